@@ -0,0 +1,53 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/urfave/cli"
+)
+
+func execAliases(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	aliasList, err := client.ListAliases()
+	if err != nil {
+		return err
+	}
+
+	aliasesBytes, err := json.MarshalIndent(aliasList.Aliases, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(aliasesBytes)))
+
+	return nil
+}
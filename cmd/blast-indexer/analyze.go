@@ -0,0 +1,77 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/urfave/cli"
+)
+
+func execAnalyze(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+	analyzerName := c.String("analyzer")
+	field := c.String("field")
+
+	text := c.Args().Get(0)
+	if text == "" {
+		err := errors.New("text argument must be set")
+		return err
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	resp, err := client.Analyze(indexName, text, analyzerName, field)
+	if err != nil {
+		return err
+	}
+
+	tokens := make([]map[string]interface{}, 0, len(resp.Tokens))
+	for _, token := range resp.Tokens {
+		tokens = append(tokens, map[string]interface{}{
+			"term":     token.Term,
+			"position": token.Position,
+			"start":    token.Start,
+			"end":      token.End,
+			"type":     token.Type,
+		})
+	}
+
+	respBytes, err := json.MarshalIndent(map[string]interface{}{
+		"analyzer": resp.Analyzer,
+		"tokens":   tokens,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(respBytes)))
+
+	return nil
+}
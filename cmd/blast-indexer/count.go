@@ -0,0 +1,79 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/mosuka/blast/indexer"
+	"github.com/urfave/cli"
+)
+
+func execCount(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+
+	queryStr := c.Args().Get(0)
+
+	// counts all the documents without a query
+	var q query.Query
+	if c.String("query") != "" {
+		if queryStr != "" {
+			err := errors.New("query argument and query flag cannot be used together")
+			return err
+		}
+		q = bleve.NewQueryStringQuery(c.String("query"))
+	} else if queryStr != "" {
+		// string -> query.Query
+		var err error
+		q, err = query.ParseQuery([]byte(queryStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	resp, err := client.Count(indexName, q)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := json.MarshalIndent(map[string]interface{}{
+		"count":     resp.Count,
+		"doc_count": resp.DocCount,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(respBytes)))
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/mosuka/blast/indexer"
+	"github.com/mosuka/blast/protobuf"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+	"github.com/urfave/cli"
+)
+
+func execCreateIndex(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexStorageType := c.String("index-storage-type")
+
+	name := c.Args().Get(0)
+	if name == "" {
+		err := errors.New("name argument must be set")
+		return err
+	}
+
+	defaultTTL, _, err := newExpiry(c.String("default-ttl"), "")
+	if err != nil {
+		return err
+	}
+
+	indexInfo := &pbindex.IndexInfo{
+		Name:             name,
+		IndexStorageType: indexStorageType,
+		DefaultTtl:       defaultTTL,
+	}
+
+	indexMappingStr := c.Args().Get(1)
+	if indexMappingStr != "" {
+		// string -> mapping.IndexMappingImpl
+		indexMapping := mapping.NewIndexMapping()
+		err := json.Unmarshal([]byte(indexMappingStr), indexMapping)
+		if err != nil {
+			return err
+		}
+
+		// mapping.IndexMappingImpl -> Any
+		indexMappingAny := &any.Any{}
+		err = protobuf.UnmarshalAny(indexMapping, indexMappingAny)
+		if err != nil {
+			return err
+		}
+		indexInfo.IndexMapping = indexMappingAny
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	err = client.CreateIndex(indexInfo)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
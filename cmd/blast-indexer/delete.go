@@ -27,6 +27,7 @@ import (
 
 func execDelete(c *cli.Context) error {
 	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
 	id := c.String("id")
 
 	// create documents
@@ -48,16 +49,23 @@ func execDelete(c *cli.Context) error {
 		}
 
 		for _, docMap := range docMaps {
+			docId, ok := docMap["id"].(string)
+			if !ok || docId == "" {
+				return errors.New("id must be a non-empty string")
+			}
+
 			// create document
 			doc := &pbindex.Document{
-				Id: docMap["id"].(string),
+				Id:    docId,
+				Index: indexName,
 			}
 
 			docs = append(docs, doc)
 		}
 	} else {
 		doc := &pbindex.Document{
-			Id: id,
+			Id:    id,
+			Index: indexName,
 		}
 
 		docs = append(docs, doc)
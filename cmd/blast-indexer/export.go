@@ -0,0 +1,84 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/mosuka/blast/indexer"
+	"github.com/mosuka/blast/protobuf"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc/status"
+)
+
+func execExport(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+
+	var q query.Query
+	if c.String("query") != "" {
+		q = bleve.NewQueryStringQuery(c.String("query"))
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	stream, err := client.Export(indexName, q)
+	if err != nil {
+		return err
+	}
+
+	for {
+		doc, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			st, _ := status.FromError(err)
+
+			return errors.New(st.Message())
+		}
+
+		// Struct -> map[string]interface{}
+		fields, err := protobuf.FromStruct(doc.Fields)
+		if err != nil {
+			return err
+		}
+
+		docBytes, err := json.Marshal(map[string]interface{}{
+			"id":     doc.Id,
+			"fields": fields,
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stdout, string(docBytes))
+	}
+}
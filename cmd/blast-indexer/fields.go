@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/urfave/cli"
+)
+
+func execFields(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+	prefix := c.String("prefix")
+	limit := c.Int("limit")
+
+	field := c.Args().Get(0)
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	var respMap map[string]interface{}
+	if field == "" {
+		resp, err := client.FieldStats(indexName)
+		if err != nil {
+			return err
+		}
+
+		fields := make([]map[string]interface{}, 0, len(resp.Fields))
+		for _, stats := range resp.Fields {
+			fields = append(fields, map[string]interface{}{
+				"name":         stats.Name,
+				"doc_count":    stats.DocCount,
+				"term_count":   stats.TermCount,
+				"sum_doc_freq": stats.SumDocFreq,
+			})
+		}
+
+		respMap = map[string]interface{}{
+			"doc_count": resp.DocCount,
+			"fields":    fields,
+		}
+	} else {
+		// the terms of a field are the prefix suggestions of it
+		resp, err := client.Suggest(indexName, field, prefix, limit, false, 0)
+		if err != nil {
+			return err
+		}
+
+		terms := make([]map[string]interface{}, 0, len(resp.Suggestions))
+		for _, suggestion := range resp.Suggestions {
+			terms = append(terms, map[string]interface{}{
+				"term":  suggestion.Term,
+				"count": suggestion.Count,
+			})
+		}
+
+		respMap = map[string]interface{}{
+			"field": field,
+			"terms": terms,
+		}
+	}
+
+	respBytes, err := json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(respBytes)))
+
+	return nil
+}
@@ -28,14 +28,20 @@ import (
 
 func execGet(c *cli.Context) error {
 	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
 	id := c.String("id")
+	ids := c.StringSlice("ids")
+	if len(ids) > 0 {
+		return multiGet(grpcAddr, indexName, ids, c.StringSlice("source-includes"), c.StringSlice("source-excludes"))
+	}
 	if id == "" {
 		err := errors.New("arguments are not correct")
 		return err
 	}
 
 	doc := &pbindex.Document{
-		Id: id,
+		Id:    id,
+		Index: indexName,
 	}
 
 	client, err := indexer.NewGRPCClient(grpcAddr)
@@ -54,16 +60,11 @@ func execGet(c *cli.Context) error {
 		return err
 	}
 
-	// Any -> map[string]interface{}
-	var fieldsMap *map[string]interface{}
-	fieldsInstance, err := protobuf.MarshalAny(resp.Fields)
+	// Struct -> map[string]interface{}
+	fieldsMap, err := protobuf.FromStruct(resp.Fields)
 	if err != nil {
 		return err
 	}
-	if fieldsInstance == nil {
-		return errors.New("nil")
-	}
-	fieldsMap = fieldsInstance.(*map[string]interface{})
 
 	// map[string]interface -> []byte
 	fieldsBytes, err := json.MarshalIndent(fieldsMap, "", "  ")
@@ -75,3 +76,62 @@ func execGet(c *cli.Context) error {
 
 	return nil
 }
+
+func multiGet(grpcAddr string, indexName string, ids []string, sourceIncludes []string, sourceExcludes []string) error {
+	req := &pbindex.MultiGetRequest{
+		Index:          indexName,
+		Ids:            ids,
+		SourceIncludes: sourceIncludes,
+		SourceExcludes: sourceExcludes,
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	resp, err := client.MultiGet(req)
+	if err != nil {
+		return err
+	}
+
+	docMaps := make([]map[string]interface{}, 0, len(resp.Documents))
+	for _, doc := range resp.Documents {
+		// Struct -> map[string]interface{}
+		fieldsMap, err := protobuf.FromStruct(doc.Fields)
+		if err != nil {
+			return err
+		}
+
+		docMaps = append(docMaps, map[string]interface{}{
+			"id":     doc.Id,
+			"fields": fieldsMap,
+		})
+	}
+
+	missingIds := resp.MissingIds
+	if missingIds == nil {
+		missingIds = make([]string, 0)
+	}
+
+	respMap := map[string]interface{}{
+		"documents":   docMaps,
+		"missing_ids": missingIds,
+	}
+
+	// map[string]interface -> []byte
+	respBytes, err := json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(respBytes)))
+
+	return nil
+}
@@ -0,0 +1,598 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/mosuka/blast/protobuf"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+	"github.com/urfave/cli"
+)
+
+const (
+	importFormatNDJSON = "ndjson"
+	importFormatJSON   = "json"
+	importFormatCSV    = "csv"
+)
+
+// importRecord is a document read from the input with what is needed to report it as rejected.
+type importRecord struct {
+	line   int
+	raw    []byte
+	doc    *pbindex.Document
+	reason error
+}
+
+func execImport(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+	file := c.String("file")
+	format := c.String("format")
+	idColumn := c.String("id-column")
+	batchSize := c.Int("batch-size")
+	workers := c.Int("workers")
+	rejectsFile := c.String("rejects-file")
+
+	if batchSize <= 0 {
+		return errors.New("batch size must be greater than 0")
+	}
+	if workers <= 0 {
+		return errors.New("workers must be greater than 0")
+	}
+
+	if format == "" {
+		format = importFormatByExt(file)
+	}
+
+	var input io.Reader
+	if file == "" || file == "-" {
+		input = os.Stdin
+	} else {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		input = f
+	}
+
+	rejects, err := newImportRejects(rejectsFile, format)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := rejects.close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	var readRecords func(io.Reader, string, chan<- *importRecord) error
+	switch format {
+	case importFormatNDJSON:
+		readRecords = readNDJSONRecords
+	case importFormatJSON:
+		readRecords = readJSONRecords
+	case importFormatCSV:
+		readRecords = func(r io.Reader, indexName string, records chan<- *importRecord) error {
+			return readCSVRecords(r, indexName, idColumn, rejects.writeCSVHeader, records)
+		}
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	records := make(chan *importRecord, batchSize)
+	batches := make(chan []*importRecord, workers)
+
+	var imported, rejected int64
+
+	// read the input
+	readErrCh := make(chan error, 1)
+	go func() {
+		defer close(records)
+		readErrCh <- readRecords(input, indexName, records)
+	}()
+
+	// batch the documents, rejecting the ones that could not be read
+	go func() {
+		defer close(batches)
+		batch := make([]*importRecord, 0, batchSize)
+		for record := range records {
+			if record.reason != nil {
+				atomic.AddInt64(&rejected, 1)
+				rejects.write(record)
+				continue
+			}
+			batch = append(batch, record)
+			if len(batch) >= batchSize {
+				batches <- batch
+				batch = make([]*importRecord, 0, batchSize)
+			}
+		}
+		if len(batch) > 0 {
+			batches <- batch
+		}
+	}()
+
+	// index the batches in parallel
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				items := make([]*pbindex.BulkItem, len(batch))
+				for i, record := range batch {
+					items[i] = &pbindex.BulkItem{
+						Action:   pbindex.BulkItem_INDEX,
+						Document: record.doc,
+					}
+				}
+
+				resp, err := client.Bulk(items)
+				if err == nil && len(resp.Results) != len(batch) {
+					err = fmt.Errorf("%d results were returned for %d documents", len(resp.Results), len(batch))
+				}
+				if err != nil {
+					// which of the documents were written is unknown
+					atomic.AddInt64(&rejected, int64(len(batch)))
+					for _, record := range batch {
+						record.reason = err
+						rejects.write(record)
+					}
+					continue
+				}
+
+				// only the documents that failed are rejected, the others are written
+				for i, result := range resp.Results {
+					if result.Error != "" {
+						atomic.AddInt64(&rejected, 1)
+						batch[i].reason = errors.New(result.Error)
+						rejects.write(batch[i])
+						continue
+					}
+					atomic.AddInt64(&imported, 1)
+				}
+			}
+		}()
+	}
+
+	// report the progress until all the batches are done
+	start := time.Now()
+	doneCh := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(doneCh)
+	}()
+
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+	for running := true; running; {
+		select {
+		case <-doneCh:
+			running = false
+		case <-ticker.C:
+		}
+		elapsed := time.Since(start).Seconds()
+		count := atomic.LoadInt64(&imported)
+		fmt.Fprintf(os.Stderr, "imported %d documents, rejected %d, %.1f docs/s\n", count, atomic.LoadInt64(&rejected), float64(count)/elapsed)
+	}
+
+	err = <-readErrCh
+	if err != nil {
+		return err
+	}
+
+	resultBytes, err := json.MarshalIndent(map[string]interface{}{
+		"count":    imported,
+		"rejected": rejected,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(resultBytes)))
+
+	return nil
+}
+
+func importFormatByExt(file string) string {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		return importFormatJSON
+	case ".csv":
+		return importFormatCSV
+	default:
+		return importFormatNDJSON
+	}
+}
+
+// readNDJSONRecords reads one {"id": ..., "fields": ...} object per line.
+func readNDJSONRecords(r io.Reader, indexName string, records chan<- *importRecord) error {
+	reader := bufio.NewReader(r)
+
+	line := 0
+	for {
+		lineBytes, err := reader.ReadBytes('\n')
+		if len(lineBytes) > 0 {
+			line++
+			lineBytes = bytes.TrimSpace(lineBytes)
+			if len(lineBytes) > 0 {
+				records <- newJSONImportRecord(line, lineBytes, indexName)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// readJSONRecords reads an array of {"id": ..., "fields": ...} objects without loading it at once.
+func readJSONRecords(r io.Reader, indexName string, records chan<- *importRecord) error {
+	decoder := json.NewDecoder(r)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return errors.New("documents must be an array")
+	}
+
+	element := 0
+	for decoder.More() {
+		element++
+
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if err != nil {
+			return err
+		}
+
+		records <- newJSONImportRecord(element, raw, indexName)
+	}
+
+	return nil
+}
+
+func newJSONImportRecord(line int, raw []byte, indexName string) *importRecord {
+	record := &importRecord{
+		line: line,
+		raw:  raw,
+	}
+
+	var docMap map[string]interface{}
+	err := json.Unmarshal(raw, &docMap)
+	if err != nil {
+		record.reason = err
+		return record
+	}
+
+	id, ok := docMap["id"].(string)
+	if !ok || id == "" {
+		record.reason = errors.New("id must be a non-empty string")
+		return record
+	}
+
+	fieldsMap, ok := docMap["fields"].(map[string]interface{})
+	if !ok {
+		record.reason = errors.New("fields must be an object")
+		return record
+	}
+
+	// map[string]interface{} -> Struct
+	fields, err := protobuf.ToStruct(fieldsMap)
+	if err != nil {
+		record.reason = err
+		return record
+	}
+
+	record.doc = &pbindex.Document{
+		Id:     id,
+		Fields: fields,
+		Index:  indexName,
+	}
+
+	return record
+}
+
+// readCSVRecords reads a CSV file with a header row. The ID column gives the document ID
+// and the other columns become string fields.
+func readCSVRecords(r io.Reader, indexName string, idColumn string, onHeader func([]byte), records chan<- *importRecord) error {
+	scanner := newCSVRecordScanner(r)
+
+	var header []string
+	for header == nil {
+		raw, _, err := scanner.next()
+		if err != nil {
+			return err
+		}
+
+		header, err = parseCSVRecord(raw, 1)
+		if err == io.EOF {
+			// a blank line
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		onHeader(raw)
+	}
+
+	idIndex := -1
+	for i, name := range header {
+		if name == idColumn {
+			idIndex = i
+			break
+		}
+	}
+	if idIndex < 0 {
+		return fmt.Errorf("id column %s not found", idColumn)
+	}
+
+	for {
+		raw, line, err := scanner.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		record := &importRecord{
+			line: line,
+			raw:  raw,
+		}
+
+		values, err := parseCSVRecord(raw, line)
+		if err == io.EOF {
+			// a blank line
+			continue
+		}
+		if err != nil {
+			record.reason = err
+			records <- record
+			continue
+		}
+
+		if len(values) != len(header) {
+			record.reason = fmt.Errorf("expected %d columns but got %d", len(header), len(values))
+			records <- record
+			continue
+		}
+		if values[idIndex] == "" {
+			record.reason = errors.New("id must not be empty")
+			records <- record
+			continue
+		}
+
+		fieldsMap := make(map[string]interface{}, len(header)-1)
+		for i, name := range header {
+			if i != idIndex {
+				fieldsMap[name] = values[i]
+			}
+		}
+
+		// map[string]interface{} -> Struct
+		fields, err := protobuf.ToStruct(fieldsMap)
+		if err != nil {
+			record.reason = err
+			records <- record
+			continue
+		}
+
+		record.doc = &pbindex.Document{
+			Id:     values[idIndex],
+			Fields: fields,
+			Index:  indexName,
+		}
+
+		records <- record
+	}
+}
+
+// parseCSVRecord parses the raw text of a record starting at the line, returning io.EOF for a blank line.
+func parseCSVRecord(raw []byte, line int) ([]string, error) {
+	reader := csv.NewReader(bytes.NewReader(raw))
+	reader.FieldsPerRecord = -1
+
+	values, err := reader.Read()
+	if parseErr, ok := err.(*csv.ParseError); ok {
+		// the lines are counted from the start of the record
+		parseErr.StartLine += line - 1
+		parseErr.Line += line - 1
+	}
+
+	return values, err
+}
+
+// csvRecordScanner splits CSV into the raw text of its records, which span several lines when a quoted field
+// holds line breaks, so that the records that cannot be parsed are still written to the rejects file as read.
+type csvRecordScanner struct {
+	reader *bufio.Reader
+	line   int
+}
+
+func newCSVRecordScanner(r io.Reader) *csvRecordScanner {
+	return &csvRecordScanner{
+		reader: bufio.NewReader(r),
+	}
+}
+
+// next returns the raw text of the next record and the line it starts at.
+func (s *csvRecordScanner) next() ([]byte, int, error) {
+	var raw []byte
+	line := s.line + 1
+	quoted := false
+	for {
+		lineBytes, err := s.reader.ReadBytes('\n')
+		if len(lineBytes) > 0 {
+			s.line++
+			raw = append(raw, lineBytes...)
+			quoted = scanCSVQuotes(lineBytes, quoted)
+		}
+		if err == io.EOF && len(raw) > 0 {
+			return raw, line, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if !quoted {
+			return raw, line, nil
+		}
+	}
+}
+
+// scanCSVQuotes returns whether a quoted field is left open at the end of the line, following encoding/csv,
+// where a quote only opens a field at its start and a doubled quote in a quoted field is an escaped one.
+func scanCSVQuotes(line []byte, quoted bool) bool {
+	fieldStart := !quoted
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if quoted {
+			if c == '"' {
+				if i+1 < len(line) && line[i+1] == '"' {
+					i++
+					continue
+				}
+				quoted = false
+			}
+		} else if c == '"' && fieldStart {
+			quoted = true
+		}
+		fieldStart = c == ','
+	}
+
+	return quoted
+}
+
+// importRejects writes the rejected records to a file in the input format so that they can be fixed and imported again.
+// The reasons are reported on stderr.
+type importRejects struct {
+	file   *os.File
+	format string
+	mutex  sync.Mutex
+}
+
+func newImportRejects(path string, format string) (*importRejects, error) {
+	rejects := &importRejects{
+		format: format,
+	}
+	if path == "" {
+		return rejects, nil
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	rejects.file = file
+
+	return rejects, nil
+}
+
+func (r *importRejects) write(record *importRecord) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	fmt.Fprintf(os.Stderr, "rejected line %d: %v\n", record.line, record.reason)
+
+	if r.file == nil {
+		return
+	}
+
+	var err error
+	if r.format == importFormatCSV {
+		err = r.writeLine(record.raw)
+	} else {
+		err = r.writeLine(compactJSON(record.raw))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+func (r *importRejects) writeCSVHeader(raw []byte) {
+	if r.file == nil {
+		return
+	}
+
+	err := r.writeLine(raw)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+// writeLine writes the raw text as read, ending it with a line break if it is the last line of the input.
+func (r *importRejects) writeLine(raw []byte) error {
+	_, err := r.file.Write(raw)
+	if err != nil {
+		return err
+	}
+	if len(raw) <= 0 || raw[len(raw)-1] != '\n' {
+		_, err = r.file.Write([]byte{'\n'})
+	}
+
+	return err
+}
+
+func (r *importRejects) close() error {
+	if r.file == nil {
+		return nil
+	}
+
+	return r.file.Close()
+}
+
+// compactJSON keeps the rejected JSON array elements on one line each.
+func compactJSON(raw []byte) []byte {
+	buf := &bytes.Buffer{}
+	err := json.Compact(buf, raw)
+	if err != nil {
+		return raw
+	}
+
+	return buf.Bytes()
+}
@@ -19,8 +19,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
-	"github.com/golang/protobuf/ptypes/any"
 	"github.com/mosuka/blast/indexer"
 	"github.com/mosuka/blast/protobuf"
 	pbindex "github.com/mosuka/blast/protobuf/index"
@@ -29,7 +30,20 @@ import (
 
 func execIndex(c *cli.Context) error {
 	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
 	id := c.String("id")
+	percolate := c.Bool("percolate")
+	pipeline := c.String("pipeline")
+
+	ttl, expireAt, err := newExpiry(c.String("ttl"), c.String("expire-at"))
+	if err != nil {
+		return err
+	}
+
+	opType, err := newOpType(c.String("op-type"))
+	if err != nil {
+		return err
+	}
 
 	if c.NArg() == 0 {
 		err := errors.New("arguments are not correct")
@@ -50,43 +64,64 @@ func execIndex(c *cli.Context) error {
 		}
 
 		for _, docMap := range docMaps {
-			// map[string]interface{} -> Any
-			fieldsAny := &any.Any{}
-			err = protobuf.UnmarshalAny(docMap["fields"], fieldsAny)
+			// a document without an id is given a generated one
+			docId, ok := docMap["id"].(string)
+			if _, exists := docMap["id"]; exists && !ok {
+				return errors.New("id must be a string")
+			}
+
+			fieldsMap, ok := docMap["fields"].(map[string]interface{})
+			if !ok {
+				return errors.New("fields must be an object")
+			}
+
+			// map[string]interface{} -> Struct
+			fields, err := protobuf.ToStruct(fieldsMap)
 			if err != nil {
 				return err
 			}
 
 			// create document
 			doc := &pbindex.Document{
-				Id:     docMap["id"].(string),
-				Fields: fieldsAny,
+				Id:        docId,
+				Fields:    fields,
+				Index:     indexName,
+				Percolate: percolate,
+				Ttl:       ttl,
+				ExpireAt:  expireAt,
+				Pipeline:  pipeline,
+				OpType:    opType,
 			}
 
 			docs = append(docs, doc)
 		}
 	} else {
 		// document
-		fields := c.Args().Get(0)
+		fieldsStr := c.Args().Get(0)
 
 		// string -> map[string]interface{}
 		var fieldsMap map[string]interface{}
-		err := json.Unmarshal([]byte(fields), &fieldsMap)
+		err := json.Unmarshal([]byte(fieldsStr), &fieldsMap)
 		if err != nil {
 			return err
 		}
 
-		// map[string]interface{} -> Any
-		fieldsAny := &any.Any{}
-		err = protobuf.UnmarshalAny(fieldsMap, fieldsAny)
+		// map[string]interface{} -> Struct
+		fields, err := protobuf.ToStruct(fieldsMap)
 		if err != nil {
 			return err
 		}
 
 		// create document
 		doc := &pbindex.Document{
-			Id:     id,
-			Fields: fieldsAny,
+			Id:        id,
+			Fields:    fields,
+			Index:     indexName,
+			Percolate: percolate,
+			Ttl:       ttl,
+			ExpireAt:  expireAt,
+			Pipeline:  pipeline,
+			OpType:    opType,
 		}
 
 		docs = append(docs, doc)
@@ -110,6 +145,11 @@ func execIndex(c *cli.Context) error {
 		return err
 	}
 
+	// the document was not written because its op type conflicts
+	if id != "" && len(result.Results) > 0 && result.Results[0].Error != "" {
+		return errors.New(result.Results[0].Error)
+	}
+
 	resultBytes, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
 		return err
@@ -119,3 +159,40 @@ func execIndex(c *cli.Context) error {
 
 	return nil
 }
+
+// newExpiry parses a ttl such as 24h and a time to expire at in RFC 3339 into seconds, 0 if not set.
+func newExpiry(ttlStr string, expireAtStr string) (int64, int64, error) {
+	ttl := int64(0)
+	if ttlStr != "" {
+		d, err := time.ParseDuration(ttlStr)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("invalid ttl: %s", ttlStr)
+		}
+		ttl = int64((d + time.Second - 1) / time.Second)
+	}
+
+	expireAt := int64(0)
+	if expireAtStr != "" {
+		t, err := time.Parse(time.RFC3339, expireAtStr)
+		if err != nil || t.Unix() <= 0 {
+			return 0, 0, fmt.Errorf("invalid expire_at: %s", expireAtStr)
+		}
+		expireAt = t.Unix()
+	}
+
+	return ttl, expireAt, nil
+}
+
+// newOpType parses an op type of create, update or upsert, upsert if not set.
+func newOpType(opTypeStr string) (pbindex.Document_OpType, error) {
+	if opTypeStr == "" {
+		return pbindex.Document_UPSERT, nil
+	}
+
+	opType, ok := pbindex.Document_OpType_value[strings.ToUpper(opTypeStr)]
+	if !ok || opTypeStr != strings.ToLower(opTypeStr) {
+		return pbindex.Document_UPSERT, fmt.Errorf("invalid op-type: %s", opTypeStr)
+	}
+
+	return pbindex.Document_OpType(opType), nil
+}
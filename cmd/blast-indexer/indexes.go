@@ -0,0 +1,75 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/mosuka/blast/protobuf"
+	"github.com/urfave/cli"
+)
+
+func execIndexes(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	indexList, err := client.ListIndexes()
+	if err != nil {
+		return err
+	}
+
+	indexInfoMaps := make([]map[string]interface{}, 0)
+	for _, indexInfo := range indexList.Indexes {
+		// Any -> mapping.IndexMappingImpl
+		indexMapping, err := protobuf.MarshalAny(indexInfo.IndexMapping)
+		if err != nil {
+			return err
+		}
+
+		indexInfoMap := map[string]interface{}{
+			"name":               indexInfo.Name,
+			"index_mapping":      indexMapping,
+			"index_storage_type": indexInfo.IndexStorageType,
+		}
+		if indexInfo.DefaultTtl > 0 {
+			indexInfoMap["default_ttl"] = (time.Duration(indexInfo.DefaultTtl) * time.Second).String()
+		}
+
+		indexInfoMaps = append(indexInfoMaps, indexInfoMap)
+	}
+
+	indexesBytes, err := json.MarshalIndent(indexInfoMaps, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(indexesBytes)))
+
+	return nil
+}
@@ -79,6 +79,16 @@ func main() {
 					Value: "boltdb",
 					Usage: "Index storage type to use",
 				},
+				cli.StringFlag{
+					Name:  "pipelines-file",
+					Value: "",
+					Usage: "Path to a file containing a JSON representation of the ingest pipelines to use",
+				},
+				cli.StringFlag{
+					Name:  "manager-addr",
+					Value: "",
+					Usage: "gRPC address of a manager to look up the ingest pipelines in first",
+				},
 				cli.StringFlag{
 					Name:  "log-level, L",
 					Value: "INFO",
@@ -212,6 +222,23 @@ func main() {
 					Value: "",
 					Usage: "document id",
 				},
+				cli.StringSliceFlag{
+					Name:  "ids",
+					Usage: "document ids to get in a single request",
+				},
+				cli.StringSliceFlag{
+					Name:  "source-includes",
+					Usage: "fields to return when getting documents by --ids",
+				},
+				cli.StringSliceFlag{
+					Name:  "source-excludes",
+					Usage: "fields not to return when getting documents by --ids",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
 			},
 			Action: execGet,
 		},
@@ -229,10 +256,104 @@ func main() {
 					Value: "",
 					Usage: "document id",
 				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.BoolFlag{
+					Name:  "percolate",
+					Usage: "publish the ids of the percolator queries matching the documents to the subscribers",
+				},
+				cli.StringFlag{
+					Name:  "ttl",
+					Value: "",
+					Usage: "duration the documents expire in, e.g. 24h (default: the default ttl of the index)",
+				},
+				cli.StringFlag{
+					Name:  "expire-at",
+					Value: "",
+					Usage: "time the documents expire at in RFC 3339, e.g. 2019-12-31T00:00:00Z",
+				},
+				cli.StringFlag{
+					Name:  "pipeline",
+					Value: "",
+					Usage: "ingest pipeline to transform the documents with",
+				},
+				cli.StringFlag{
+					Name:  "op-type",
+					Value: "",
+					Usage: "create fails if a document exists, update if it does not, upsert writes it either way (default: upsert)",
+				},
 			},
 			ArgsUsage: "[documents | fields]",
 			Action:    execIndex,
 		},
+		{
+			Name:  "simulate-pipeline",
+			Usage: "Transform documents with an ingest pipeline without indexing them",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "pipeline",
+					Value: "",
+					Usage: "JSON representation of a pipeline to simulate instead of a named one",
+				},
+			},
+			ArgsUsage: "[name] [documents]",
+			Action:    execSimulatePipeline,
+		},
+		{
+			Name:  "import",
+			Usage: "Import documents from a file in batches",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.StringFlag{
+					Name:  "file, f",
+					Value: "-",
+					Usage: "file to import, - for stdin",
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Value: "",
+					Usage: "ndjson, json or csv (default: by the file extension, ndjson otherwise)",
+				},
+				cli.StringFlag{
+					Name:  "id-column",
+					Value: "id",
+					Usage: "CSV column holding the document id",
+				},
+				cli.IntFlag{
+					Name:  "batch-size",
+					Value: 1000,
+					Usage: "number of documents sent in one request",
+				},
+				cli.IntFlag{
+					Name:  "workers",
+					Value: 4,
+					Usage: "number of batches sent in parallel",
+				},
+				cli.StringFlag{
+					Name:  "rejects-file",
+					Value: "",
+					Usage: "file to write the records that could not be imported to, in the input format",
+				},
+			},
+			Action: execImport,
+		},
 		{
 			Name:  "delete",
 			Usage: "Delete documents in bulk",
@@ -247,6 +368,11 @@ func main() {
 					Value: "",
 					Usage: "document id",
 				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
 			},
 			ArgsUsage: "[documents]",
 			Action:    execDelete,
@@ -260,10 +386,275 @@ func main() {
 					Value: ":5050",
 					Usage: "gRPC address to connect to",
 				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.StringFlag{
+					Name:  "query, q",
+					Value: "",
+					Usage: "query string query to search instead of a search request",
+				},
+				cli.IntFlag{
+					Name:  "size",
+					Value: 10,
+					Usage: "number of hits to return with --query",
+				},
+				cli.IntFlag{
+					Name:  "from",
+					Value: 0,
+					Usage: "offset of the first hit with --query",
+				},
+				cli.StringFlag{
+					Name:  "sort",
+					Value: "",
+					Usage: "comma separated sort fields with --query, e.g. -_score,_id",
+				},
+				cli.StringFlag{
+					Name:  "fields",
+					Value: "",
+					Usage: "comma separated stored fields to return with --query",
+				},
+				cli.BoolFlag{
+					Name:  "explain",
+					Usage: "return the score explanation of the hits",
+				},
+				cli.StringFlag{
+					Name:  "explain-id",
+					Value: "",
+					Usage: "explain why the document with this id matches the query, or does not",
+				},
+				cli.BoolFlag{
+					Name:  "profile",
+					Usage: "return the timings of the phases of the search",
+				},
+				cli.BoolFlag{
+					Name:  "stream",
+					Usage: "stream all the hits as newline delimited JSON, ignoring size and from",
+				},
 			},
 			ArgsUsage: "[search request]",
 			Action:    execSearch,
 		},
+		{
+			Name:  "similar",
+			Usage: "Search documents similar to a document",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.StringFlag{
+					Name:  "like-fields",
+					Value: "",
+					Usage: "comma separated fields to take terms from (default: all the text fields)",
+				},
+				cli.IntFlag{
+					Name:  "max-query-terms",
+					Value: 25,
+					Usage: "max number of terms to search with",
+				},
+				cli.IntFlag{
+					Name:  "min-term-freq",
+					Value: 2,
+					Usage: "min number of times a term occurs in the document",
+				},
+				cli.IntFlag{
+					Name:  "min-doc-freq",
+					Value: 5,
+					Usage: "min number of documents containing a term",
+				},
+				cli.IntFlag{
+					Name:  "max-doc-freq",
+					Value: 0,
+					Usage: "max number of documents containing a term (default: unlimited)",
+				},
+				cli.IntFlag{
+					Name:  "size",
+					Value: 10,
+					Usage: "number of hits to return",
+				},
+				cli.IntFlag{
+					Name:  "from",
+					Value: 0,
+					Usage: "offset of the first hit",
+				},
+				cli.StringFlag{
+					Name:  "fields",
+					Value: "",
+					Usage: "comma separated stored fields to return",
+				},
+			},
+			ArgsUsage: "[id]",
+			Action:    execSimilar,
+		},
+		{
+			Name:  "count",
+			Usage: "Count documents",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.StringFlag{
+					Name:  "query, q",
+					Value: "",
+					Usage: "query string query to count the matches of instead of a query",
+				},
+			},
+			ArgsUsage: "[query]",
+			Action:    execCount,
+		},
+		{
+			Name:  "analyze",
+			Usage: "Analyze text",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.StringFlag{
+					Name:  "analyzer",
+					Value: "",
+					Usage: "analyzer name (default: the analyzer of the field)",
+				},
+				cli.StringFlag{
+					Name:  "field",
+					Value: "",
+					Usage: "field name whose analyzer to use (default: the default analyzer)",
+				},
+			},
+			ArgsUsage: "[text]",
+			Action:    execAnalyze,
+		},
+		{
+			Name:  "suggest",
+			Usage: "Suggest terms",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.StringFlag{
+					Name:  "field",
+					Value: "",
+					Usage: "field name whose terms to suggest",
+				},
+				cli.IntFlag{
+					Name:  "size",
+					Value: 10,
+					Usage: "number of terms to suggest",
+				},
+				cli.BoolFlag{
+					Name:  "fuzzy",
+					Usage: "suggest the terms within an edit distance of the prefix for corrections",
+				},
+				cli.IntFlag{
+					Name:  "fuzziness",
+					Value: 0,
+					Usage: "max edit distance of fuzzy suggestions (default: 2)",
+				},
+			},
+			ArgsUsage: "[prefix]",
+			Action:    execSuggest,
+		},
+		{
+			Name:  "fields",
+			Usage: "Get field statistics, or the terms of a field",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.StringFlag{
+					Name:  "prefix",
+					Value: "",
+					Usage: "prefix of the terms to list",
+				},
+				cli.IntFlag{
+					Name:  "limit",
+					Value: 10,
+					Usage: "number of terms to list",
+				},
+			},
+			ArgsUsage: "[field]",
+			Action:    execFields,
+		},
+		{
+			Name:  "scroll",
+			Usage: "Get the next page of a scroll",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "scroll",
+					Value: "",
+					Usage: "how long to keep the scroll open, e.g. 1m (default: the previous value)",
+				},
+				cli.BoolFlag{
+					Name:  "clear",
+					Usage: "close the scroll instead of reading the next page",
+				},
+			},
+			ArgsUsage: "[scroll id]",
+			Action:    execScroll,
+		},
+		{
+			Name:  "export",
+			Usage: "Export documents as newline delimited JSON",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.StringFlag{
+					Name:  "query, q",
+					Value: "",
+					Usage: "export only the documents matching the query string query",
+				},
+			},
+			Action: execExport,
+		},
 		{
 			Name:  "stats",
 			Usage: "Get a index stats",
@@ -273,9 +664,271 @@ func main() {
 					Value: ":5050",
 					Usage: "address to connect to",
 				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
 			},
 			Action: execStats,
 		},
+		{
+			Name:  "mapping",
+			Usage: "Get the index mapping",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+			},
+			Action: execMapping,
+		},
+		{
+			Name:  "put-mapping",
+			Usage: "Change the index mapping and reindex all documents",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+			},
+			ArgsUsage: "[index mapping]",
+			Action:    execPutMapping,
+		},
+		{
+			Name:  "reindex-status",
+			Usage: "Get the progress of the reindex",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+			},
+			Action: execReindexStatus,
+		},
+		{
+			Name:  "indexes",
+			Usage: "List indexes",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+			},
+			Action: execIndexes,
+		},
+		{
+			Name:  "create-index",
+			Usage: "Create an index",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index-storage-type, s",
+					Value: "",
+					Usage: "Index storage type to use (default: the storage type of the node)",
+				},
+				cli.StringFlag{
+					Name:  "default-ttl",
+					Value: "",
+					Usage: "duration the documents written without a ttl expire in, e.g. 720h (default: never)",
+				},
+			},
+			ArgsUsage: "[name] [index mapping]",
+			Action:    execCreateIndex,
+		},
+		{
+			Name:  "delete-index",
+			Usage: "Delete an index",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+			},
+			ArgsUsage: "[name]",
+			Action:    execDeleteIndex,
+		},
+		{
+			Name:  "aliases",
+			Usage: "List aliases",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+			},
+			Action: execAliases,
+		},
+		{
+			Name:  "put-alias",
+			Usage: "Create or update an alias",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+			},
+			ArgsUsage: "[name] [index]...",
+			Action:    execPutAlias,
+		},
+		{
+			Name:  "delete-alias",
+			Usage: "Delete an alias",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+			},
+			ArgsUsage: "[name]",
+			Action:    execDeleteAlias,
+		},
+		{
+			Name:  "percolators",
+			Usage: "List percolator queries",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+			},
+			Action: execPercolators,
+		},
+		{
+			Name:  "put-percolator",
+			Usage: "Create or update a percolator query",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+				cli.StringFlag{
+					Name:  "query, q",
+					Value: "",
+					Usage: "query string query to store instead of a query",
+				},
+			},
+			ArgsUsage: "[id] [query]",
+			Action:    execPutPercolator,
+		},
+		{
+			Name:  "delete-percolator",
+			Usage: "Delete a percolator query",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index)",
+				},
+			},
+			ArgsUsage: "[id]",
+			Action:    execDeletePercolator,
+		},
+		{
+			Name:  "percolate",
+			Usage: "Get the percolator queries matching a document, or subscribe to percolations",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index or alias name (default: the default index, all the indexes with --subscribe)",
+				},
+				cli.BoolFlag{
+					Name:  "subscribe",
+					Usage: "stream the percolations of the writes as newline delimited JSON",
+				},
+			},
+			ArgsUsage: "[fields]",
+			Action:    execPercolate,
+		},
+		{
+			Name:  "subscribe",
+			Usage: "Stream the changes of the documents in raft log order",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringFlag{
+					Name:  "index",
+					Value: "",
+					Usage: "index name (default: all the indexes)",
+				},
+				cli.Uint64Flag{
+					Name:  "from",
+					Value: 0,
+					Usage: "raft index to resume the changes from (default: only the new changes)",
+				},
+			},
+			Action: execSubscribe,
+		},
+		{
+			Name:  "swap-alias",
+			Usage: "Point an alias to other indexes atomically",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "grpc-addr, g",
+					Value: ":5050",
+					Usage: "gRPC address to connect to",
+				},
+				cli.StringSliceFlag{
+					Name:  "from, f",
+					Usage: "index the alias must point to before the swap",
+				},
+			},
+			ArgsUsage: "[name] [index]...",
+			Action:    execSwapAlias,
+		},
 	}
 
 	cli.HelpFlag = cli.BoolFlag{
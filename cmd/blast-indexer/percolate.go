@@ -0,0 +1,103 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc/status"
+)
+
+func execPercolate(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	if c.Bool("subscribe") {
+		return subscribePercolations(client, indexName)
+	}
+
+	fieldsStr := c.Args().Get(0)
+	if fieldsStr == "" {
+		err := errors.New("fields argument must be set")
+		return err
+	}
+
+	// string -> map[string]interface{}
+	var fieldsMap map[string]interface{}
+	err = json.Unmarshal([]byte(fieldsStr), &fieldsMap)
+	if err != nil {
+		return err
+	}
+
+	queryIds, err := client.Percolate(indexName, fieldsMap)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := json.MarshalIndent(map[string]interface{}{
+		"query_ids": queryIds,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(respBytes)))
+
+	return nil
+}
+
+// subscribePercolations writes the percolations as newline delimited JSON until interrupted.
+func subscribePercolations(client *indexer.GRPCClient, indexName string) error {
+	stream, err := client.SubscribePercolations(context.Background(), indexName)
+	if err != nil {
+		return err
+	}
+
+	for {
+		percolation, err := stream.Recv()
+		if err != nil {
+			st, _ := status.FromError(err)
+			return errors.New(st.Message())
+		}
+
+		percolationBytes, err := json.Marshal(map[string]interface{}{
+			"index":     percolation.Index,
+			"id":        percolation.Id,
+			"query_ids": percolation.QueryIds,
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stdout, string(percolationBytes))
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/mosuka/blast/protobuf"
+	"github.com/urfave/cli"
+)
+
+func execPercolators(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	resp, err := client.ListPercolatorQueries(indexName)
+	if err != nil {
+		return err
+	}
+
+	queries := make([]map[string]interface{}, 0, len(resp.Queries))
+	for _, percolatorQuery := range resp.Queries {
+		// index.Query -> query.Query
+		q, err := protobuf.ToBleveQuery(percolatorQuery.Query)
+		if err != nil {
+			return err
+		}
+
+		queries = append(queries, map[string]interface{}{
+			"id":    percolatorQuery.Id,
+			"query": q,
+		})
+	}
+
+	queriesBytes, err := json.MarshalIndent(map[string]interface{}{
+		"queries": queries,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(queriesBytes)))
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/mosuka/blast/indexer"
+	"github.com/urfave/cli"
+)
+
+func execPutMapping(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+
+	indexMappingStr := c.Args().Get(0)
+	if indexMappingStr == "" {
+		err := errors.New("index mapping argument must be set")
+		return err
+	}
+
+	// string -> mapping.IndexMappingImpl
+	indexMapping := mapping.NewIndexMapping()
+	err := json.Unmarshal([]byte(indexMappingStr), indexMapping)
+	if err != nil {
+		return err
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	err = client.PutIndexMapping(indexName, indexMapping)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/mosuka/blast/indexer"
+	"github.com/mosuka/blast/protobuf"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+	"github.com/urfave/cli"
+)
+
+func execPutPercolator(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+
+	id := c.Args().Get(0)
+	if id == "" {
+		err := errors.New("id argument must be set")
+		return err
+	}
+
+	queryStr := c.Args().Get(1)
+
+	var q query.Query
+	if c.String("query") != "" {
+		if queryStr != "" {
+			err := errors.New("query argument and query flag cannot be used together")
+			return err
+		}
+		q = bleve.NewQueryStringQuery(c.String("query"))
+	} else {
+		if queryStr == "" {
+			err := errors.New("query argument or query flag must be set")
+			return err
+		}
+
+		// string -> query.Query
+		var err error
+		q, err = query.ParseQuery([]byte(queryStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	// query.Query -> index.Query
+	pbQuery, err := protobuf.FromBleveQuery(q)
+	if err != nil {
+		return err
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	err = client.PutPercolatorQuery(&pbindex.PercolatorQuery{
+		Index: indexName,
+		Id:    id,
+		Query: pbQuery,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -18,25 +18,58 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
+	"strconv"
 
 	"github.com/blevesearch/bleve"
 	"github.com/mosuka/blast/indexer"
+	"github.com/mosuka/blast/protobuf"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc/status"
 )
 
 func execSearch(c *cli.Context) error {
 	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
 
 	searchRequestStr := c.Args().Get(0)
-	if searchRequestStr == "" {
-		err := errors.New("key argument must be set")
-		return err
-	}
 
-	// string -> bleve.SearchRequest
-	searchRequest := bleve.NewSearchRequest(nil)
-	if searchRequestStr != "" {
+	var searchRequest *indexer.SearchRequest
+	if c.IsSet("query") {
+		if searchRequestStr != "" {
+			err := errors.New("search request argument and query flag cannot be used together")
+			return err
+		}
+
+		// flags -> SearchRequest
+		values := url.Values{}
+		values.Set("q", c.String("query"))
+		for _, name := range []string{"size", "from"} {
+			if c.IsSet(name) {
+				values.Set(name, strconv.Itoa(c.Int(name)))
+			}
+		}
+		for _, name := range []string{"sort", "fields"} {
+			if c.IsSet(name) {
+				values.Set(name, c.String(name))
+			}
+		}
+
+		var err error
+		searchRequest, err = indexer.NewSearchRequestFromValues(values)
+		if err != nil {
+			return err
+		}
+	} else {
+		if searchRequestStr == "" {
+			err := errors.New("search request argument or query flag must be set")
+			return err
+		}
+
+		// string -> SearchRequest
+		searchRequest = indexer.NewSearchRequest(bleve.NewSearchRequest(nil))
 		err := json.Unmarshal([]byte(searchRequestStr), searchRequest)
 		if err != nil {
 			return err
@@ -54,7 +87,22 @@ func execSearch(c *cli.Context) error {
 		}
 	}()
 
-	searchResult, err := client.Search(searchRequest)
+	if c.Bool("explain") {
+		searchRequest.Explain = true
+	}
+	if c.Bool("profile") {
+		searchRequest.Profile = true
+	}
+
+	if c.String("explain-id") != "" {
+		return explain(client, indexName, c.String("explain-id"), searchRequest)
+	}
+
+	if c.Bool("stream") {
+		return searchStream(client, indexName, searchRequest)
+	}
+
+	searchResult, err := client.Search(indexName, searchRequest)
 	if err != nil {
 		return err
 	}
@@ -68,3 +116,66 @@ func execSearch(c *cli.Context) error {
 
 	return nil
 }
+
+func searchStream(client *indexer.GRPCClient, indexName string, searchRequest *indexer.SearchRequest) error {
+	stream, err := client.SearchStream(indexName, searchRequest)
+	if err != nil {
+		return err
+	}
+
+	for {
+		documentMatch, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			st, _ := status.FromError(err)
+
+			return errors.New(st.Message())
+		}
+
+		// index.DocumentMatch -> search.DocumentMatch
+		hit, err := protobuf.ToBleveDocumentMatch(documentMatch)
+		if err != nil {
+			return err
+		}
+
+		// Struct -> map[string]interface{}
+		source, err := protobuf.FromStruct(documentMatch.Source)
+		if err != nil {
+			return err
+		}
+
+		jsonBytes, err := json.Marshal(&indexer.DocumentMatch{DocumentMatch: hit, Source: source})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stdout, string(jsonBytes))
+	}
+}
+
+func explain(client *indexer.GRPCClient, indexName string, id string, searchRequest *indexer.SearchRequest) error {
+	resp, err := client.Explain(indexName, id, searchRequest.Query)
+	if err != nil {
+		return err
+	}
+
+	respMap := map[string]interface{}{
+		"id":      resp.Id,
+		"matched": resp.Matched,
+	}
+	if resp.Matched {
+		respMap["score"] = resp.Score
+		respMap["explanation"] = protobuf.ToBleveExplanation(resp.Explanation)
+	}
+
+	jsonBytes, err := json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(jsonBytes)))
+
+	return nil
+}
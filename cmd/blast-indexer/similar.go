@@ -0,0 +1,82 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/urfave/cli"
+)
+
+func execSimilar(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+
+	id := c.Args().Get(0)
+	if id == "" {
+		err := errors.New("id argument must be set")
+		return err
+	}
+
+	// flags -> MoreLikeThisOptions, SearchRequest
+	values := url.Values{}
+	values.Set("like_fields", c.String("like-fields"))
+	for _, name := range []string{"max-query-terms", "min-term-freq", "min-doc-freq", "max-doc-freq", "size", "from"} {
+		values.Set(strings.Replace(name, "-", "_", -1), strconv.Itoa(c.Int(name)))
+	}
+	values.Set("fields", c.String("fields"))
+
+	options, err := indexer.NewMoreLikeThisOptionsFromValues(values)
+	if err != nil {
+		return err
+	}
+
+	searchRequest, err := indexer.NewSearchRequestFromValues(values)
+	if err != nil {
+		return err
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	searchResult, err := client.Similar(indexName, id, options, searchRequest)
+	if err != nil {
+		return err
+	}
+
+	jsonBytes, err := json.MarshalIndent(&searchResult, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(jsonBytes)))
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/mosuka/blast/protobuf"
+	"github.com/urfave/cli"
+)
+
+func execSimulatePipeline(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	pipelineStr := c.String("pipeline")
+
+	var name, docsStr string
+	if pipelineStr == "" {
+		name = c.Args().Get(0)
+		docsStr = c.Args().Get(1)
+		if name == "" {
+			err := errors.New("name argument or pipeline flag must be set")
+			return err
+		}
+	} else {
+		docsStr = c.Args().Get(0)
+	}
+	if docsStr == "" {
+		err := errors.New("documents argument must be set")
+		return err
+	}
+
+	// string -> map[string]interface{}
+	var pipelineMap map[string]interface{}
+	if pipelineStr != "" {
+		err := json.Unmarshal([]byte(pipelineStr), &pipelineMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	// string -> []map[string]interface{}
+	var docMaps []map[string]interface{}
+	err := json.Unmarshal([]byte(docsStr), &docMaps)
+	if err != nil {
+		return err
+	}
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	resp, err := client.SimulatePipeline(name, pipelineMap, docMaps)
+	if err != nil {
+		return err
+	}
+
+	results := make([]map[string]interface{}, 0, len(resp.Docs))
+	for _, doc := range resp.Docs {
+		if doc.Error != "" {
+			results = append(results, map[string]interface{}{
+				"error": doc.Error,
+			})
+			continue
+		}
+
+		// Struct -> map[string]interface{}
+		fieldsMap, err := protobuf.FromStruct(doc.Fields)
+		if err != nil {
+			return err
+		}
+
+		results = append(results, map[string]interface{}{
+			"fields": fieldsMap,
+		})
+	}
+
+	respBytes, err := json.MarshalIndent(map[string]interface{}{
+		"docs": results,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(respBytes)))
+
+	return nil
+}
@@ -35,6 +35,9 @@ func execStart(c *cli.Context) error {
 	indexMappingFile := c.String("index-mapping-file")
 	indexStorageType := c.String("index-storage-type")
 
+	pipelinesFile := c.String("pipelines-file")
+	managerAddr := c.String("manager-addr")
+
 	logLevel := c.String("log-level")
 	logFilename := c.String("log-file")
 	logMaxSize := c.Int("log-max-size")
@@ -67,7 +70,7 @@ func execStart(c *cli.Context) error {
 		httpAccessLogCompress,
 	)
 
-	svr, err := indexer.NewServer(nodeId, bindAddr, grpcAddr, httpAddr, dataDir, joinAddr, indexMappingFile, indexStorageType, logger, httpAccessLogger)
+	svr, err := indexer.NewServer(nodeId, bindAddr, grpcAddr, httpAddr, dataDir, joinAddr, indexMappingFile, indexStorageType, pipelinesFile, managerAddr, logger, httpAccessLogger)
 	if err != nil {
 		return err
 	}
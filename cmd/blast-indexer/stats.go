@@ -27,6 +27,7 @@ import (
 
 func execStats(c *cli.Context) error {
 	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
 
 	client, err := indexer.NewGRPCClient(grpcAddr)
 	if err != nil {
@@ -39,7 +40,7 @@ func execStats(c *cli.Context) error {
 		}
 	}()
 
-	resp, err := client.GetIndexStats()
+	resp, err := client.GetIndexStats(indexName)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,81 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/mosuka/blast/protobuf"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc/status"
+)
+
+// execSubscribe writes the changes as newline delimited JSON until interrupted.
+func execSubscribe(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+	from := c.Uint64("from")
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	stream, err := client.Subscribe(context.Background(), indexName, from)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			st, _ := status.FromError(err)
+			return errors.New(st.Message())
+		}
+
+		eventMap := map[string]interface{}{
+			"type":       strings.ToLower(event.Type.String()),
+			"raft_index": event.RaftIndex,
+			"index":      event.Index,
+			"id":         event.Id,
+		}
+		if event.Fields != nil {
+			fieldsMap, err := protobuf.FromStruct(event.Fields)
+			if err != nil {
+				return err
+			}
+			eventMap["fields"] = fieldsMap
+		}
+
+		eventBytes, err := json.Marshal(eventMap)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stdout, string(eventBytes))
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mosuka/blast/indexer"
+	"github.com/urfave/cli"
+)
+
+func execSuggest(c *cli.Context) error {
+	grpcAddr := c.String("grpc-addr")
+	indexName := c.String("index")
+	field := c.String("field")
+	size := c.Int("size")
+	fuzzy := c.Bool("fuzzy")
+	fuzziness := c.Int("fuzziness")
+
+	if field == "" {
+		err := errors.New("field flag must be set")
+		return err
+	}
+
+	prefix := c.Args().Get(0)
+
+	client, err := indexer.NewGRPCClient(grpcAddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := client.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	resp, err := client.Suggest(indexName, field, prefix, size, fuzzy || fuzziness > 0, fuzziness)
+	if err != nil {
+		return err
+	}
+
+	suggestions := make([]map[string]interface{}, 0, len(resp.Suggestions))
+	for _, suggestion := range resp.Suggestions {
+		suggestionMap := map[string]interface{}{
+			"term":  suggestion.Term,
+			"count": suggestion.Count,
+		}
+		if fuzzy || fuzziness > 0 {
+			suggestionMap["distance"] = suggestion.Distance
+		}
+		suggestions = append(suggestions, suggestionMap)
+	}
+
+	respBytes, err := json.MarshalIndent(map[string]interface{}{
+		"suggestions": suggestions,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, fmt.Sprintf("%v\n", string(respBytes)))
+
+	return nil
+}
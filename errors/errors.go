@@ -19,5 +19,7 @@ import "errors"
 var (
 	ErrNotFoundLeader = errors.New("does not found leader")
 	ErrNotFound       = errors.New("not found")
+	ErrAlreadyExists  = errors.New("already exists")
 	ErrTimeout        = errors.New("timeout")
+	ErrResyncRequired = errors.New("resync required")
 )
@@ -6,7 +6,7 @@ require (
 	github.com/armon/go-metrics v0.0.0-20180917152333-f0300d1749da // indirect
 	github.com/blevesearch/bleve v0.7.0
 	github.com/blevesearch/blevex v0.0.0-20180227211930-4b158bb555a3 // indirect
-	github.com/blevesearch/cld2 v0.0.0-20150916130542-10f17c049ec9 // indirect
+	github.com/blevesearch/cld2 v0.0.0-20150916130542-10f17c049ec9
 	github.com/blevesearch/snowballstem v0.0.0-20180110192139-26b06a2c243d // indirect
 	github.com/couchbase/ghistogram v0.0.0-20170308220240-d910dd063dd6 // indirect
 	github.com/couchbase/moss v0.0.0-20190322010551-a0cae174c498 // indirect
@@ -0,0 +1,311 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/raft"
+	blasterrors "github.com/mosuka/blast/errors"
+	"github.com/mosuka/blast/protobuf"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+)
+
+const changeBufferSize = 1000
+
+// ErrSubscriberLagged is returned to a subscriber that did not keep up with the changes.
+// It can resume from the raft index following the last change it received.
+var ErrSubscriberLagged = errors.New("subscriber lagged behind, resume from the last raft index")
+
+type changeSubscriber struct {
+	// the index name to receive the changes of, all the indexes if empty
+	index string
+	ch    chan *pbindex.ChangeEvent
+}
+
+// newChangeEvent returns the change event of a document command, or nil for the other commands.
+func newChangeEvent(raftIndex uint64, c *pbindex.IndexCommand) (*pbindex.ChangeEvent, error) {
+	var eventType pbindex.ChangeEvent_Type
+	switch c.Type {
+	case pbindex.IndexCommand_INDEX_DOCUMENT:
+		eventType = pbindex.ChangeEvent_INDEX
+	case pbindex.IndexCommand_UPDATE_DOCUMENT:
+		eventType = pbindex.ChangeEvent_UPDATE
+	case pbindex.IndexCommand_DELETE_DOCUMENT:
+		eventType = pbindex.ChangeEvent_DELETE
+	default:
+		return nil, nil
+	}
+
+	// Any -> Document
+	doc, err := protobuf.DocumentFromAny(c.Data)
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, errors.New("nil")
+	}
+
+	indexName := doc.Index
+	if indexName == "" {
+		indexName = DefaultIndexName
+	}
+
+	event := &pbindex.ChangeEvent{
+		Type:      eventType,
+		RaftIndex: raftIndex,
+		Index:     indexName,
+		Id:        doc.Id,
+	}
+	if eventType != pbindex.ChangeEvent_DELETE {
+		event.Fields = doc.Fields
+	}
+
+	return event, nil
+}
+
+// setApplied records the raft index as applied together with whether its document command was rejected,
+// so that the log replay up to the applied raft index knows all the rejected commands in it.
+func (f *RaftFSM) setApplied(raftIndex uint64, c *pbindex.IndexCommand, rejected bool) {
+	f.rejectedChangesMutex.Lock()
+	defer f.rejectedChangesMutex.Unlock()
+
+	if rejected {
+		switch c.Type {
+		case pbindex.IndexCommand_INDEX_DOCUMENT, pbindex.IndexCommand_UPDATE_DOCUMENT, pbindex.IndexCommand_DELETE_DOCUMENT:
+			f.rejectedChanges[raftIndex] = struct{}{}
+		}
+	}
+	f.appliedIndex = raftIndex
+}
+
+// lastApplied returns the raft index of the last command applied to the FSM. Unlike the applied index of raft,
+// which counts the entries queued for the FSM, the commands up to it have been applied.
+func (f *RaftFSM) lastApplied() uint64 {
+	f.rejectedChangesMutex.RLock()
+	defer f.rejectedChangesMutex.RUnlock()
+
+	return f.appliedIndex
+}
+
+func (f *RaftFSM) isRejectedChange(raftIndex uint64) bool {
+	f.rejectedChangesMutex.RLock()
+	defer f.rejectedChangesMutex.RUnlock()
+
+	_, rejected := f.rejectedChanges[raftIndex]
+
+	return rejected
+}
+
+// snapshotRejectedChanges returns the rejected raft indexes that may still be in the raft log,
+// and forgets the others as the log is compacted up to them.
+func (f *RaftFSM) snapshotRejectedChanges() *pbindex.RejectedChanges {
+	f.rejectedChangesMutex.Lock()
+	defer f.rejectedChangesMutex.Unlock()
+
+	raftIndexes := make([]uint64, 0, len(f.rejectedChanges))
+	for raftIndex := range f.rejectedChanges {
+		if f.appliedIndex > f.trailingLogs && raftIndex < f.appliedIndex-f.trailingLogs {
+			delete(f.rejectedChanges, raftIndex)
+			continue
+		}
+		raftIndexes = append(raftIndexes, raftIndex)
+	}
+	sort.Slice(raftIndexes, func(i, j int) bool { return raftIndexes[i] < raftIndexes[j] })
+
+	return &pbindex.RejectedChanges{
+		RaftIndexes:  raftIndexes,
+		AppliedIndex: f.appliedIndex,
+	}
+}
+
+func (f *RaftFSM) applySetRejectedChanges(rejectedChanges *pbindex.RejectedChanges) interface{} {
+	f.rejectedChangesMutex.Lock()
+	defer f.rejectedChangesMutex.Unlock()
+
+	for _, raftIndex := range rejectedChanges.RaftIndexes {
+		f.rejectedChanges[raftIndex] = struct{}{}
+	}
+	f.appliedIndex = rejectedChanges.AppliedIndex
+
+	return nil
+}
+
+// SubscribeChanges returns a channel receiving the changes of the documents of the index as they are applied,
+// and a function to cancel the subscription. The channel is closed if the subscriber does not keep up.
+func (f *RaftFSM) SubscribeChanges(name string) (<-chan *pbindex.ChangeEvent, func()) {
+	subscriber := &changeSubscriber{
+		index: name,
+		ch:    make(chan *pbindex.ChangeEvent, changeBufferSize),
+	}
+
+	f.changeSubscribersMutex.Lock()
+	f.changeSubscribers[subscriber] = struct{}{}
+	f.changeSubscribersMutex.Unlock()
+
+	cancel := func() {
+		f.changeSubscribersMutex.Lock()
+		defer f.changeSubscribersMutex.Unlock()
+
+		_, exists := f.changeSubscribers[subscriber]
+		if exists {
+			delete(f.changeSubscribers, subscriber)
+			close(subscriber.ch)
+		}
+	}
+
+	return subscriber.ch, cancel
+}
+
+func (f *RaftFSM) publishChange(raftIndex uint64, c *pbindex.IndexCommand) {
+	f.changeSubscribersMutex.Lock()
+	defer f.changeSubscribersMutex.Unlock()
+
+	if len(f.changeSubscribers) <= 0 {
+		return
+	}
+
+	event, err := newChangeEvent(raftIndex, c)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return
+	}
+	if event == nil {
+		return
+	}
+
+	for subscriber := range f.changeSubscribers {
+		if subscriber.index != "" && subscriber.index != event.Index {
+			continue
+		}
+
+		select {
+		case subscriber.ch <- event:
+		default:
+			// a gap in the changes cannot be told apart from no changes, so end the subscription
+			f.logger.Printf("[WARN] change subscriber lagged behind at %d", raftIndex)
+			delete(f.changeSubscribers, subscriber)
+			close(subscriber.ch)
+		}
+	}
+}
+
+// Subscribe passes the changes of the documents of the index, all the indexes if empty, to fn in raft log order
+// until done is closed. With from set, the changes from that raft index are read from the raft log first,
+// and ErrResyncRequired is returned if the log has been compacted past it.
+func (s *RaftServer) Subscribe(name string, from uint64, done <-chan struct{}, fn func(*pbindex.ChangeEvent) error) error {
+	next := from
+	if from > 0 {
+		firstIndex, err := s.logStore.FirstIndex()
+		if err != nil {
+			return err
+		}
+		if from < firstIndex {
+			return blasterrors.ErrResyncRequired
+		}
+
+		// catch up from the log before subscribing, so that the changes applied meanwhile
+		// wait in the log for fn rather than overflow the subscription
+		for {
+			// the commands applied to the FSM are committed, unlike the last entries of the log of a follower,
+			// and have their rejection recorded, unlike the entries raft has only queued for the FSM
+			last := s.fsm.lastApplied()
+			if last < next || last-next < changeBufferSize/2 {
+				break
+			}
+
+			err := s.replayChanges(name, next, last, fn)
+			if err != nil {
+				return err
+			}
+			next = last + 1
+		}
+	}
+
+	// subscribe before reading the rest of the log so that no change falls in between
+	changes, cancel := s.fsm.SubscribeChanges(name)
+	defer cancel()
+
+	last := uint64(0)
+	if from > 0 {
+		last = s.fsm.lastApplied()
+		err := s.replayChanges(name, next, last, fn)
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-done:
+			return nil
+		case event, ok := <-changes:
+			if !ok {
+				return ErrSubscriberLagged
+			}
+			if event.RaftIndex <= last || event.RaftIndex < from {
+				// already read from the log, or before the requested one
+				continue
+			}
+
+			err := fn(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// replayChanges passes the changes from the raft log between the raft indexes to fn,
+// leaving out the commands that were not applied as they were never published.
+func (s *RaftServer) replayChanges(name string, from uint64, to uint64, fn func(*pbindex.ChangeEvent) error) error {
+	for i := from; i <= to; i++ {
+		var l raft.Log
+		err := s.logStore.GetLog(i, &l)
+		if err == raft.ErrLogNotFound {
+			// compacted while reading
+			return blasterrors.ErrResyncRequired
+		}
+		if err != nil {
+			return err
+		}
+		if l.Type != raft.LogCommand || s.fsm.isRejectedChange(l.Index) {
+			continue
+		}
+
+		var c pbindex.IndexCommand
+		err = proto.Unmarshal(l.Data, &c)
+		if err != nil {
+			return err
+		}
+
+		event, err := newChangeEvent(l.Index, &c)
+		if err != nil {
+			return err
+		}
+		if event == nil || (name != "" && event.Index != name) {
+			continue
+		}
+
+		err = fn(event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
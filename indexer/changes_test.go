@@ -0,0 +1,130 @@
+package indexer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mosuka/blast/protobuf"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+)
+
+func TestNewChangeEvent(t *testing.T) {
+	fields, err := protobuf.ToStruct(map[string]interface{}{"title": "a"})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	cases := []struct {
+		commandType pbindex.IndexCommand_Type
+		doc         *pbindex.Document
+		expected    *pbindex.ChangeEvent
+	}{
+		{
+			pbindex.IndexCommand_INDEX_DOCUMENT,
+			&pbindex.Document{Index: "books", Id: "1", Fields: fields},
+			&pbindex.ChangeEvent{Type: pbindex.ChangeEvent_INDEX, RaftIndex: 10, Index: "books", Id: "1", Fields: fields},
+		},
+		{
+			pbindex.IndexCommand_UPDATE_DOCUMENT,
+			&pbindex.Document{Index: "books", Id: "1", Fields: fields},
+			&pbindex.ChangeEvent{Type: pbindex.ChangeEvent_UPDATE, RaftIndex: 10, Index: "books", Id: "1", Fields: fields},
+		},
+		{
+			pbindex.IndexCommand_DELETE_DOCUMENT,
+			&pbindex.Document{Index: "books", Id: "1", Fields: fields},
+			&pbindex.ChangeEvent{Type: pbindex.ChangeEvent_DELETE, RaftIndex: 10, Index: "books", Id: "1"},
+		},
+		{
+			pbindex.IndexCommand_INDEX_DOCUMENT,
+			&pbindex.Document{Id: "1", Fields: fields},
+			&pbindex.ChangeEvent{Type: pbindex.ChangeEvent_INDEX, RaftIndex: 10, Index: DefaultIndexName, Id: "1", Fields: fields},
+		},
+	}
+
+	for _, c := range cases {
+		docAny, err := protobuf.DocumentToAny(c.doc)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		event, err := newChangeEvent(10, &pbindex.IndexCommand{Type: c.commandType, Data: docAny})
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		if !proto.Equal(c.expected, event) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, event)
+		}
+	}
+
+	// commands other than the document changes have no event
+	event, err := newChangeEvent(10, &pbindex.IndexCommand{Type: pbindex.IndexCommand_CREATE_INDEX, Data: newTestMessageAny(t, &empty.Empty{})})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if event != nil {
+		t.Errorf("expected content to see nil, saw %v", event)
+	}
+}
+
+func TestRaftFSMRejectedChanges(t *testing.T) {
+	f, dir := newTestRaftFSM(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	defer func() {
+		_ = f.Close()
+	}()
+
+	applyTestCommand(t, f, 1, pbindex.IndexCommand_CREATE_INDEX, newTestMessageAny(t, &pbindex.IndexInfo{Name: "books"}))
+	applyTestCommand(t, f, 2, pbindex.IndexCommand_INDEX_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Index: "books", Id: "1"}, map[string]interface{}{"title": "a"}))
+	ret := applyTestCommand(t, f, 3, pbindex.IndexCommand_UPDATE_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Index: "books", Id: "2"}, map[string]interface{}{"title": "b"}))
+	if ret == nil {
+		t.Fatalf("expected content to see an error, saw nil")
+	}
+	ret = applyTestCommand(t, f, 4, pbindex.IndexCommand_CREATE_INDEX, newTestMessageAny(t, &pbindex.IndexInfo{Name: "books"}))
+	if ret == nil {
+		t.Fatalf("expected content to see an error, saw nil")
+	}
+
+	data := snapshotTestRaftFSM(t, f)
+
+	applyTestCommand(t, f, 5, pbindex.IndexCommand_UPDATE_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Index: "books", Id: "3"}, map[string]interface{}{"title": "c"}))
+
+	// only the failed document changes are rejected
+	expected := []bool{false, false, true, false, true}
+	actual := make([]bool, 0, len(expected))
+	for raftIndex := uint64(1); raftIndex <= 5; raftIndex++ {
+		actual = append(actual, f.isRejectedChange(raftIndex))
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected content to see %v, saw %v", expected, actual)
+	}
+	if applied := f.lastApplied(); applied != 5 {
+		t.Errorf("expected content to see %v, saw %v", 5, applied)
+	}
+
+	err := f.Restore(ioutil.NopCloser(bytes.NewReader(data)))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// the rejections up to the snapshot are restored, the later ones are dropped
+	expected = []bool{false, false, true, false, false}
+	actual = actual[:0]
+	for raftIndex := uint64(1); raftIndex <= 5; raftIndex++ {
+		actual = append(actual, f.isRejectedChange(raftIndex))
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected content to see %v, saw %v", expected, actual)
+	}
+
+	// the log is replayed up to the raft index the snapshot was taken at
+	if applied := f.lastApplied(); applied != 4 {
+		t.Errorf("expected content to see %v, saw %v", 4, applied)
+	}
+}
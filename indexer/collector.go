@@ -0,0 +1,403 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/document"
+	bleveindex "github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/facet"
+	"github.com/blevesearch/bleve/search/highlight"
+)
+
+const checkDoneEvery = 1024
+
+// afterCollector collects the top hits that sort after a given hit.
+// bleve's TopNCollector can only skip a number of hits, which gets slower the deeper the page is.
+type afterCollector struct {
+	size  int
+	after *search.DocumentMatch
+
+	sort          search.SortOrder
+	cachedScoring []bool
+	cachedDesc    []bool
+	needDocIds    bool
+	neededFields  []string
+
+	facetsBuilder *search.FacetsBuilder
+
+	total    uint64
+	maxScore float64
+	results  search.DocumentMatchCollection
+	took     time.Duration
+}
+
+func newAfterCollector(size int, after *search.DocumentMatch, sortOrder search.SortOrder) *afterCollector {
+	return &afterCollector{
+		size:          size,
+		after:         after,
+		sort:          sortOrder,
+		cachedScoring: sortOrder.CacheIsScore(),
+		cachedDesc:    sortOrder.CacheDescending(),
+		needDocIds:    sortOrder.RequiresDocID(),
+		neededFields:  sortOrder.RequiredFields(),
+		results:       make(search.DocumentMatchCollection, 0, size+1),
+	}
+}
+
+func (c *afterCollector) SetFacetsBuilder(facetsBuilder *search.FacetsBuilder) {
+	c.facetsBuilder = facetsBuilder
+	c.neededFields = append(c.neededFields, facetsBuilder.RequiredFields()...)
+}
+
+func (c *afterCollector) Collect(ctx context.Context, searcher search.Searcher, reader bleveindex.IndexReader) error {
+	start := time.Now()
+
+	searchContext := &search.SearchContext{
+		DocumentMatchPool: search.NewDocumentMatchPool(c.size+1+searcher.DocumentMatchPoolSize(), len(c.sort)),
+	}
+
+	next, err := searcher.Next(searchContext)
+	for err == nil && next != nil {
+		if c.total%checkDoneEvery == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+		}
+
+		err = c.collectSingle(searchContext, reader, next)
+		if err != nil {
+			break
+		}
+
+		next, err = searcher.Next(searchContext)
+	}
+	c.took = time.Since(start)
+	if err != nil {
+		return err
+	}
+
+	// load the external IDs that were not needed for sorting
+	for _, hit := range c.results {
+		if hit.ID == "" {
+			hit.ID, err = reader.ExternalID(hit.IndexInternalID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *afterCollector) collectSingle(ctx *search.SearchContext, reader bleveindex.IndexReader, d *search.DocumentMatch) error {
+	var err error
+
+	// visit field terms for sort and facets
+	if len(c.neededFields) > 0 {
+		if c.facetsBuilder != nil {
+			c.facetsBuilder.StartDoc()
+		}
+		err = reader.DocumentVisitFieldTerms(d.IndexInternalID, c.neededFields, func(field string, term []byte) {
+			if c.facetsBuilder != nil {
+				c.facetsBuilder.UpdateVisitor(field, term)
+			}
+			c.sort.UpdateVisitor(field, term)
+		})
+		if c.facetsBuilder != nil {
+			c.facetsBuilder.EndDoc()
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	c.total++
+	d.HitNumber = c.total
+
+	if d.Score > c.maxScore {
+		c.maxScore = d.Score
+	}
+
+	if c.needDocIds {
+		d.ID, err = reader.ExternalID(d.IndexInternalID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// compute the sort values, keeping the actual score so that the last hit can be used as a cursor
+	c.sort.Value(d)
+	for i, scoring := range c.cachedScoring {
+		if scoring {
+			d.Sort[i] = strconv.FormatFloat(d.Score, 'g', -1, 64)
+		}
+	}
+
+	// skip the hits up to and including the cursor
+	if c.after != nil && c.sort.Compare(c.cachedScoring, c.cachedDesc, d, c.after) <= 0 {
+		ctx.DocumentMatchPool.Put(d)
+		return nil
+	}
+
+	pos := sort.Search(len(c.results), func(i int) bool {
+		return c.sort.Compare(c.cachedScoring, c.cachedDesc, d, c.results[i]) < 0
+	})
+	if pos >= c.size {
+		ctx.DocumentMatchPool.Put(d)
+		return nil
+	}
+
+	c.results = append(c.results, nil)
+	copy(c.results[pos+1:], c.results[pos:])
+	c.results[pos] = d
+
+	if len(c.results) > c.size {
+		ctx.DocumentMatchPool.Put(c.results[c.size])
+		c.results = c.results[:c.size]
+	}
+
+	return nil
+}
+
+func (c *afterCollector) Results() search.DocumentMatchCollection {
+	return c.results
+}
+
+func (c *afterCollector) Total() uint64 {
+	return c.total
+}
+
+func (c *afterCollector) MaxScore() float64 {
+	return c.maxScore
+}
+
+func (c *afterCollector) Took() time.Duration {
+	return c.took
+}
+
+func (c *afterCollector) FacetResults() search.FacetResults {
+	if c.facetsBuilder != nil {
+		return c.facetsBuilder.Results()
+	}
+	return search.FacetResults{}
+}
+
+// validateSearchAfter returns the error of a search after request with the sort values of the last hit
+// of the previous page, before the request is searched.
+func validateSearchAfter(request *bleve.SearchRequest, after []string) error {
+	if request.From > 0 {
+		return errors.New("from must be 0 when search_after is set")
+	}
+
+	sortOrder := request.Sort
+	if len(sortOrder) <= 0 {
+		sortOrder = search.SortOrder{&search.SortScore{Desc: true}}
+	}
+	_, err := newAfterDocumentMatch(sortOrder, after)
+
+	return err
+}
+
+// newAfterDocumentMatch builds the cursor hit from the sort values of the last hit of the previous page.
+func newAfterDocumentMatch(sortOrder search.SortOrder, after []string) (*search.DocumentMatch, error) {
+	if len(after) != len(sortOrder) {
+		return nil, fmt.Errorf("search_after has %d values but the sort has %d", len(after), len(sortOrder))
+	}
+
+	// hits with the same sort values are ordered by hit number, so put the cursor after all of them
+	d := &search.DocumentMatch{
+		Sort:      after,
+		HitNumber: math.MaxUint64,
+	}
+	for i, scoring := range sortOrder.CacheIsScore() {
+		if scoring {
+			score, err := strconv.ParseFloat(after[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("search_after value %q is not a score", after[i])
+			}
+			d.Score = score
+		}
+	}
+
+	return d, nil
+}
+
+// searchReader runs a search against an open index reader, returning the hits that sort after the given hit.
+// It follows bleve's own search so that the results look the same as regular searches.
+func searchReader(ctx context.Context, reader bleveindex.IndexReader, indexMapping mapping.IndexMapping, name string, request *bleve.SearchRequest, after *search.DocumentMatch) (*bleve.SearchResult, error) {
+	start := time.Now()
+
+	sortOrder := request.Sort
+	if len(sortOrder) <= 0 {
+		sortOrder = search.SortOrder{&search.SortScore{Desc: true}}
+	}
+
+	collector := newAfterCollector(request.Size, after, sortOrder)
+
+	searcher, err := request.Query.Searcher(reader, indexMapping, search.SearcherOptions{
+		Explain:            request.Explain,
+		IncludeTermVectors: request.IncludeLocations || request.Highlight != nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = searcher.Close()
+	}()
+
+	if request.Facets != nil {
+		facetsBuilder := search.NewFacetsBuilder(reader)
+		for facetName, facetRequest := range request.Facets {
+			if facetRequest.NumericRanges != nil {
+				facetBuilder := facet.NewNumericFacetBuilder(facetRequest.Field, facetRequest.Size)
+				for _, nr := range facetRequest.NumericRanges {
+					facetBuilder.AddRange(nr.Name, nr.Min, nr.Max)
+				}
+				facetsBuilder.Add(facetName, facetBuilder)
+			} else if facetRequest.DateTimeRanges != nil {
+				facetBuilder := facet.NewDateTimeFacetBuilder(facetRequest.Field, facetRequest.Size)
+				dateTimeParser := indexMapping.DateTimeParserNamed("")
+				for _, dr := range facetRequest.DateTimeRanges {
+					start, end := dr.ParseDates(dateTimeParser)
+					facetBuilder.AddRange(dr.Name, start, end)
+				}
+				facetsBuilder.Add(facetName, facetBuilder)
+			} else {
+				facetBuilder := facet.NewTermsFacetBuilder(facetRequest.Field, facetRequest.Size)
+				facetsBuilder.Add(facetName, facetBuilder)
+			}
+		}
+		collector.SetFacetsBuilder(facetsBuilder)
+	}
+
+	err = collector.Collect(ctx, searcher, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := collector.Results()
+
+	var highlighter highlight.Highlighter
+	if request.Highlight != nil {
+		style := bleve.Config.DefaultHighlighter
+		if request.Highlight.Style != nil {
+			style = *request.Highlight.Style
+		}
+		highlighter, err = bleve.Config.Cache.HighlighterNamed(style)
+		if err != nil {
+			return nil, err
+		}
+		if highlighter == nil {
+			return nil, fmt.Errorf("no highlighter named `%s` registered", style)
+		}
+	}
+
+	for _, hit := range hits {
+		if len(request.Fields) > 0 || highlighter != nil {
+			doc, err := reader.Document(hit.ID)
+			if err != nil {
+				return nil, err
+			}
+			if doc == nil {
+				return nil, bleve.ErrorIndexReadInconsistency
+			}
+			if len(request.Fields) > 0 {
+				loadFields(hit, doc, request.Fields)
+			}
+			if highlighter != nil {
+				highlightFields := request.Highlight.Fields
+				if highlightFields == nil {
+					highlightFields = make([]string, 0, len(hit.Locations))
+					for k := range hit.Locations {
+						highlightFields = append(highlightFields, k)
+					}
+				}
+				for _, hf := range highlightFields {
+					highlighter.BestFragmentsInField(hit, doc, hf, 1)
+				}
+			}
+		}
+		hit.Index = name
+	}
+
+	return &bleve.SearchResult{
+		Status: &bleve.SearchStatus{
+			Total:      1,
+			Failed:     0,
+			Successful: 1,
+			Errors:     make(map[string]error),
+		},
+		Request:  request,
+		Hits:     hits,
+		Total:    collector.Total(),
+		MaxScore: collector.MaxScore(),
+		Took:     time.Since(start),
+		Facets:   collector.FacetResults(),
+	}, nil
+}
+
+func loadFields(hit *search.DocumentMatch, doc *document.Document, fields []string) {
+	for _, f := range fields {
+		for _, docF := range doc.Fields {
+			if f != "*" && docF.Name() != f {
+				continue
+			}
+			var value interface{}
+			switch docF := docF.(type) {
+			case *document.TextField:
+				value = string(docF.Value())
+			case *document.NumericField:
+				num, err := docF.Number()
+				if err == nil {
+					value = num
+				}
+			case *document.DateTimeField:
+				datetime, err := docF.DateTime()
+				if err == nil {
+					value = datetime.Format(time.RFC3339)
+				}
+			case *document.BooleanField:
+				boolean, err := docF.Boolean()
+				if err == nil {
+					value = boolean
+				}
+			case *document.GeoPointField:
+				lon, err := docF.Lon()
+				if err == nil {
+					lat, err := docF.Lat()
+					if err == nil {
+						value = []float64{lon, lat}
+					}
+				}
+			}
+			if value != nil {
+				hit.AddFieldValue(docF.Name(), value)
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"crypto/rand"
+	"sync"
+	"time"
+
+	pbindex "github.com/mosuka/blast/protobuf/index"
+)
+
+// documentIDAlphabet is Crockford's base32, whose characters sort in the order of their values.
+const documentIDAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// documentIDGenerator generates the ids of the documents indexed without one. An id is 48 bits of milliseconds
+// since the epoch followed by 80 random bits, encoded in 26 characters, so that the ids sort in the order they
+// were generated. Within a millisecond the random bits of the previous id are incremented, as in ULID.
+// The last id is only kept in memory by the leader, so the ids are monotonic within one leader process;
+// across a restart or a change of leader they follow the clocks and may be out of order within a millisecond.
+type documentIDGenerator struct {
+	lastTime uint64
+	lastRand [10]byte
+	mutex    sync.Mutex
+}
+
+func newDocumentIDGenerator() *documentIDGenerator {
+	return &documentIDGenerator{}
+}
+
+func (g *documentIDGenerator) generate() (string, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	now := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+	if now <= g.lastTime {
+		// keep the ids ascending when generated within a millisecond or when the clock goes back
+		if !incrementBytes(g.lastRand[:]) {
+			g.lastTime++
+		}
+	} else {
+		_, err := rand.Read(g.lastRand[:])
+		if err != nil {
+			return "", err
+		}
+		g.lastTime = now
+	}
+
+	var b [16]byte
+	for i := 0; i < 6; i++ {
+		b[i] = byte(g.lastTime >> uint(8*(5-i)))
+	}
+	copy(b[6:], g.lastRand[:])
+
+	return encodeDocumentID(b), nil
+}
+
+// incrementBytes adds one to the big endian number, returning false if it overflowed.
+func incrementBytes(b []byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i]++
+		if b[i] != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// encodeDocumentID encodes the 128 bits in 26 base32 characters, the first of which holds only 3 bits.
+func encodeDocumentID(b [16]byte) string {
+	id := make([]byte, 26)
+	for i := range id {
+		v := 0
+		for j := 0; j < 5; j++ {
+			v <<= 1
+			bit := i*5 + j - 2
+			if bit >= 0 && b[bit/8]&(0x80>>uint(bit%8)) != 0 {
+				v |= 1
+			}
+		}
+		id[i] = documentIDAlphabet[v]
+	}
+
+	return string(id)
+}
+
+// assignIDs gives the documents without an id a generated one. It is called on the leader before the documents
+// are proposed, so that all the replicas index them with the same ids.
+func (s *RaftServer) assignIDs(docs []*pbindex.Document) error {
+	for _, doc := range docs {
+		if doc.Id != "" {
+			continue
+		}
+
+		id, err := s.idGenerator.generate()
+		if err != nil {
+			return err
+		}
+		doc.Id = id
+	}
+
+	return nil
+}
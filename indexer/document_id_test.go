@@ -0,0 +1,102 @@
+package indexer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDocumentID(t *testing.T) {
+	cases := []struct {
+		b        [16]byte
+		expected string
+	}{
+		{[16]byte{}, "00000000000000000000000000"},
+		{[16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		{[16]byte{15: 0x01}, "00000000000000000000000001"},
+		{[16]byte{15: 0x20}, "00000000000000000000000010"},
+		{[16]byte{0: 0x80}, "40000000000000000000000000"},
+	}
+
+	for _, c := range cases {
+		actual := encodeDocumentID(c.b)
+		if actual != c.expected {
+			t.Errorf("expected content to see %v, saw %v", c.expected, actual)
+		}
+	}
+}
+
+func TestIncrementBytes(t *testing.T) {
+	cases := []struct {
+		b        []byte
+		expected []byte
+		ok       bool
+	}{
+		{[]byte{0x00, 0x00}, []byte{0x00, 0x01}, true},
+		{[]byte{0x00, 0xff}, []byte{0x01, 0x00}, true},
+		{[]byte{0xfe, 0xff}, []byte{0xff, 0x00}, true},
+		{[]byte{0xff, 0xff}, []byte{0x00, 0x00}, false},
+	}
+
+	for _, c := range cases {
+		ok := incrementBytes(c.b)
+		if ok != c.ok {
+			t.Errorf("expected content to see %v, saw %v", c.ok, ok)
+		}
+		if !bytes.Equal(c.b, c.expected) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, c.b)
+		}
+	}
+}
+
+func TestDocumentIDGeneratorGenerate(t *testing.T) {
+	g := newDocumentIDGenerator()
+
+	for i := 0; i < 100; i++ {
+		id, err := g.generate()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		if len(id) != 26 {
+			t.Errorf("expected content to see %v, saw %v", 26, len(id))
+		}
+		for _, r := range id {
+			if !strings.ContainsRune(documentIDAlphabet, r) {
+				t.Errorf("expected content to see %v in %v, saw %v", string(r), documentIDAlphabet, id)
+			}
+		}
+		if id[0] > '7' {
+			t.Errorf("expected content to see the first character at most 7, saw %v", id)
+		}
+	}
+}
+
+func TestDocumentIDGeneratorGenerateWithinMillisecond(t *testing.T) {
+	cases := []struct {
+		lastRand [10]byte
+	}{
+		{[10]byte{}},
+		{[10]byte{9: 0xfe}},
+		{[10]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe}},
+	}
+
+	for _, c := range cases {
+		// a last time ahead of the clock keeps all the ids within its millisecond, as when the clock goes back
+		g := newDocumentIDGenerator()
+		g.lastTime = 1 << 47
+		g.lastRand = c.lastRand
+
+		prev := ""
+		for i := 0; i < 3; i++ {
+			id, err := g.generate()
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if id <= prev {
+				t.Errorf("expected content to see %v after %v", id, prev)
+			}
+			prev = id
+		}
+	}
+}
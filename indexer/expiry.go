@@ -0,0 +1,280 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"errors"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/document"
+	bleveindex "github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/numeric"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/raft"
+	"github.com/mosuka/blast/protobuf"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// expireAtField is the field the expiry times of the documents are indexed in, regardless of the index mapping.
+	expireAtField = "_expire_at"
+
+	expiryReapInterval  = 10 * time.Second
+	expiryReapBatchSize = 1000
+)
+
+// errNotExpired is returned when deleting an expired document that has been written again in the meantime.
+var errNotExpired = errors.New("not expired")
+
+// newDocument maps the fields to a document, along with the time it expires at in seconds since the epoch if set.
+func newDocument(m mapping.IndexMapping, id string, fields map[string]interface{}, expireAt int64) (*document.Document, error) {
+	doc := document.NewDocument(id)
+	err := m.MapDocument(doc, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if expireAt > 0 {
+		doc.AddField(document.NewNumericFieldWithIndexingOptions(expireAtField, []uint64{}, float64(expireAt), document.IndexField|document.DocValues))
+	}
+
+	return doc, nil
+}
+
+// readExpireAt returns the time the document expires at in seconds since the epoch, 0 if it never expires.
+func readExpireAt(r bleveindex.IndexReader, id string) (int64, error) {
+	internalId, err := r.InternalID(id)
+	if err != nil {
+		return 0, err
+	}
+	if internalId == nil {
+		return 0, nil
+	}
+
+	expireAt := int64(0)
+	err = r.DocumentVisitFieldTerms(internalId, []string{expireAtField}, func(field string, term []byte) {
+		prefixCoded := numeric.PrefixCoded(term)
+		shift, err := prefixCoded.Shift()
+		if err != nil || shift != 0 {
+			// only the full precision term holds the exact value
+			return
+		}
+		i64, err := prefixCoded.Int64()
+		if err != nil {
+			return
+		}
+		expireAt = int64(numeric.Int64ToFloat64(i64))
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return expireAt, nil
+}
+
+// ExpireAt returns the time the document expires at in seconds since the epoch, 0 if it never expires.
+func (b *Index) ExpireAt(id string) (int64, error) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		return 0, err
+	}
+	r, err := i.Reader()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		err := r.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	return readExpireAt(r, id)
+}
+
+// Expired returns the ids of up to size documents that expired at or before now, in seconds since the epoch.
+func (b *Index) Expired(now int64, size int) ([]string, error) {
+	start := time.Now()
+	defer func() {
+		b.logger.Printf("[DEBUG] expired %d %f", now, float64(time.Since(start))/float64(time.Second))
+	}()
+
+	max := float64(now)
+	inclusive := true
+	q := query.NewNumericRangeInclusiveQuery(nil, &max, nil, &inclusive)
+	q.SetField(expireAtField)
+
+	request := bleve.NewSearchRequestOptions(q, size, 0, false)
+	request.SortBy([]string{expireAtField})
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	result, err := b.index.Search(request)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.ID)
+	}
+
+	return ids, nil
+}
+
+// DefaultTTL returns the seconds the documents written without a ttl or expire_at expire in, 0 if they never expire.
+func (b *Index) DefaultTTL() int64 {
+	b.defaultTTLMutex.RLock()
+	defer b.defaultTTLMutex.RUnlock()
+
+	return b.defaultTTL
+}
+
+func (b *Index) SetDefaultTTL(ttl int64) {
+	b.defaultTTLMutex.Lock()
+	defer b.defaultTTLMutex.Unlock()
+
+	b.defaultTTL = ttl
+}
+
+// resolveExpireAt resolves the ttl of the document, or with useDefault set, the default ttl of its index,
+// into the time it expires at, so that all the replicas agree on it.
+func (s *RaftServer) resolveExpireAt(doc *pbindex.Document, useDefault bool) error {
+	if doc.Ttl < 0 || doc.ExpireAt < 0 {
+		return errNegativeTTL
+	}
+
+	ttl := doc.Ttl
+	doc.Ttl = 0
+	if doc.ExpireAt > 0 {
+		return nil
+	}
+
+	if ttl == 0 && useDefault {
+		defaultTTL, err := s.fsm.DefaultTTL(doc.Index)
+		if err != nil {
+			// e.g. the index does not exist, which is reported when the document is applied
+			return nil
+		}
+		ttl = defaultTTL
+	}
+	if ttl > 0 {
+		doc.ExpireAt = time.Now().Unix() + ttl
+	}
+
+	return nil
+}
+
+// reapExpired periodically deletes the expired documents through raft while this node is the leader.
+func (s *RaftServer) reapExpired() {
+	ticker := time.NewTicker(expiryReapInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case now := <-ticker.C:
+			if s.raft.State() != raft.Leader {
+				continue
+			}
+
+			s.reapExpiredAt(now.Unix())
+		}
+	}
+}
+
+func (s *RaftServer) reapExpiredAt(now int64) {
+	start := time.Now()
+	defer RecordMetrics(start, "reap_expired")
+
+	for _, name := range s.fsm.IndexNames() {
+		for {
+			ids, err := s.fsm.Expired(name, now, expiryReapBatchSize)
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+				ExpiryReaperErrorsTotal.Inc()
+				break
+			}
+
+			deleted := 0
+			for _, id := range ids {
+				err := s.deleteExpired(name, id, now)
+				if err == errNotExpired {
+					continue
+				}
+				if err == raft.ErrNotLeader || err == raft.ErrLeadershipLost {
+					// the new leader takes over
+					return
+				}
+				if err != nil {
+					s.logger.Printf("[ERR] %v", err)
+					ExpiryReaperErrorsTotal.Inc()
+					continue
+				}
+
+				deleted++
+				ExpiredDocumentsTotal.With(prometheus.Labels{"index": name}).Inc()
+			}
+
+			if len(ids) < expiryReapBatchSize || deleted == 0 {
+				break
+			}
+		}
+	}
+}
+
+// deleteExpired deletes the document through raft unless it has been written again since it expired.
+func (s *RaftServer) deleteExpired(name string, id string, now int64) error {
+	// Document -> Any
+	docAny, err := protobuf.DocumentToAny(&pbindex.Document{
+		Index:    name,
+		Id:       id,
+		ExpireAt: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	c := &pbindex.IndexCommand{
+		Type: pbindex.IndexCommand_DELETE_DOCUMENT,
+		Data: docAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
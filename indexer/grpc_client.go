@@ -19,10 +19,14 @@ import (
 	"errors"
 	"log"
 	"math"
+	"time"
 
-	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/empty"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	blasterrors "github.com/mosuka/blast/errors"
 	"github.com/mosuka/blast/protobuf"
 	"github.com/mosuka/blast/protobuf/index"
@@ -147,38 +151,362 @@ func (c *GRPCClient) Get(doc *index.Document, opts ...grpc.CallOption) (*index.D
 	return retDoc, nil
 }
 
-func (c *GRPCClient) Search(searchRequest *bleve.SearchRequest, opts ...grpc.CallOption) (*bleve.SearchResult, error) {
-	// bleve.SearchRequest -> Any
-	searchRequestAny := &any.Any{}
-	err := protobuf.UnmarshalAny(searchRequest, searchRequestAny)
+func (c *GRPCClient) MultiGet(req *index.MultiGetRequest, opts ...grpc.CallOption) (*index.MultiGetResponse, error) {
+	resp, err := c.client.MultiGet(c.ctx, req, opts...)
 	if err != nil {
-		return nil, err
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
 	}
 
-	req := &index.SearchRequest{
-		SearchRequest: searchRequestAny,
+	return resp, nil
+}
+
+func (c *GRPCClient) Bulk(items []*index.BulkItem, opts ...grpc.CallOption) (*index.BulkResponse, error) {
+	req := &index.BulkRequest{
+		Items: items,
 	}
 
-	resp, err := c.client.Search(c.ctx, req, opts...)
+	resp, err := c.client.Bulk(c.ctx, req, opts...)
 	if err != nil {
 		st, _ := status.FromError(err)
 
 		return nil, errors.New(st.Message())
 	}
 
-	// Any -> bleve.SearchResult
-	searchResultInstance, err := protobuf.MarshalAny(resp.SearchResult)
+	return resp, nil
+}
+
+// Export streams the original documents of an index, optionally only the ones matching the query.
+func (c *GRPCClient) Export(indexName string, q query.Query, opts ...grpc.CallOption) (index.Index_ExportClient, error) {
+	req := &index.ExportRequest{
+		Index: indexName,
+	}
+	if q != nil {
+		// query.Query -> index.Query
+		var err error
+		req.Query, err = protobuf.FromBleveQuery(q)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stream, err := c.client.Export(c.ctx, req, opts...)
 	if err != nil {
 		st, _ := status.FromError(err)
 
 		return nil, errors.New(st.Message())
 	}
-	if searchResultInstance == nil {
+
+	return stream, nil
+}
+
+// Explain explains the score of a document for the query. The response is not matched if the document does not match.
+func (c *GRPCClient) Explain(indexName string, id string, q query.Query, opts ...grpc.CallOption) (*index.ExplainResponse, error) {
+	// query.Query -> index.Query
+	pbQuery, err := protobuf.FromBleveQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &index.ExplainRequest{
+		Index: indexName,
+		Id:    id,
+		Query: pbQuery,
+	}
+
+	resp, err := c.client.Explain(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return resp, nil
+}
+
+func (c *GRPCClient) Analyze(indexName string, text string, analyzerName string, field string, opts ...grpc.CallOption) (*index.AnalyzeResponse, error) {
+	req := &index.AnalyzeRequest{
+		Index:    indexName,
+		Text:     text,
+		Analyzer: analyzerName,
+		Field:    field,
+	}
+
+	resp, err := c.client.Analyze(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return resp, nil
+}
+
+func (c *GRPCClient) FieldStats(indexName string, opts ...grpc.CallOption) (*index.FieldStatsResponse, error) {
+	req := &index.FieldStatsRequest{
+		Index: indexName,
+	}
+
+	resp, err := c.client.FieldStats(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return resp, nil
+}
+
+// Suggest returns the terms of the field starting with the prefix, or with fuzzy set,
+// the terms within the fuzziness edit distance of it (0 for the default distance).
+func (c *GRPCClient) Suggest(indexName string, field string, prefix string, size int, fuzzy bool, fuzziness int, opts ...grpc.CallOption) (*index.SuggestResponse, error) {
+	req := &index.SuggestRequest{
+		Index:     indexName,
+		Field:     field,
+		Prefix:    prefix,
+		Size:      int32(size),
+		Fuzzy:     fuzzy,
+		Fuzziness: int32(fuzziness),
+	}
+
+	resp, err := c.client.Suggest(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return resp, nil
+}
+
+// Count returns the number of documents matching the query, all of them if the query is nil.
+func (c *GRPCClient) Count(indexName string, q query.Query, opts ...grpc.CallOption) (*index.CountResponse, error) {
+	req := &index.CountRequest{
+		Index: indexName,
+	}
+	if q != nil {
+		// query.Query -> index.Query
+		var err error
+		req.Query, err = protobuf.FromBleveQuery(q)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.client.Count(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return resp, nil
+}
+
+func (c *GRPCClient) Search(indexName string, searchRequest *SearchRequest, opts ...grpc.CallOption) (*SearchResult, error) {
+	// bleve.SearchRequest -> index.SearchRequest
+	req, err := protobuf.FromBleveSearchRequest(searchRequest.SearchRequest)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
 		return nil, errors.New("nil")
 	}
-	searchResult := searchResultInstance.(*bleve.SearchResult)
+	req.Index = indexName
+	req.IncludeSource = searchRequest.IncludeSource
+	req.SourceIncludes = searchRequest.SourceIncludes
+	req.SourceExcludes = searchRequest.SourceExcludes
+	req.SearchAfter = searchRequest.SearchAfter
+	req.Profile = searchRequest.Profile
+	if searchRequest.Scroll != "" {
+		ttl, err := time.ParseDuration(searchRequest.Scroll)
+		if err != nil {
+			return nil, err
+		}
+		req.Scroll = ptypes.DurationProto(ttl)
+	}
+
+	resp, err := c.client.Search(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return toSearchResult(resp)
+}
+
+// Similar searches the documents similar to the document with the id. The query of the search request is ignored.
+func (c *GRPCClient) Similar(indexName string, id string, options *MoreLikeThisOptions, searchRequest *SearchRequest, opts ...grpc.CallOption) (*SearchResult, error) {
+	if options == nil {
+		options = NewMoreLikeThisOptions()
+	}
+
+	req := &index.SimilarRequest{
+		Index:         indexName,
+		Id:            id,
+		LikeFields:    options.Fields,
+		MaxQueryTerms: int32(options.MaxQueryTerms),
+		MinTermFreq:   int32(options.MinTermFreq),
+		MinDocFreq:    int32(options.MinDocFreq),
+		MaxDocFreq:    int32(options.MaxDocFreq),
+	}
+
+	if searchRequest != nil {
+		// bleve.SearchRequest -> index.SearchRequest
+		var err error
+		req.SearchRequest, err = protobuf.FromBleveSearchRequest(searchRequest.SearchRequest)
+		if err != nil {
+			return nil, err
+		}
+		if req.SearchRequest == nil {
+			return nil, errors.New("nil")
+		}
+		req.SearchRequest.IncludeSource = searchRequest.IncludeSource
+		req.SearchRequest.SourceIncludes = searchRequest.SourceIncludes
+		req.SearchRequest.SourceExcludes = searchRequest.SourceExcludes
+	}
+
+	resp, err := c.client.Similar(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return toSearchResult(resp)
+}
+
+func (c *GRPCClient) Scroll(scrollId string, ttl time.Duration, opts ...grpc.CallOption) (*SearchResult, error) {
+	req := &index.ScrollRequest{
+		ScrollId: scrollId,
+	}
+	if ttl > 0 {
+		req.Scroll = ptypes.DurationProto(ttl)
+	}
+
+	resp, err := c.client.Scroll(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return toSearchResult(resp)
+}
+
+func (c *GRPCClient) ClearScroll(scrollId string, opts ...grpc.CallOption) error {
+	req := &index.ScrollRequest{
+		ScrollId: scrollId,
+	}
+
+	_, err := c.client.ClearScroll(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return blasterrors.ErrNotFound
+		default:
+			return errors.New(st.Message())
+		}
+	}
+
+	return nil
+}
+
+// SearchStream streams all the hits of a search. Size and from are ignored.
+func (c *GRPCClient) SearchStream(indexName string, searchRequest *SearchRequest, opts ...grpc.CallOption) (index.Index_SearchStreamClient, error) {
+	// bleve.SearchRequest -> index.SearchRequest
+	req, err := protobuf.FromBleveSearchRequest(searchRequest.SearchRequest)
+	if err != nil {
+		return nil, err
+	}
+	req.Index = indexName
+	req.IncludeSource = searchRequest.IncludeSource
+	req.SourceIncludes = searchRequest.SourceIncludes
+	req.SourceExcludes = searchRequest.SourceExcludes
+
+	stream, err := c.client.SearchStream(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		return nil, errors.New(st.Message())
+	}
+
+	return stream, nil
+}
+
+func toSearchResult(resp *index.SearchResponse) (*SearchResult, error) {
+	// index.SearchResponse -> bleve.SearchResult
+	searchResult, err := protobuf.ToBleveSearchResult(resp)
+	if err != nil {
+		return nil, err
+	}
 
-	return searchResult, nil
+	// Struct -> map[string]interface{}
+	sources := make([]map[string]interface{}, len(resp.Hits))
+	for i, hit := range resp.Hits {
+		sources[i], err = protobuf.FromStruct(hit.Source)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := NewSearchResult(searchResult, sources)
+	result.ScrollId = resp.ScrollId
+	result.Profile, err = NewSearchProfile(resp.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func (c *GRPCClient) Index(docs []*index.Document, opts ...grpc.CallOption) (*index.UpdateResult, error) {
@@ -199,7 +527,16 @@ func (c *GRPCClient) Index(docs []*index.Document, opts ...grpc.CallOption) (*in
 
 	rep, err := stream.CloseAndRecv()
 	if err != nil {
-		return nil, err
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		case codes.InvalidArgument:
+			return nil, &PipelineError{message: st.Message()}
+		default:
+			return nil, errors.New(st.Message())
+		}
 	}
 
 	return rep, nil
@@ -223,19 +560,393 @@ func (c *GRPCClient) Delete(docs []*index.Document, opts ...grpc.CallOption) (*i
 
 	rep, err := stream.CloseAndRecv()
 	if err != nil {
-		return nil, err
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
 	}
 
 	return rep, nil
 }
 
-func (c *GRPCClient) GetIndexStats(opts ...grpc.CallOption) (*index.Stats, error) {
-	stats, err := c.client.GetStats(c.ctx, &empty.Empty{}, opts...)
+func (c *GRPCClient) GetIndexStats(indexName string, opts ...grpc.CallOption) (*index.Stats, error) {
+	stats, err := c.client.GetStats(c.ctx, &index.IndexInfo{Name: indexName}, opts...)
 	if err != nil {
 		st, _ := status.FromError(err)
 
-		return nil, errors.New(st.Message())
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
 	}
 
 	return stats, nil
 }
+
+func (c *GRPCClient) GetIndexMapping(indexName string, opts ...grpc.CallOption) (*mapping.IndexMappingImpl, error) {
+	resp, err := c.client.GetIndexMapping(c.ctx, &index.IndexInfo{Name: indexName}, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	// Any -> mapping.IndexMappingImpl
+	indexMappingInstance, err := protobuf.MarshalAny(resp.IndexMapping)
+	if err != nil {
+		return nil, err
+	}
+	if indexMappingInstance == nil {
+		return nil, errors.New("nil")
+	}
+
+	return indexMappingInstance.(*mapping.IndexMappingImpl), nil
+}
+
+func (c *GRPCClient) PutIndexMapping(indexName string, indexMapping interface{}, opts ...grpc.CallOption) error {
+	var req *index.IndexMapping
+	switch v := indexMapping.(type) {
+	case *index.IndexMapping:
+		req = &index.IndexMapping{
+			IndexMapping: v.IndexMapping,
+			Index:        indexName,
+		}
+	case *mapping.IndexMappingImpl:
+		// mapping.IndexMappingImpl -> Any
+		indexMappingAny := &any.Any{}
+		err := protobuf.UnmarshalAny(v, indexMappingAny)
+		if err != nil {
+			return err
+		}
+		req = &index.IndexMapping{
+			IndexMapping: indexMappingAny,
+			Index:        indexName,
+		}
+	default:
+		return errors.New("unsupported index mapping type")
+	}
+
+	_, err := c.client.PutIndexMapping(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return blasterrors.ErrNotFound
+		default:
+			return errors.New(st.Message())
+		}
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) GetReindexStatus(indexName string, opts ...grpc.CallOption) (*index.ReindexStatus, error) {
+	reindexStatus, err := c.client.GetReindexStatus(c.ctx, &index.IndexInfo{Name: indexName}, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return reindexStatus, nil
+}
+
+func (c *GRPCClient) CreateIndex(indexInfo *index.IndexInfo, opts ...grpc.CallOption) error {
+	_, err := c.client.CreateIndex(c.ctx, indexInfo, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.AlreadyExists:
+			return blasterrors.ErrAlreadyExists
+		default:
+			return errors.New(st.Message())
+		}
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) DeleteIndex(indexInfo *index.IndexInfo, opts ...grpc.CallOption) error {
+	_, err := c.client.DeleteIndex(c.ctx, indexInfo, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return blasterrors.ErrNotFound
+		default:
+			return errors.New(st.Message())
+		}
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) GetIndex(indexName string, opts ...grpc.CallOption) (*index.IndexInfo, error) {
+	indexInfo, err := c.client.GetIndex(c.ctx, &index.IndexInfo{Name: indexName}, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return indexInfo, nil
+}
+
+func (c *GRPCClient) ListIndexes(opts ...grpc.CallOption) (*index.IndexList, error) {
+	indexList, err := c.client.ListIndexes(c.ctx, &empty.Empty{}, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		return nil, errors.New(st.Message())
+	}
+
+	return indexList, nil
+}
+
+func (c *GRPCClient) PutAlias(alias *index.Alias, opts ...grpc.CallOption) error {
+	_, err := c.client.PutAlias(c.ctx, alias, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		return errors.New(st.Message())
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) DeleteAlias(alias *index.Alias, opts ...grpc.CallOption) error {
+	_, err := c.client.DeleteAlias(c.ctx, alias, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return blasterrors.ErrNotFound
+		default:
+			return errors.New(st.Message())
+		}
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) SwapAlias(swapAlias *index.SwapAliasRequest, opts ...grpc.CallOption) error {
+	_, err := c.client.SwapAlias(c.ctx, swapAlias, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return blasterrors.ErrNotFound
+		default:
+			return errors.New(st.Message())
+		}
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) PutPercolatorQuery(percolatorQuery *index.PercolatorQuery, opts ...grpc.CallOption) error {
+	_, err := c.client.PutPercolatorQuery(c.ctx, percolatorQuery, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return blasterrors.ErrNotFound
+		default:
+			return errors.New(st.Message())
+		}
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) DeletePercolatorQuery(percolatorQuery *index.PercolatorQuery, opts ...grpc.CallOption) error {
+	_, err := c.client.DeletePercolatorQuery(c.ctx, percolatorQuery, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return blasterrors.ErrNotFound
+		default:
+			return errors.New(st.Message())
+		}
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) ListPercolatorQueries(indexName string, opts ...grpc.CallOption) (*index.PercolatorQueryList, error) {
+	resp, err := c.client.ListPercolatorQueries(c.ctx, &index.PercolatorQuery{Index: indexName}, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return resp, nil
+}
+
+// Percolate returns the ids of the percolator queries of the index matching the document fields.
+func (c *GRPCClient) Percolate(indexName string, fieldsMap map[string]interface{}, opts ...grpc.CallOption) ([]string, error) {
+	// map[string]interface{} -> Struct
+	fields, err := protobuf.ToStruct(fieldsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &index.PercolateRequest{
+		Index:  indexName,
+		Fields: fields,
+	}
+
+	resp, err := c.client.Percolate(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return resp.QueryIds, nil
+}
+
+// SubscribePercolations subscribes to the percolations of the writes to the index, all the indexes if empty.
+// The subscription lasts until the context is canceled.
+func (c *GRPCClient) SubscribePercolations(ctx context.Context, indexName string, opts ...grpc.CallOption) (index.Index_SubscribePercolationsClient, error) {
+	req := &index.SubscribePercolationsRequest{
+		Index: indexName,
+	}
+
+	stream, err := c.client.SubscribePercolations(ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		return nil, errors.New(st.Message())
+	}
+
+	return stream, nil
+}
+
+// SimulatePipeline transforms the documents with the named pipeline, or with the given pipeline if the name is empty.
+func (c *GRPCClient) SimulatePipeline(name string, pipelineConfig map[string]interface{}, docs []map[string]interface{}, opts ...grpc.CallOption) (*index.SimulatePipelineResponse, error) {
+	req := &index.SimulatePipelineRequest{
+		Name: name,
+		Docs: make([]*structpb.Struct, 0, len(docs)),
+	}
+
+	if pipelineConfig != nil {
+		// map[string]interface{} -> Struct
+		pipelineStruct, err := protobuf.ToStruct(pipelineConfig)
+		if err != nil {
+			return nil, err
+		}
+		req.Pipeline = pipelineStruct
+	}
+
+	for _, fields := range docs {
+		// map[string]interface{} -> Struct
+		fieldsStruct, err := protobuf.ToStruct(fields)
+		if err != nil {
+			return nil, err
+		}
+		req.Docs = append(req.Docs, fieldsStruct)
+	}
+
+	resp, err := c.client.SimulatePipeline(c.ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		case codes.InvalidArgument:
+			return nil, &PipelineError{message: st.Message()}
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return resp, nil
+}
+
+// Subscribe subscribes to the changes of the documents of the index, all the indexes if empty, in raft log order.
+// With from set, the changes are replayed from that raft index. The stream fails with codes.OutOfRange
+// if the raft log no longer holds it. The subscription lasts until the context is canceled.
+func (c *GRPCClient) Subscribe(ctx context.Context, indexName string, from uint64, opts ...grpc.CallOption) (index.Index_SubscribeClient, error) {
+	req := &index.SubscribeRequest{
+		Index:         indexName,
+		FromRaftIndex: from,
+	}
+
+	stream, err := c.client.Subscribe(ctx, req, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		return nil, errors.New(st.Message())
+	}
+
+	return stream, nil
+}
+
+func (c *GRPCClient) GetAlias(name string, opts ...grpc.CallOption) (*index.Alias, error) {
+	alias, err := c.client.GetAlias(c.ctx, &index.Alias{Name: name}, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, blasterrors.ErrNotFound
+		default:
+			return nil, errors.New(st.Message())
+		}
+	}
+
+	return alias, nil
+}
+
+func (c *GRPCClient) ListAliases(opts ...grpc.CallOption) (*index.AliasList, error) {
+	aliasList, err := c.client.ListAliases(c.ctx, &empty.Empty{}, opts...)
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		return nil, errors.New(st.Message())
+	}
+
+	return aliasList, nil
+}
@@ -18,10 +18,15 @@ import (
 	"context"
 	"io"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/blevesearch/bleve"
-	"github.com/golang/protobuf/ptypes/any"
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/mosuka/blast/errors"
 	"github.com/mosuka/blast/protobuf"
@@ -31,6 +36,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const searchStreamPageSize = 1000
+
 type GRPCService struct {
 	raftServer *RaftServer
 
@@ -136,6 +143,278 @@ func (s *GRPCService) Get(ctx context.Context, req *index.Document) (*index.Docu
 	return resp, nil
 }
 
+func (s *GRPCService) MultiGet(ctx context.Context, req *index.MultiGetRequest) (*index.MultiGetResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "multi_get")
+
+	s.logger.Printf("[INFO] multi get %v", req)
+
+	resp := &index.MultiGetResponse{}
+
+	if len(req.Ids) <= 0 {
+		return resp, status.Error(codes.InvalidArgument, "ids must be set")
+	}
+
+	resp, err := s.raftServer.MultiGet(req)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return &index.MultiGetResponse{}, status.Error(codes.NotFound, err.Error())
+		default:
+			return &index.MultiGetResponse{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) Bulk(ctx context.Context, req *index.BulkRequest) (*index.BulkResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "bulk")
+
+	s.logger.Printf("[INFO] bulk %d items", len(req.Items))
+
+	resp, err := s.raftServer.Bulk(req)
+	if err != nil {
+		return &index.BulkResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) Export(req *index.ExportRequest, stream index.Index_ExportServer) error {
+	start := time.Now()
+	defer RecordMetrics(start, "export")
+
+	s.logger.Printf("[INFO] export %v", req)
+
+	var q query.Query
+	if req.Query != nil {
+		// index.Query -> query.Query
+		var err error
+		q, err = protobuf.ToBleveQuery(req.Query)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err := s.raftServer.Export(req.Index, q, func(id string, fieldsMap map[string]interface{}) error {
+		// map[string]interface{} -> Struct
+		fields, err := protobuf.ToStruct(fieldsMap)
+		if err != nil {
+			return err
+		}
+
+		return stream.Send(&index.Document{
+			Id:     id,
+			Fields: fields,
+			Index:  req.Index,
+		})
+	})
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return status.Error(codes.NotFound, err.Error())
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (s *GRPCService) Count(ctx context.Context, req *index.CountRequest) (*index.CountResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "count")
+
+	s.logger.Printf("[INFO] count %v", req)
+
+	resp := &index.CountResponse{}
+
+	var q query.Query
+	if req.Query != nil {
+		// index.Query -> query.Query
+		var err error
+		q, err = protobuf.ToBleveQuery(req.Query)
+		if err != nil {
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	count, docCount, err := s.raftServer.Count(req.Index, q)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	resp.Count = count
+	resp.DocCount = docCount
+
+	return resp, nil
+}
+
+func (s *GRPCService) Explain(ctx context.Context, req *index.ExplainRequest) (*index.ExplainResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "explain")
+
+	s.logger.Printf("[INFO] explain %v", req)
+
+	resp := &index.ExplainResponse{}
+
+	if req.Query == nil {
+		return resp, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	// index.Query -> query.Query
+	q, err := protobuf.ToBleveQuery(req.Query)
+	if err != nil {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	match, err := s.raftServer.Explain(req.Index, req.Id, q)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	resp.Index = req.Index
+	resp.Id = req.Id
+	if match != nil {
+		resp.Matched = true
+		resp.Score = match.Score
+		resp.Explanation = protobuf.FromBleveExplanation(match.Expl)
+	}
+
+	return resp, nil
+}
+
+var tokenTypeNames = map[analysis.TokenType]string{
+	analysis.AlphaNumeric: "alphanumeric",
+	analysis.Ideographic:  "ideographic",
+	analysis.Numeric:      "numeric",
+	analysis.DateTime:     "datetime",
+	analysis.Shingle:      "shingle",
+	analysis.Single:       "single",
+	analysis.Double:       "double",
+	analysis.Boolean:      "boolean",
+}
+
+func (s *GRPCService) Analyze(ctx context.Context, req *index.AnalyzeRequest) (*index.AnalyzeResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "analyze")
+
+	s.logger.Printf("[INFO] analyze %v", req)
+
+	resp := &index.AnalyzeResponse{}
+
+	analyzerName, tokens, err := s.raftServer.Analyze(req.Index, req.Text, req.Analyzer, req.Field)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			// e.g. an unknown analyzer
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	resp.Analyzer = analyzerName
+	resp.Tokens = make([]*index.Token, 0, len(tokens))
+	for _, token := range tokens {
+		resp.Tokens = append(resp.Tokens, &index.Token{
+			Term:     string(token.Term),
+			Position: int32(token.Position),
+			Start:    int32(token.Start),
+			End:      int32(token.End),
+			Type:     tokenTypeNames[token.Type],
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) FieldStats(ctx context.Context, req *index.FieldStatsRequest) (*index.FieldStatsResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "field_stats")
+
+	s.logger.Printf("[INFO] field stats %v", req)
+
+	resp := &index.FieldStatsResponse{}
+
+	fieldStats, docCount, err := s.raftServer.FieldStats(req.Index)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	resp.DocCount = docCount
+	resp.Fields = make([]*index.FieldStats, 0, len(fieldStats))
+	for _, stats := range fieldStats {
+		resp.Fields = append(resp.Fields, &index.FieldStats{
+			Name:       stats.Name,
+			DocCount:   stats.DocCount,
+			TermCount:  stats.TermCount,
+			SumDocFreq: stats.SumDocFreq,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) Suggest(ctx context.Context, req *index.SuggestRequest) (*index.SuggestResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "suggest")
+
+	s.logger.Printf("[INFO] suggest %v", req)
+
+	resp := &index.SuggestResponse{}
+
+	if req.Field == "" {
+		return resp, status.Error(codes.InvalidArgument, "field is required")
+	}
+
+	fuzziness := 0
+	if req.Fuzzy {
+		fuzziness = int(req.Fuzziness)
+		if fuzziness <= 0 {
+			fuzziness = DefaultSuggestFuzziness
+		}
+	}
+
+	suggestions, err := s.raftServer.Suggest(req.Index, req.Field, req.Prefix, int(req.Size), fuzziness)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	resp.Suggestions = make([]*index.Suggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, &index.Suggestion{
+			Term:     suggestion.Term,
+			Count:    suggestion.Count,
+			Distance: int32(suggestion.Distance),
+		})
+	}
+
+	return resp, nil
+}
+
 func (s *GRPCService) Search(ctx context.Context, req *index.SearchRequest) (*index.SearchResponse, error) {
 	start := time.Now()
 	defer RecordMetrics(start, "search")
@@ -144,88 +423,847 @@ func (s *GRPCService) Search(ctx context.Context, req *index.SearchRequest) (*in
 
 	resp := &index.SearchResponse{}
 
-	// Any -> bleve.SearchRequest
-	searchRequest, err := protobuf.MarshalAny(req.SearchRequest)
+	profiler := newSearchProfiler(req.Profile, s.raftServer.Node.Id, start)
+
+	// index.SearchRequest -> bleve.SearchRequest
+	searchRequest, err := protobuf.ToBleveSearchRequest(req)
 	if err != nil {
 		return resp, status.Error(codes.InvalidArgument, err.Error())
 	}
+	profiler.phase("parse_request")
+
+	var source *SourceFilter
+	if req.IncludeSource {
+		source = &SourceFilter{
+			Includes: req.SourceIncludes,
+			Excludes: req.SourceExcludes,
+		}
+	}
+
+	var searchResult *bleve.SearchResult
+	scrollId := ""
+	if req.Scroll != nil {
+		ttl, err := ptypes.Duration(req.Scroll)
+		if err != nil {
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+		searchResult, scrollId, err = s.raftServer.OpenScroll(req.Index, searchRequest, ttl, source)
+	} else if len(req.SearchAfter) > 0 {
+		err = validateSearchAfter(searchRequest, req.SearchAfter)
+		if err != nil {
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+		searchResult, err = s.raftServer.SearchAfter(req.Index, searchRequest, req.SearchAfter)
+	} else {
+		searchResult, err = s.raftServer.Search(req.Index, searchRequest)
+	}
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	profiler.phase("search")
 
-	searchResult, err := s.raftServer.Search(searchRequest.(*bleve.SearchRequest))
+	// bleve.SearchResult -> index.SearchResponse
+	resp, err = protobuf.FromBleveSearchResult(searchResult)
 	if err != nil {
-		return resp, status.Error(codes.Internal, err.Error())
+		return &index.SearchResponse{}, status.Error(codes.Internal, err.Error())
 	}
+	resp.ScrollId = scrollId
+	profiler.phase("convert_result")
 
-	// bleve.SearchResult -> Any
-	searchResultAny := &any.Any{}
-	err = protobuf.UnmarshalAny(searchResult, searchResultAny)
+	err = s.setSources(resp.Hits, searchResult.Hits, source)
 	if err != nil {
-		return resp, status.Error(codes.Internal, err.Error())
+		return &index.SearchResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if source != nil {
+		profiler.phase("fetch_sources")
 	}
 
-	resp.SearchResult = searchResultAny
+	resp.Profile = profiler.finish()
 
 	return resp, nil
 }
 
-func (s *GRPCService) Index(stream index.Index_IndexServer) error {
-	docs := make([]*index.Document, 0)
+func (s *GRPCService) Similar(ctx context.Context, req *index.SimilarRequest) (*index.SearchResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "similar")
 
-	for {
-		doc, err := stream.Recv()
-		if err == io.EOF {
-			break
+	s.logger.Printf("[INFO] similar %v", req)
+
+	resp := &index.SearchResponse{}
+
+	if req.Id == "" {
+		return resp, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	options := NewMoreLikeThisOptions()
+	options.Fields = req.LikeFields
+	if req.MaxQueryTerms > 0 {
+		options.MaxQueryTerms = int(req.MaxQueryTerms)
+	}
+	if req.MinTermFreq > 0 {
+		options.MinTermFreq = int(req.MinTermFreq)
+	}
+	if req.MinDocFreq > 0 {
+		options.MinDocFreq = int(req.MinDocFreq)
+	}
+	if req.MaxDocFreq > 0 {
+		options.MaxDocFreq = int(req.MaxDocFreq)
+	}
+
+	q, err := s.raftServer.MoreLikeThis(req.Index, req.Id, options)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
 		}
+	}
+
+	searchRequest := bleve.NewSearchRequest(q)
+	var source *SourceFilter
+	if req.SearchRequest != nil {
+		// index.SearchRequest -> bleve.SearchRequest
+		searchRequest, err = protobuf.ToBleveSearchRequest(req.SearchRequest)
 		if err != nil {
-			return status.Error(codes.Internal, err.Error())
+			return resp, status.Error(codes.InvalidArgument, err.Error())
 		}
+		searchRequest.Query = q
 
-		docs = append(docs, doc)
+		if req.SearchRequest.IncludeSource {
+			source = &SourceFilter{
+				Includes: req.SearchRequest.SourceIncludes,
+				Excludes: req.SearchRequest.SourceExcludes,
+			}
+		}
 	}
 
-	// index
-	result, err := s.raftServer.Index(docs)
+	searchResult, err := s.raftServer.Search(req.Index, searchRequest)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// bleve.SearchResult -> index.SearchResponse
+	resp, err = protobuf.FromBleveSearchResult(searchResult)
 	if err != nil {
-		return status.Error(codes.Internal, err.Error())
+		return &index.SearchResponse{}, status.Error(codes.Internal, err.Error())
 	}
 
-	return stream.SendAndClose(result)
+	err = s.setSources(resp.Hits, searchResult.Hits, source)
+	if err != nil {
+		return &index.SearchResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
 }
 
-func (s *GRPCService) Delete(stream index.Index_DeleteServer) error {
-	docs := make([]*index.Document, 0)
+func (s *GRPCService) Scroll(ctx context.Context, req *index.ScrollRequest) (*index.SearchResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "scroll")
 
-	for {
-		doc, err := stream.Recv()
-		if err == io.EOF {
-			break
-		}
+	s.logger.Printf("[INFO] scroll %v", req)
+
+	resp := &index.SearchResponse{}
+
+	var ttl time.Duration
+	if req.Scroll != nil {
+		var err error
+		ttl, err = ptypes.Duration(req.Scroll)
 		if err != nil {
-			return status.Error(codes.Internal, err.Error())
+			return resp, status.Error(codes.InvalidArgument, err.Error())
 		}
+	}
 
-		docs = append(docs, doc)
+	searchResult, source, err := s.raftServer.Scroll(req.ScrollId, ttl)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
 	}
 
-	// delete
-	result, err := s.raftServer.Delete(docs)
+	// bleve.SearchResult -> index.SearchResponse
+	resp, err = protobuf.FromBleveSearchResult(searchResult)
 	if err != nil {
-		return status.Error(codes.Internal, err.Error())
+		return &index.SearchResponse{}, status.Error(codes.Internal, err.Error())
 	}
+	resp.ScrollId = req.ScrollId
 
-	return stream.SendAndClose(result)
+	err = s.setSources(resp.Hits, searchResult.Hits, source)
+	if err != nil {
+		return &index.SearchResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
 }
 
-func (s *GRPCService) GetStats(ctx context.Context, req *empty.Empty) (*index.Stats, error) {
+func (s *GRPCService) ClearScroll(ctx context.Context, req *index.ScrollRequest) (*empty.Empty, error) {
 	start := time.Now()
-	defer RecordMetrics(start, "stats")
+	defer RecordMetrics(start, "clear_scroll")
 
-	resp := &index.Stats{}
+	s.logger.Printf("[INFO] clear scroll %v", req)
 
-	s.logger.Printf("[INFO] stats %v", req)
+	resp := &empty.Empty{}
+
+	err := s.raftServer.ClearScroll(req.ScrollId)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) SearchStream(req *index.SearchRequest, stream index.Index_SearchStreamServer) error {
+	start := time.Now()
+	defer RecordMetrics(start, "search_stream")
+
+	s.logger.Printf("[INFO] search stream %v", req)
+
+	// index.SearchRequest -> bleve.SearchRequest
+	searchRequest, err := protobuf.ToBleveSearchRequest(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var source *SourceFilter
+	if req.IncludeSource {
+		source = &SourceFilter{
+			Includes: req.SourceIncludes,
+			Excludes: req.SourceExcludes,
+		}
+	}
+
+	err = s.raftServer.SearchEach(req.Index, searchRequest, searchStreamPageSize, func(hits search.DocumentMatchCollection) error {
+		var err error
+		documentMatches := make([]*index.DocumentMatch, len(hits))
+		for i, hit := range hits {
+			documentMatches[i], err = protobuf.FromBleveDocumentMatch(hit)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.setSources(documentMatches, hits, source)
+		if err != nil {
+			return err
+		}
+
+		for _, documentMatch := range documentMatches {
+			err = stream.Send(documentMatch)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return status.Error(codes.NotFound, err.Error())
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// setSources fills the original documents of the hits when the request asked for them.
+func (s *GRPCService) setSources(documentMatches []*index.DocumentMatch, hits search.DocumentMatchCollection, source *SourceFilter) error {
+	if source == nil {
+		return nil
+	}
+
+	sources, err := s.raftServer.GetSources(hits)
+	if err != nil {
+		return err
+	}
+
+	for i, fieldsMap := range sources {
+		if fieldsMap == nil {
+			continue
+		}
+
+		// map[string]interface{} -> Struct
+		documentMatches[i].Source, err = protobuf.ToStruct(filterSource(fieldsMap, source.Includes, source.Excludes))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *GRPCService) Index(stream index.Index_IndexServer) error {
+	docs := make([]*index.Document, 0)
+
+	for {
+		doc, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		docs = append(docs, doc)
+	}
+
+	// index
+	result, err := s.raftServer.Index(docs)
+	if _, ok := err.(*PipelineError); ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return status.Error(codes.NotFound, err.Error())
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return stream.SendAndClose(result)
+}
+
+func (s *GRPCService) Delete(stream index.Index_DeleteServer) error {
+	docs := make([]*index.Document, 0)
+
+	for {
+		doc, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		docs = append(docs, doc)
+	}
+
+	// delete
+	result, err := s.raftServer.Delete(docs)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return status.Error(codes.NotFound, err.Error())
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return stream.SendAndClose(result)
+}
+
+func (s *GRPCService) GetStats(ctx context.Context, req *index.IndexInfo) (*index.Stats, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "stats")
+
+	resp := &index.Stats{}
+
+	s.logger.Printf("[INFO] stats %v", req)
+
+	var err error
+
+	resp, err = s.raftServer.Stats(req.Name)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) GetIndexMapping(ctx context.Context, req *index.IndexInfo) (*index.IndexMapping, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "mapping")
+
+	s.logger.Printf("[INFO] get index mapping %v", req)
+
+	resp := &index.IndexMapping{}
+
+	var err error
+
+	resp, err = s.raftServer.GetIndexMapping(req.Name)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) PutIndexMapping(ctx context.Context, req *index.IndexMapping) (*empty.Empty, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "put_mapping")
+
+	s.logger.Printf("[INFO] put index mapping %v", req)
+
+	resp := &empty.Empty{}
+
+	// validate index mapping
+	indexMappingInstance, err := protobuf.MarshalAny(req.IndexMapping)
+	if err != nil {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	indexMapping, ok := indexMappingInstance.(*mapping.IndexMappingImpl)
+	if !ok {
+		return resp, status.Error(codes.InvalidArgument, "index mapping is not specified")
+	}
+	err = indexMapping.Validate()
+	if err != nil {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.raftServer.PutIndexMapping(req)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) GetReindexStatus(ctx context.Context, req *index.IndexInfo) (*index.ReindexStatus, error) {
+	s.logger.Printf("[INFO] get reindex status %v", req)
+
+	resp := &index.ReindexStatus{}
+
+	var err error
+
+	resp, err = s.raftServer.GetReindexStatus(req.Name)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) CreateIndex(ctx context.Context, req *index.IndexInfo) (*empty.Empty, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "create_index")
+
+	s.logger.Printf("[INFO] create index %v", req)
+
+	resp := &empty.Empty{}
+
+	err := ValidateIndexName(req.Name)
+	if err != nil {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// validate index mapping
+	if req.IndexMapping != nil {
+		indexMappingInstance, err := protobuf.MarshalAny(req.IndexMapping)
+		if err != nil {
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+		indexMapping, ok := indexMappingInstance.(*mapping.IndexMappingImpl)
+		if !ok {
+			return resp, status.Error(codes.InvalidArgument, "index mapping is not specified")
+		}
+		err = indexMapping.Validate()
+		if err != nil {
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err = s.raftServer.CreateIndex(req)
+	if err != nil {
+		switch err {
+		case errors.ErrAlreadyExists:
+			return resp, status.Error(codes.AlreadyExists, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) DeleteIndex(ctx context.Context, req *index.IndexInfo) (*empty.Empty, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "delete_index")
+
+	s.logger.Printf("[INFO] delete index %v", req)
+
+	resp := &empty.Empty{}
+
+	if req.Name == "" || req.Name == DefaultIndexName {
+		return resp, status.Error(codes.InvalidArgument, "the default index cannot be deleted")
+	}
+
+	err := s.raftServer.DeleteIndex(req)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) GetIndex(ctx context.Context, req *index.IndexInfo) (*index.IndexInfo, error) {
+	s.logger.Printf("[INFO] get index %v", req)
+
+	resp := &index.IndexInfo{}
+
+	var err error
+
+	resp, err = s.raftServer.GetIndex(req.Name)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) ListIndexes(ctx context.Context, req *empty.Empty) (*index.IndexList, error) {
+	s.logger.Printf("[INFO] list indexes %v", req)
+
+	resp := &index.IndexList{}
+
+	var err error
+
+	resp, err = s.raftServer.ListIndexes()
+	if err != nil {
+		return resp, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) PutAlias(ctx context.Context, req *index.Alias) (*empty.Empty, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "put_alias")
+
+	s.logger.Printf("[INFO] put alias %v", req)
+
+	resp := &empty.Empty{}
+
+	err := ValidateIndexName(req.Name)
+	if err != nil {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.Indexes) <= 0 {
+		return resp, status.Error(codes.InvalidArgument, "indexes are not specified")
+	}
+
+	err = s.raftServer.PutAlias(req)
+	if err != nil {
+		return resp, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) DeleteAlias(ctx context.Context, req *index.Alias) (*empty.Empty, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "delete_alias")
+
+	s.logger.Printf("[INFO] delete alias %v", req)
+
+	resp := &empty.Empty{}
+
+	err := s.raftServer.DeleteAlias(req)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) SwapAlias(ctx context.Context, req *index.SwapAliasRequest) (*empty.Empty, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "swap_alias")
+
+	s.logger.Printf("[INFO] swap alias %v", req)
+
+	resp := &empty.Empty{}
+
+	if len(req.ToIndexes) <= 0 {
+		return resp, status.Error(codes.InvalidArgument, "indexes are not specified")
+	}
+
+	err := s.raftServer.SwapAlias(req)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) PutPercolatorQuery(ctx context.Context, req *index.PercolatorQuery) (*empty.Empty, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "put_percolator_query")
+
+	s.logger.Printf("[INFO] put percolator query %v", req)
+
+	resp := &empty.Empty{}
+
+	if req.Id == "" {
+		return resp, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	// check that the query can be converted before it is logged
+	_, err := protobuf.ToBleveQuery(req.Query)
+	if err != nil {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.raftServer.PutPercolatorQuery(req)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) DeletePercolatorQuery(ctx context.Context, req *index.PercolatorQuery) (*empty.Empty, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "delete_percolator_query")
+
+	s.logger.Printf("[INFO] delete percolator query %v", req)
+
+	resp := &empty.Empty{}
+
+	err := s.raftServer.DeletePercolatorQuery(req)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) ListPercolatorQueries(ctx context.Context, req *index.PercolatorQuery) (*index.PercolatorQueryList, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "list_percolator_queries")
+
+	s.logger.Printf("[INFO] list percolator queries %v", req)
+
+	resp := &index.PercolatorQueryList{}
+
+	queries, err := s.raftServer.ListPercolatorQueries(req.Index)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	ids := make([]string, 0, len(queries))
+	for id := range queries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	resp.Queries = make([]*index.PercolatorQuery, 0, len(ids))
+	for _, id := range ids {
+		// query.Query -> index.Query
+		q, err := protobuf.FromBleveQuery(queries[id])
+		if err != nil {
+			return &index.PercolatorQueryList{}, status.Error(codes.Internal, err.Error())
+		}
+
+		resp.Queries = append(resp.Queries, &index.PercolatorQuery{
+			Index: req.Index,
+			Id:    id,
+			Query: q,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) Percolate(ctx context.Context, req *index.PercolateRequest) (*index.PercolateResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "percolate")
+
+	s.logger.Printf("[INFO] percolate %v", req)
+
+	resp := &index.PercolateResponse{}
+
+	// Struct -> map[string]interface{}
+	fields, err := protobuf.FromStruct(req.Fields)
+	if err != nil {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if fields == nil {
+		return resp, status.Error(codes.InvalidArgument, "fields are required")
+	}
+
+	queryIds, err := s.raftServer.Percolate(req.Index, fields)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	resp.QueryIds = queryIds
+
+	return resp, nil
+}
+
+// SubscribePercolations streams the percolations of the writes applied on this node until the client goes away.
+func (s *GRPCService) SubscribePercolations(req *index.SubscribePercolationsRequest, stream index.Index_SubscribePercolationsServer) error {
+	s.logger.Printf("[INFO] subscribe percolations %v", req)
+
+	percolations, cancel := s.raftServer.SubscribePercolations(req.Index)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case percolation := <-percolations:
+			err := stream.Send(percolation)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+}
+
+func (s *GRPCService) SimulatePipeline(ctx context.Context, req *index.SimulatePipelineRequest) (*index.SimulatePipelineResponse, error) {
+	start := time.Now()
+	defer RecordMetrics(start, "simulate_pipeline")
+
+	s.logger.Printf("[INFO] simulate pipeline %v", req)
+
+	resp, err := s.raftServer.SimulatePipeline(req)
+	if _, ok := err.(*PipelineError); ok {
+		return &index.SimulatePipelineResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return &index.SimulatePipelineResponse{}, status.Error(codes.NotFound, err.Error())
+		default:
+			return &index.SimulatePipelineResponse{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+// Subscribe streams the changes of the documents in raft log order until the client goes away.
+func (s *GRPCService) Subscribe(req *index.SubscribeRequest, stream index.Index_SubscribeServer) error {
+	s.logger.Printf("[INFO] subscribe %v", req)
+
+	err := s.raftServer.Subscribe(req.Index, req.FromRaftIndex, stream.Context().Done(), func(event *index.ChangeEvent) error {
+		return stream.Send(event)
+	})
+	if err != nil {
+		switch err {
+		case errors.ErrResyncRequired:
+			return status.Error(codes.OutOfRange, err.Error())
+		case ErrSubscriberLagged:
+			return status.Error(codes.Aborted, err.Error())
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (s *GRPCService) GetAlias(ctx context.Context, req *index.Alias) (*index.Alias, error) {
+	s.logger.Printf("[INFO] get alias %v", req)
+
+	resp := &index.Alias{}
+
+	var err error
+
+	resp, err = s.raftServer.GetAlias(req.Name)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			return resp, status.Error(codes.NotFound, err.Error())
+		default:
+			return resp, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCService) ListAliases(ctx context.Context, req *empty.Empty) (*index.AliasList, error) {
+	s.logger.Printf("[INFO] list aliases %v", req)
+
+	resp := &index.AliasList{}
 
 	var err error
 
-	resp, err = s.raftServer.Stats()
+	resp, err = s.raftServer.ListAliases()
 	if err != nil {
 		return resp, status.Error(codes.Internal, err.Error())
 	}
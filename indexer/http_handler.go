@@ -15,13 +15,24 @@
 package indexer
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/gorilla/mux"
 	"github.com/mosuka/blast/errors"
@@ -30,6 +41,8 @@ import (
 	"github.com/mosuka/blast/protobuf/index"
 	pbindex "github.com/mosuka/blast/protobuf/index"
 	"github.com/mosuka/blast/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type RootHandler struct {
@@ -86,7 +99,8 @@ func (h *GetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 
 	doc := &index.Document{
-		Id: vars["id"],
+		Id:    vars["id"],
+		Index: vars["index"],
 	}
 
 	doc, err := h.client.Get(doc)
@@ -111,9 +125,8 @@ func (h *GetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Any -> map[string]interface{}
-	var fieldsMap *map[string]interface{}
-	fieldsInstance, err := protobuf.MarshalAny(doc.Fields)
+	// Struct -> map[string]interface{}
+	fieldsMap, err := protobuf.FromStruct(doc.Fields)
 	if err != nil {
 		httpStatus = http.StatusInternalServerError
 
@@ -129,22 +142,6 @@ func (h *GetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 		return
 	}
-	if fieldsInstance == nil {
-		httpStatus = http.StatusInternalServerError
-
-		msgMap := map[string]interface{}{
-			"message": err.Error(),
-			"status":  httpStatus,
-		}
-
-		content, err = blasthttp.NewJSONMessage(msgMap)
-		if err != nil {
-			h.logger.Printf("[ERR] %v", err)
-		}
-
-		return
-	}
-	fieldsMap = fieldsInstance.(*map[string]interface{})
 
 	// map[string]interface{} -> bytes
 	content, err = json.MarshalIndent(fieldsMap, "", "  ")
@@ -209,7 +206,25 @@ func (h *IndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if id == "" {
+	// the op type of the documents that have none of their own
+	defaultOpType, err := newOpType(r.URL.Query().Get("op_type"))
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	if id == "" && r.Method != http.MethodPost {
 		// Indexing documents in bulk
 		var docMaps []map[string]interface{}
 		err := json.Unmarshal(bodyBytes, &docMaps)
@@ -230,8 +245,59 @@ func (h *IndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		for _, docMap := range docMaps {
-			fieldsAny := &any.Any{}
-			err = protobuf.UnmarshalAny(docMap["fields"], fieldsAny)
+			// a document without an id is given a generated one
+			docId, ok := docMap["id"].(string)
+			if _, exists := docMap["id"]; exists && !ok {
+				httpStatus = http.StatusBadRequest
+
+				msgMap := map[string]interface{}{
+					"message": "id must be a string",
+					"status":  httpStatus,
+				}
+
+				content, err = blasthttp.NewJSONMessage(msgMap)
+				if err != nil {
+					h.logger.Printf("[ERR] %v", err)
+				}
+
+				return
+			}
+
+			fieldsMap, ok := docMap["fields"].(map[string]interface{})
+			if !ok {
+				httpStatus = http.StatusBadRequest
+
+				msgMap := map[string]interface{}{
+					"message": "fields must be an object",
+					"status":  httpStatus,
+				}
+
+				content, err = blasthttp.NewJSONMessage(msgMap)
+				if err != nil {
+					h.logger.Printf("[ERR] %v", err)
+				}
+
+				return
+			}
+
+			fields, err := protobuf.ToStruct(fieldsMap)
+			if err != nil {
+				httpStatus = http.StatusBadRequest
+
+				msgMap := map[string]interface{}{
+					"message": err.Error(),
+					"status":  httpStatus,
+				}
+
+				content, err = blasthttp.NewJSONMessage(msgMap)
+				if err != nil {
+					h.logger.Printf("[ERR] %v", err)
+				}
+
+				return
+			}
+
+			ttl, expireAt, err := newExpiryValues(docMap["ttl"], docMap["expire_at"])
 			if err != nil {
 				httpStatus = http.StatusBadRequest
 
@@ -248,15 +314,42 @@ func (h *IndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 
+			pipelineName, _ := docMap["pipeline"].(string)
+
+			opType := defaultOpType
+			if opTypeStr, _ := docMap["op_type"].(string); opTypeStr != "" {
+				opType, err = newOpType(opTypeStr)
+				if err != nil {
+					httpStatus = http.StatusBadRequest
+
+					msgMap := map[string]interface{}{
+						"message": err.Error(),
+						"status":  httpStatus,
+					}
+
+					content, err = blasthttp.NewJSONMessage(msgMap)
+					if err != nil {
+						h.logger.Printf("[ERR] %v", err)
+					}
+
+					return
+				}
+			}
+
 			doc := &pbindex.Document{
-				Id:     docMap["id"].(string),
-				Fields: fieldsAny,
+				Id:       docId,
+				Fields:   fields,
+				Index:    vars["index"],
+				Ttl:      ttl,
+				ExpireAt: expireAt,
+				Pipeline: pipelineName,
+				OpType:   opType,
 			}
 
 			docs = append(docs, doc)
 		}
 	} else {
-		// Indexing a document
+		// Indexing a document, with a generated id if posted
 		var fieldsMap map[string]interface{}
 		err := json.Unmarshal(bodyBytes, &fieldsMap)
 		if err != nil {
@@ -275,8 +368,7 @@ func (h *IndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		fieldsAny := &any.Any{}
-		err = protobuf.UnmarshalAny(fieldsMap, fieldsAny)
+		fields, err := protobuf.ToStruct(fieldsMap)
 		if err != nil {
 			httpStatus = http.StatusBadRequest
 
@@ -295,16 +387,87 @@ func (h *IndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 		doc := &pbindex.Document{
 			Id:     id,
-			Fields: fieldsAny,
+			Fields: fields,
+			Index:  vars["index"],
+			OpType: defaultOpType,
 		}
 
 		docs = append(docs, doc)
 	}
 
+	// expire the documents that have no expiry of their own
+	if ttlStr, expireAtStr := r.URL.Query().Get("ttl"), r.URL.Query().Get("expire_at"); ttlStr != "" || expireAtStr != "" {
+		ttl, expireAt, err := newExpiry(ttlStr, expireAtStr)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+		for _, doc := range docs {
+			if doc.Ttl == 0 && doc.ExpireAt == 0 {
+				doc.Ttl = ttl
+				doc.ExpireAt = expireAt
+			}
+		}
+	}
+
+	// transform the documents that have no pipeline of their own
+	if pipelineName := r.URL.Query().Get("pipeline"); pipelineName != "" {
+		for _, doc := range docs {
+			if doc.Pipeline == "" {
+				doc.Pipeline = pipelineName
+			}
+		}
+	}
+
+	// publish the percolator queries matching the documents
+	if percolateStr := r.URL.Query().Get("percolate"); percolateStr != "" {
+		percolate, err := strconv.ParseBool(percolateStr)
+		if err != nil {
+			err = fmt.Errorf("invalid percolate: %s", percolateStr)
+			httpStatus = http.StatusBadRequest
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+		for _, doc := range docs {
+			doc.Percolate = percolate
+		}
+	}
+
 	// index documents in bulk
 	result, err := h.client.Index(docs)
 	if err != nil {
-		httpStatus = http.StatusInternalServerError
+		switch err.(type) {
+		case *PipelineError:
+			httpStatus = http.StatusBadRequest
+		default:
+			switch err {
+			case errors.ErrNotFound:
+				httpStatus = http.StatusNotFound
+			default:
+				httpStatus = http.StatusInternalServerError
+			}
+		}
 
 		msgMap := map[string]interface{}{
 			"message": err.Error(),
@@ -319,7 +482,39 @@ func (h *IndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	content, err = json.MarshalIndent(result, "", "  ")
+	// the ids of the documents are returned as they may have been generated, and a document whose op type
+	// conflicts is reported with its status
+	single := id != "" || r.Method == http.MethodPost
+	resultMap := map[string]interface{}{
+		"count": result.Count,
+	}
+	itemMaps := make([]map[string]interface{}, 0, len(result.Results))
+	hasErrors := false
+	for _, itemResult := range result.Results {
+		itemStatus := bulkItemStatus(codes.Code(itemResult.Code))
+		if itemStatus != http.StatusOK {
+			if single {
+				httpStatus = itemStatus
+			}
+			hasErrors = true
+		}
+		itemMaps = append(itemMaps, newBulkItemMap(itemResult.Action, itemResult.Id, itemResult.Index, itemStatus, itemResult.Error))
+	}
+	if single {
+		if hasErrors {
+			resultMap = map[string]interface{}{
+				"message": result.Results[0].Error,
+				"status":  httpStatus,
+			}
+		} else if len(result.Results) > 0 {
+			resultMap["id"] = result.Results[0].Id
+		}
+	} else {
+		resultMap["errors"] = hasErrors
+		resultMap["items"] = itemMaps
+	}
+
+	content, err = json.MarshalIndent(resultMap, "", "  ")
 	if err != nil {
 		httpStatus = http.StatusInternalServerError
 
@@ -402,8 +597,26 @@ func (h *DeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		for _, docMap := range docMaps {
+			docId, ok := docMap["id"].(string)
+			if !ok || docId == "" {
+				httpStatus = http.StatusBadRequest
+
+				msgMap := map[string]interface{}{
+					"message": "id must be a non-empty string",
+					"status":  httpStatus,
+				}
+
+				content, err = blasthttp.NewJSONMessage(msgMap)
+				if err != nil {
+					h.logger.Printf("[ERR] %v", err)
+				}
+
+				return
+			}
+
 			doc := &pbindex.Document{
-				Id: docMap["id"].(string),
+				Id:    docId,
+				Index: vars["index"],
 			}
 
 			docs = append(docs, doc)
@@ -411,7 +624,8 @@ func (h *DeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	} else {
 		// Deleting a document
 		doc := &pbindex.Document{
-			Id: id,
+			Id:    id,
+			Index: vars["index"],
 		}
 
 		docs = append(docs, doc)
@@ -420,7 +634,12 @@ func (h *DeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// delete documents in bulk
 	result, err := h.client.Delete(docs)
 	if err != nil {
-		httpStatus = http.StatusInternalServerError
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
 
 		msgMap := map[string]interface{}{
 			"message": err.Error(),
@@ -453,19 +672,19 @@ func (h *DeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-type SearchHandler struct {
+type MultiGetHandler struct {
 	client *GRPCClient
 	logger *log.Logger
 }
 
-func NewSearchHandler(client *GRPCClient, logger *log.Logger) *SearchHandler {
-	return &SearchHandler{
+func NewMultiGetHandler(client *GRPCClient, logger *log.Logger) *MultiGetHandler {
+	return &MultiGetHandler{
 		client: client,
 		logger: logger,
 	}
 }
 
-func (h *SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+func (h *MultiGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
 	httpStatus := http.StatusOK
 	content := make([]byte, 0)
@@ -474,7 +693,9 @@ func (h *SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
 	}()
 
-	searchRequestBytes, err := ioutil.ReadAll(r.Body)
+	vars := mux.Vars(r)
+
+	bodyBytes, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		httpStatus = http.StatusInternalServerError
 
@@ -491,30 +712,49 @@ func (h *SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// []byte -> bleve.SearchRequest
-	searchRequest := bleve.NewSearchRequest(nil)
-	if len(searchRequestBytes) > 0 {
-		err := json.Unmarshal(searchRequestBytes, searchRequest)
+	// []byte -> MultiGetRequest
+	req := &pbindex.MultiGetRequest{}
+	err = json.Unmarshal(bodyBytes, req)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
 		if err != nil {
-			httpStatus = http.StatusBadRequest
+			h.logger.Printf("[ERR] %v", err)
+		}
 
-			msgMap := map[string]interface{}{
-				"message": err.Error(),
-				"status":  httpStatus,
-			}
+		return
+	}
+	if len(req.Ids) <= 0 {
+		httpStatus = http.StatusBadRequest
 
-			content, err = blasthttp.NewJSONMessage(msgMap)
-			if err != nil {
-				h.logger.Printf("[ERR] %v", err)
-			}
+		msgMap := map[string]interface{}{
+			"message": "ids must be set",
+			"status":  httpStatus,
+		}
 
-			return
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
 		}
+
+		return
 	}
+	req.Index = vars["index"]
 
-	searchResult, err := h.client.Search(searchRequest)
+	resp, err := h.client.MultiGet(req)
 	if err != nil {
-		httpStatus = http.StatusInternalServerError
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
 
 		msgMap := map[string]interface{}{
 			"message": err.Error(),
@@ -529,7 +769,44 @@ func (h *SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	content, err = json.MarshalIndent(&searchResult, "", "  ")
+	docMaps := make([]map[string]interface{}, 0, len(resp.Documents))
+	for _, doc := range resp.Documents {
+		// Struct -> map[string]interface{}
+		fieldsMap, err := protobuf.FromStruct(doc.Fields)
+		if err != nil {
+			httpStatus = http.StatusInternalServerError
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+
+		docMaps = append(docMaps, map[string]interface{}{
+			"id":     doc.Id,
+			"fields": fieldsMap,
+		})
+	}
+
+	missingIds := resp.MissingIds
+	if missingIds == nil {
+		missingIds = make([]string, 0)
+	}
+
+	respMap := map[string]interface{}{
+		"documents":   docMaps,
+		"missing_ids": missingIds,
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
 	if err != nil {
 		httpStatus = http.StatusInternalServerError
 
@@ -545,5 +822,3305 @@ func (h *SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 		return
 	}
+}
+
+const bulkBatchSize = 1000
+
+type BulkHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewBulkHandler(client *GRPCClient, logger *log.Logger) *BulkHandler {
+	return &BulkHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// bulkEntry is an item of a bulk request, or the reason why it could not be read.
+type bulkEntry struct {
+	item       *index.BulkItem
+	parseError error
+}
+
+// ServeHTTP reads newline delimited JSON action and document pairs and sends them to the indexer in batches.
+// An action line is an object with one key, index, delete or update, holding the id and optionally the index.
+// The index and update actions are followed by a line with the fields; update merges them into the stored document.
+func (h *BulkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	itemMaps := make([]map[string]interface{}, 0)
+	hasErrors := false
+	entries := make([]*bulkEntry, 0, bulkBatchSize)
+
+	flush := func() error {
+		items := make([]*index.BulkItem, 0, len(entries))
+		for _, entry := range entries {
+			if entry.parseError == nil {
+				items = append(items, entry.item)
+			}
+		}
+
+		var results []*index.BulkItemResult
+		if len(items) > 0 {
+			resp, err := h.client.Bulk(items)
+			if err != nil {
+				return err
+			}
+			results = resp.Results
+		}
+		if len(results) != len(items) {
+			return fmt.Errorf("%d results were returned for %d items", len(results), len(items))
+		}
+
+		for _, entry := range entries {
+			if entry.parseError != nil {
+				hasErrors = true
+				itemMaps = append(itemMaps, newBulkItemMap(entry.item.Action, entry.item.Document.Id, entry.item.Document.Index, http.StatusBadRequest, entry.parseError.Error()))
+				continue
+			}
+
+			result := results[0]
+			results = results[1:]
+
+			if result.Error != "" {
+				hasErrors = true
+			}
+			itemMaps = append(itemMaps, newBulkItemMap(result.Action, result.Id, result.Index, bulkItemStatus(codes.Code(result.Code)), result.Error))
+		}
+
+		entries = make([]*bulkEntry, 0, bulkBatchSize)
+
+		return nil
+	}
+
+	lines := newBulkLineReader(r.Body)
+	for {
+		actionLine, err := lines.next()
+		if err == io.EOF {
+			break
+		}
+
+		var entry *bulkEntry
+		if err == nil {
+			entry, err = h.readEntry(actionLine, lines, vars["index"], r.URL.Query().Get("pipeline"), r.URL.Query().Get("op_type"))
+		}
+		if err != nil {
+			// the following lines cannot be paired with their actions, so stop here
+			httpStatus = http.StatusBadRequest
+
+			msgMap := map[string]interface{}{
+				"message": fmt.Sprintf("line %d: %s", lines.lineNum, err.Error()),
+				"status":  httpStatus,
+				"items":   itemMaps,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+
+		entries = append(entries, entry)
+		if len(entries) >= bulkBatchSize {
+			err = flush()
+			if err != nil {
+				// the batch is not sent again as some of its items may have been written
+				httpStatus = http.StatusInternalServerError
+
+				msgMap := map[string]interface{}{
+					"message": err.Error(),
+					"status":  httpStatus,
+					"items":   itemMaps,
+				}
+
+				content, err = blasthttp.NewJSONMessage(msgMap)
+				if err != nil {
+					h.logger.Printf("[ERR] %v", err)
+				}
+
+				return
+			}
+		}
+	}
+
+	err := flush()
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+			"items":   itemMaps,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	respMap := map[string]interface{}{
+		"took":   time.Since(start).Nanoseconds() / int64(time.Millisecond),
+		"errors": hasErrors,
+		"items":  itemMaps,
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+// readEntry reads an action line and, for index and update, the fields line following it.
+// Invalid fields are reported on the item, an invalid action line fails the request.
+func (h *BulkHandler) readEntry(actionLine []byte, lines *bulkLineReader, indexName string, pipelineName string, opTypeStr string) (*bulkEntry, error) {
+	var actionMap map[string]struct {
+		Id       string      `json:"id"`
+		Index    string      `json:"index"`
+		TTL      interface{} `json:"ttl"`
+		ExpireAt interface{} `json:"expire_at"`
+		Pipeline string      `json:"pipeline"`
+		OpType   string      `json:"op_type"`
+	}
+	err := json.Unmarshal(actionLine, &actionMap)
+	if err != nil {
+		return nil, err
+	}
+	if len(actionMap) != 1 {
+		return nil, goerrors.New("action must be an object with one of index, delete or update")
+	}
+
+	entry := &bulkEntry{}
+	for name, meta := range actionMap {
+		action, ok := index.BulkItem_Action_value[strings.ToUpper(name)]
+		if !ok || name != strings.ToLower(name) {
+			return nil, fmt.Errorf("unknown action: %s", name)
+		}
+
+		if meta.Index != "" {
+			indexName = meta.Index
+		}
+
+		entry.item = &index.BulkItem{
+			Action: index.BulkItem_Action(action),
+			Document: &index.Document{
+				Id:    meta.Id,
+				Index: indexName,
+			},
+		}
+
+		if entry.item.Action != index.BulkItem_DELETE {
+			entry.item.Document.Ttl, entry.item.Document.ExpireAt, entry.parseError = newExpiryValues(meta.TTL, meta.ExpireAt)
+		}
+		if entry.item.Action == index.BulkItem_INDEX {
+			entry.item.Document.Pipeline = meta.Pipeline
+			if entry.item.Document.Pipeline == "" {
+				entry.item.Document.Pipeline = pipelineName
+			}
+
+			if meta.OpType != "" {
+				opTypeStr = meta.OpType
+			}
+			opType, err := newOpType(opTypeStr)
+			if err != nil && entry.parseError == nil {
+				entry.parseError = err
+			}
+			entry.item.Document.OpType = opType
+		}
+	}
+
+	if entry.item.Action == index.BulkItem_DELETE {
+		return entry, nil
+	}
+
+	fieldsLine, err := lines.next()
+	if err == io.EOF {
+		return nil, goerrors.New("missing fields line")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// []byte -> map[string]interface{}
+	var fieldsMap map[string]interface{}
+	err = json.Unmarshal(fieldsLine, &fieldsMap)
+	if err != nil || fieldsMap == nil {
+		entry.parseError = fmt.Errorf("line %d: fields must be an object", lines.lineNum)
+		return entry, nil
+	}
+
+	// map[string]interface{} -> Struct
+	entry.item.Document.Fields, err = protobuf.ToStruct(fieldsMap)
+	if err != nil && entry.parseError == nil {
+		entry.parseError = err
+	}
+
+	return entry, nil
+}
+
+// newExpiry parses a ttl such as 24h and a time to expire at in RFC 3339 into seconds, 0 if not set.
+func newExpiry(ttlStr string, expireAtStr string) (int64, int64, error) {
+	ttl := int64(0)
+	if ttlStr != "" {
+		d, err := time.ParseDuration(ttlStr)
+		if err != nil || d <= 0 {
+			return 0, 0, fmt.Errorf("invalid ttl: %s", ttlStr)
+		}
+		ttl = int64((d + time.Second - 1) / time.Second)
+	}
+
+	expireAt := int64(0)
+	if expireAtStr != "" {
+		t, err := time.Parse(time.RFC3339, expireAtStr)
+		if err != nil || t.Unix() <= 0 {
+			return 0, 0, fmt.Errorf("invalid expire_at: %s", expireAtStr)
+		}
+		expireAt = t.Unix()
+	}
+
+	return ttl, expireAt, nil
+}
+
+// newExpiryValues parses the ttl and expire_at of a JSON body like newExpiry,
+// taking the ttl either as a duration string or as a number of seconds.
+func newExpiryValues(ttlValue interface{}, expireAtValue interface{}) (int64, int64, error) {
+	ttlStr := ""
+	switch ttl := ttlValue.(type) {
+	case nil:
+	case string:
+		ttlStr = ttl
+	case float64:
+		ttlStr = strconv.FormatFloat(ttl, 'f', -1, 64) + "s"
+	default:
+		return 0, 0, fmt.Errorf("invalid ttl: %v", ttlValue)
+	}
+
+	expireAtStr := ""
+	switch expireAt := expireAtValue.(type) {
+	case nil:
+	case string:
+		expireAtStr = expireAt
+	default:
+		return 0, 0, fmt.Errorf("invalid expire_at: %v", expireAtValue)
+	}
+
+	return newExpiry(ttlStr, expireAtStr)
+}
+
+// newOpType parses an op type of create, update or upsert, upsert if not set.
+func newOpType(opTypeStr string) (pbindex.Document_OpType, error) {
+	if opTypeStr == "" {
+		return pbindex.Document_UPSERT, nil
+	}
+
+	opType, ok := pbindex.Document_OpType_value[strings.ToUpper(opTypeStr)]
+	if !ok || opTypeStr != strings.ToLower(opTypeStr) {
+		return pbindex.Document_UPSERT, fmt.Errorf("invalid op_type: %s", opTypeStr)
+	}
+
+	return pbindex.Document_OpType(opType), nil
+}
+
+// bulkLineReader reads the non-empty lines of a bulk request body without loading it at once.
+type bulkLineReader struct {
+	reader  *bufio.Reader
+	lineNum int
+}
+
+func newBulkLineReader(r io.Reader) *bulkLineReader {
+	return &bulkLineReader{
+		reader: bufio.NewReader(r),
+	}
+}
+
+func (r *bulkLineReader) next() ([]byte, error) {
+	for {
+		line, err := r.reader.ReadBytes('\n')
+		if len(line) > 0 {
+			r.lineNum++
+			line = bytes.TrimSpace(line)
+			if len(line) > 0 {
+				return line, nil
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func newBulkItemMap(action index.BulkItem_Action, id string, indexName string, status int, errMsg string) map[string]interface{} {
+	itemMap := map[string]interface{}{
+		"id":     id,
+		"status": status,
+	}
+	if indexName != "" {
+		itemMap["index"] = indexName
+	}
+	if errMsg != "" {
+		itemMap["error"] = errMsg
+	}
+
+	return map[string]interface{}{
+		strings.ToLower(action.String()): itemMap,
+	}
+}
+
+func bulkItemStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+type ExportHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewExportHandler(client *GRPCClient, logger *log.Logger) *ExportHandler {
+	return &ExportHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *ExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	streaming := false
+	defer func() {
+		if !streaming {
+			blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		}
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	var q query.Query
+	if queryStr := r.URL.Query().Get("query"); queryStr != "" {
+		q = bleve.NewQueryStringQuery(queryStr)
+	}
+
+	stream, err := h.client.Export(vars["index"], q)
+	if err == nil {
+		// the first document tells whether the export could be started
+		var doc *index.Document
+		doc, err = stream.Recv()
+		if err == io.EOF {
+			doc, err = nil, nil
+		}
+		if err == nil {
+			streaming = true
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.WriteHeader(httpStatus)
+			h.writeDocuments(w, doc, stream)
+			return
+		}
+	}
+
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.NotFound:
+		httpStatus = http.StatusNotFound
+	case codes.InvalidArgument:
+		httpStatus = http.StatusBadRequest
+	default:
+		httpStatus = http.StatusInternalServerError
+	}
+
+	msgMap := map[string]interface{}{
+		"message": st.Message(),
+		"status":  httpStatus,
+	}
+
+	content, err = blasthttp.NewJSONMessage(msgMap)
+	if err != nil {
+		h.logger.Printf("[ERR] %v", err)
+	}
+}
+
+// writeDocuments writes the documents as newline delimited JSON, flushing each line.
+// Errors after the response has started can only be logged.
+func (h *ExportHandler) writeDocuments(w http.ResponseWriter, doc *index.Document, stream index.Index_ExportClient) {
+	flusher, _ := w.(http.Flusher)
+
+	for doc != nil {
+		// Struct -> map[string]interface{}
+		fieldsMap, err := protobuf.FromStruct(doc.Fields)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+			return
+		}
+
+		docBytes, err := json.Marshal(map[string]interface{}{
+			"id":     doc.Id,
+			"fields": fieldsMap,
+		})
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+			return
+		}
+
+		_, err = w.Write(append(docBytes, '\n'))
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		doc, err = stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+			return
+		}
+	}
+}
+
+type SearchHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewSearchHandler(client *GRPCClient, logger *log.Logger) *SearchHandler {
+	return &SearchHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP searches with a search request in the body, or with a query string query built from the query parameters of a GET request.
+// Besides the bleve search request, the body takes include_source, source_includes, source_excludes, search_after and scroll.
+// To debug relevance, explain returns the score explanation of every hit, and profile returns the timings
+// of the phases of the search on the node that served it; /explain/{id} explains a single document.
+func (h *SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	var searchRequest *SearchRequest
+	if r.Method == http.MethodGet {
+		// query parameters -> SearchRequest
+		var err error
+		searchRequest, err = NewSearchRequestFromValues(r.URL.Query())
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+	} else {
+		searchRequestBytes, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			httpStatus = http.StatusInternalServerError
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+
+		// []byte -> SearchRequest
+		searchRequest = NewSearchRequest(bleve.NewSearchRequest(nil))
+		if len(searchRequestBytes) > 0 {
+			err := json.Unmarshal(searchRequestBytes, searchRequest)
+			if err != nil {
+				httpStatus = http.StatusBadRequest
+
+				msgMap := map[string]interface{}{
+					"message": err.Error(),
+					"status":  httpStatus,
+				}
+
+				content, err = blasthttp.NewJSONMessage(msgMap)
+				if err != nil {
+					h.logger.Printf("[ERR] %v", err)
+				}
+
+				return
+			}
+		}
+	}
+
+	if len(searchRequest.SearchAfter) > 0 {
+		err := validateSearchAfter(searchRequest.SearchRequest, searchRequest.SearchAfter)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+	}
+
+	searchResult, err := h.client.Search(vars["index"], searchRequest)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	content, err = json.MarshalIndent(&searchResult, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+}
+
+type SimilarHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewSimilarHandler(client *GRPCClient, logger *log.Logger) *SimilarHandler {
+	return &SimilarHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP searches the documents similar to the document with the id. The terms are chosen by the
+// like_fields, max_query_terms, min_term_freq, min_doc_freq and max_doc_freq parameters, and the
+// other parameters of a search except q apply to the search of the similar documents.
+func (h *SimilarHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	options, err := NewMoreLikeThisOptionsFromValues(r.URL.Query())
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	// query parameters -> SearchRequest
+	searchRequest, err := NewSearchRequestFromValues(r.URL.Query())
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	searchResult, err := h.client.Similar(vars["index"], vars["id"], options, searchRequest)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	content, err = json.MarshalIndent(&searchResult, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type CountHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewCountHandler(client *GRPCClient, logger *log.Logger) *CountHandler {
+	return &CountHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP counts the documents matching the query string query in the q parameter,
+// or the query in a {"query": ...} body. All documents are counted without a query.
+func (h *CountHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	q, err := newRequestQuery(r)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	resp, err := h.client.Count(vars["index"], q)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	respMap := map[string]interface{}{
+		"count":     resp.Count,
+		"doc_count": resp.DocCount,
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+func newRequestQuery(r *http.Request) (query.Query, error) {
+	if queryStr := r.URL.Query().Get("q"); queryStr != "" {
+		return bleve.NewQueryStringQuery(queryStr), nil
+	}
+
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(bodyBytes)) <= 0 {
+		return nil, nil
+	}
+
+	var countRequest struct {
+		Query json.RawMessage `json:"query"`
+	}
+	err = json.Unmarshal(bodyBytes, &countRequest)
+	if err != nil {
+		return nil, err
+	}
+	if countRequest.Query == nil {
+		return nil, nil
+	}
+
+	return query.ParseQuery(countRequest.Query)
+}
+
+type ExplainHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewExplainHandler(client *GRPCClient, logger *log.Logger) *ExplainHandler {
+	return &ExplainHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP explains why the document matches the query string query in the q parameter,
+// or the query in a {"query": ...} body, and how its score is computed.
+// A document that does not match is reported with matched set to false.
+func (h *ExplainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	q, err := newRequestQuery(r)
+	if err == nil && q == nil {
+		err = goerrors.New("query must be set")
+	}
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	resp, err := h.client.Explain(vars["index"], vars["id"], q)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	respMap := map[string]interface{}{
+		"id":      resp.Id,
+		"matched": resp.Matched,
+	}
+	if resp.Matched {
+		respMap["score"] = resp.Score
+		respMap["explanation"] = protobuf.ToBleveExplanation(resp.Explanation)
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type AnalyzeHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewAnalyzeHandler(client *GRPCClient, logger *log.Logger) *AnalyzeHandler {
+	return &AnalyzeHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP analyzes the text in a {"text": ..., "analyzer": ..., "field": ...} body
+// with the analyzers of the index mapping and returns the tokens.
+func (h *AnalyzeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	var analyzeRequest struct {
+		Text     string `json:"text"`
+		Analyzer string `json:"analyzer"`
+		Field    string `json:"field"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&analyzeRequest)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	resp, err := h.client.Analyze(vars["index"], analyzeRequest.Text, analyzeRequest.Analyzer, analyzeRequest.Field)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	respMap := map[string]interface{}{
+		"analyzer": resp.Analyzer,
+		"tokens":   newTokenMaps(resp.Tokens),
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+func newTokenMaps(tokens []*pbindex.Token) []map[string]interface{} {
+	tokenMaps := make([]map[string]interface{}, 0, len(tokens))
+	for _, token := range tokens {
+		tokenMaps = append(tokenMaps, map[string]interface{}{
+			"term":     token.Term,
+			"position": token.Position,
+			"start":    token.Start,
+			"end":      token.End,
+			"type":     token.Type,
+		})
+	}
+
+	return tokenMaps
+}
+
+type SuggestHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewSuggestHandler(client *GRPCClient, logger *log.Logger) *SuggestHandler {
+	return &SuggestHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP suggests the terms of the field parameter starting with the prefix parameter, most frequent first.
+// With fuzzy=true it suggests the terms within the fuzziness edit distance of the prefix instead.
+func (h *SuggestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	field, prefix, size, fuzzy, fuzziness, err := newSuggestParams(r.URL.Query())
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	resp, err := h.client.Suggest(vars["index"], field, prefix, size, fuzzy, fuzziness)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	suggestions := make([]map[string]interface{}, 0, len(resp.Suggestions))
+	for _, suggestion := range resp.Suggestions {
+		suggestionMap := map[string]interface{}{
+			"term":  suggestion.Term,
+			"count": suggestion.Count,
+		}
+		if fuzzy {
+			suggestionMap["distance"] = suggestion.Distance
+		}
+		suggestions = append(suggestions, suggestionMap)
+	}
+
+	respMap := map[string]interface{}{
+		"suggestions": suggestions,
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+func newSuggestParams(values url.Values) (string, string, int, bool, int, error) {
+	field := values.Get("field")
+	if field == "" {
+		return "", "", 0, false, 0, goerrors.New("field is required")
+	}
+
+	size := 0
+	if sizeStr := values.Get("size"); sizeStr != "" {
+		var err error
+		size, err = strconv.Atoi(sizeStr)
+		if err != nil {
+			return "", "", 0, false, 0, fmt.Errorf("invalid size: %s", sizeStr)
+		}
+	}
+
+	fuzzy := false
+	if fuzzyStr := values.Get("fuzzy"); fuzzyStr != "" {
+		var err error
+		fuzzy, err = strconv.ParseBool(fuzzyStr)
+		if err != nil {
+			return "", "", 0, false, 0, fmt.Errorf("invalid fuzzy: %s", fuzzyStr)
+		}
+	}
+
+	fuzziness := 0
+	if fuzzinessStr := values.Get("fuzziness"); fuzzinessStr != "" {
+		var err error
+		fuzziness, err = strconv.Atoi(fuzzinessStr)
+		if err != nil {
+			return "", "", 0, false, 0, fmt.Errorf("invalid fuzziness: %s", fuzzinessStr)
+		}
+		// a fuzziness implies a fuzzy suggestion
+		fuzzy = true
+	}
+
+	return field, values.Get("prefix"), size, fuzzy, fuzziness, nil
+}
+
+type FieldStatsHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewFieldStatsHandler(client *GRPCClient, logger *log.Logger) *FieldStatsHandler {
+	return &FieldStatsHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP lists the indexed fields with the number of documents having them,
+// the number of distinct terms and the number of postings.
+func (h *FieldStatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	resp, err := h.client.FieldStats(vars["index"])
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	fields := make([]map[string]interface{}, 0, len(resp.Fields))
+	for _, stats := range resp.Fields {
+		fields = append(fields, map[string]interface{}{
+			"name":         stats.Name,
+			"doc_count":    stats.DocCount,
+			"term_count":   stats.TermCount,
+			"sum_doc_freq": stats.SumDocFreq,
+		})
+	}
+
+	respMap := map[string]interface{}{
+		"doc_count": resp.DocCount,
+		"fields":    fields,
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type FieldTermsHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewFieldTermsHandler(client *GRPCClient, logger *log.Logger) *FieldTermsHandler {
+	return &FieldTermsHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP lists the terms of the field dictionary starting with the prefix parameter,
+// most frequent first, up to the limit parameter.
+func (h *FieldTermsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+
+			msgMap := map[string]interface{}{
+				"message": fmt.Sprintf("invalid limit: %s", limitStr),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+	}
+
+	resp, err := h.client.Suggest(vars["index"], vars["name"], r.URL.Query().Get("prefix"), limit, false, 0)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	terms := make([]map[string]interface{}, 0, len(resp.Suggestions))
+	for _, suggestion := range resp.Suggestions {
+		terms = append(terms, map[string]interface{}{
+			"term":  suggestion.Term,
+			"count": suggestion.Count,
+		})
+	}
+
+	respMap := map[string]interface{}{
+		"field": vars["name"],
+		"terms": terms,
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type ScrollHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewScrollHandler(client *GRPCClient, logger *log.Logger) *ScrollHandler {
+	return &ScrollHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *ScrollHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	scrollRequest, err := newScrollRequest(r)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	var ttl time.Duration
+	if scrollRequest.Scroll != "" {
+		ttl, err = time.ParseDuration(scrollRequest.Scroll)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+	}
+
+	searchResult, err := h.client.Scroll(scrollRequest.ScrollId, ttl)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	content, err = json.MarshalIndent(&searchResult, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type ClearScrollHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewClearScrollHandler(client *GRPCClient, logger *log.Logger) *ClearScrollHandler {
+	return &ClearScrollHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *ClearScrollHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	scrollRequest, err := newScrollRequest(r)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	err = h.client.ClearScroll(scrollRequest.ScrollId)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type scrollRequest struct {
+	ScrollId string `json:"scroll_id"`
+	Scroll   string `json:"scroll"`
+}
+
+func newScrollRequest(r *http.Request) (*scrollRequest, error) {
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var req scrollRequest
+	err = json.Unmarshal(bodyBytes, &req)
+	if err != nil {
+		return nil, err
+	}
+	if req.ScrollId == "" {
+		return nil, fmt.Errorf("scroll_id must be set")
+	}
+
+	return &req, nil
+}
+
+type GetIndexMappingHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewGetIndexMappingHandler(client *GRPCClient, logger *log.Logger) *GetIndexMappingHandler {
+	return &GetIndexMappingHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *GetIndexMappingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	indexMapping, err := h.client.GetIndexMapping(vars["index"])
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	content, err = json.MarshalIndent(indexMapping, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type PutIndexMappingHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewPutIndexMappingHandler(client *GRPCClient, logger *log.Logger) *PutIndexMappingHandler {
+	return &PutIndexMappingHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *PutIndexMappingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	indexMappingBytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	// []byte -> mapping.IndexMappingImpl
+	indexMapping := mapping.NewIndexMapping()
+	err = json.Unmarshal(indexMappingBytes, indexMapping)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	err = indexMapping.Validate()
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	err = h.client.PutIndexMapping(vars["index"], indexMapping)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	// the mapping is applied by a background reindex
+	httpStatus = http.StatusAccepted
+
+	msgMap := map[string]interface{}{
+		"message": "reindex started",
+		"status":  httpStatus,
+	}
+
+	content, err = blasthttp.NewJSONMessage(msgMap)
+	if err != nil {
+		h.logger.Printf("[ERR] %v", err)
+	}
+}
+
+type ReindexStatusHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewReindexStatusHandler(client *GRPCClient, logger *log.Logger) *ReindexStatusHandler {
+	return &ReindexStatusHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *ReindexStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	reindexStatus, err := h.client.GetReindexStatus(vars["index"])
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	// ReindexStatus -> []byte
+	marshaler := &jsonpb.Marshaler{
+		OrigName:     true,
+		EmitDefaults: true,
+		Indent:       "  ",
+	}
+	reindexStatusStr, err := marshaler.MarshalToString(reindexStatus)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	content = []byte(reindexStatusStr)
+}
+
+type ListIndexesHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewListIndexesHandler(client *GRPCClient, logger *log.Logger) *ListIndexesHandler {
+	return &ListIndexesHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *ListIndexesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	indexList, err := h.client.ListIndexes()
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	indexInfoMaps := make([]map[string]interface{}, 0)
+	for _, indexInfo := range indexList.Indexes {
+		indexInfoMap, err := newIndexInfoMap(indexInfo)
+		if err != nil {
+			httpStatus = http.StatusInternalServerError
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+		indexInfoMaps = append(indexInfoMaps, indexInfoMap)
+	}
+
+	content, err = json.MarshalIndent(indexInfoMaps, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type GetIndexHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewGetIndexHandler(client *GRPCClient, logger *log.Logger) *GetIndexHandler {
+	return &GetIndexHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *GetIndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	indexInfo, err := h.client.GetIndex(vars["index"])
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	indexInfoMap, err := newIndexInfoMap(indexInfo)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	content, err = json.MarshalIndent(indexInfoMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type CreateIndexHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewCreateIndexHandler(client *GRPCClient, logger *log.Logger) *CreateIndexHandler {
+	return &CreateIndexHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *CreateIndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	err = ValidateIndexName(vars["index"])
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	// []byte -> IndexInfo
+	indexInfo, err := newIndexInfo(vars["index"], bodyBytes)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	err = h.client.CreateIndex(indexInfo)
+	if err != nil {
+		switch err {
+		case errors.ErrAlreadyExists:
+			httpStatus = http.StatusConflict
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	msgMap := map[string]interface{}{
+		"message": "index created",
+		"status":  httpStatus,
+	}
+
+	content, err = blasthttp.NewJSONMessage(msgMap)
+	if err != nil {
+		h.logger.Printf("[ERR] %v", err)
+	}
+}
+
+type DeleteIndexHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewDeleteIndexHandler(client *GRPCClient, logger *log.Logger) *DeleteIndexHandler {
+	return &DeleteIndexHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *DeleteIndexHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	if vars["index"] == DefaultIndexName {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": "the default index cannot be deleted",
+			"status":  httpStatus,
+		}
+
+		var err error
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	err := h.client.DeleteIndex(&pbindex.IndexInfo{Name: vars["index"]})
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	msgMap := map[string]interface{}{
+		"message": "index deleted",
+		"status":  httpStatus,
+	}
+
+	content, err = blasthttp.NewJSONMessage(msgMap)
+	if err != nil {
+		h.logger.Printf("[ERR] %v", err)
+	}
+}
+
+type ListAliasesHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewListAliasesHandler(client *GRPCClient, logger *log.Logger) *ListAliasesHandler {
+	return &ListAliasesHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *ListAliasesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	aliasList, err := h.client.ListAliases()
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	content, err = json.MarshalIndent(aliasList.Aliases, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type GetAliasHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewGetAliasHandler(client *GRPCClient, logger *log.Logger) *GetAliasHandler {
+	return &GetAliasHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *GetAliasHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	alias, err := h.client.GetAlias(vars["alias"])
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	content, err = json.MarshalIndent(alias, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type PutAliasHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewPutAliasHandler(client *GRPCClient, logger *log.Logger) *PutAliasHandler {
+	return &PutAliasHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *PutAliasHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	// []byte -> Alias
+	alias := &pbindex.Alias{}
+	err = json.Unmarshal(bodyBytes, alias)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+	alias.Name = vars["alias"]
+
+	err = ValidateIndexName(alias.Name)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	err = h.client.PutAlias(alias)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	msgMap := map[string]interface{}{
+		"message": "alias updated",
+		"status":  httpStatus,
+	}
+
+	content, err = blasthttp.NewJSONMessage(msgMap)
+	if err != nil {
+		h.logger.Printf("[ERR] %v", err)
+	}
+}
+
+type DeleteAliasHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewDeleteAliasHandler(client *GRPCClient, logger *log.Logger) *DeleteAliasHandler {
+	return &DeleteAliasHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *DeleteAliasHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	err := h.client.DeleteAlias(&pbindex.Alias{Name: vars["alias"]})
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	msgMap := map[string]interface{}{
+		"message": "alias deleted",
+		"status":  httpStatus,
+	}
+
+	content, err = blasthttp.NewJSONMessage(msgMap)
+	if err != nil {
+		h.logger.Printf("[ERR] %v", err)
+	}
+}
+
+type SwapAliasHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewSwapAliasHandler(client *GRPCClient, logger *log.Logger) *SwapAliasHandler {
+	return &SwapAliasHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *SwapAliasHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	// []byte -> SwapAliasRequest
+	swapAlias := &pbindex.SwapAliasRequest{}
+	err = json.Unmarshal(bodyBytes, swapAlias)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+	swapAlias.Name = vars["alias"]
+
+	err = h.client.SwapAlias(swapAlias)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	msgMap := map[string]interface{}{
+		"message": "alias swapped",
+		"status":  httpStatus,
+	}
+
+	content, err = blasthttp.NewJSONMessage(msgMap)
+	if err != nil {
+		h.logger.Printf("[ERR] %v", err)
+	}
+}
+
+// newIndexInfo creates an IndexInfo from a JSON representation like
+// {"index_mapping": {...}, "index_storage_type": "boltdb"}.
+type PutPercolatorQueryHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewPutPercolatorQueryHandler(client *GRPCClient, logger *log.Logger) *PutPercolatorQueryHandler {
+	return &PutPercolatorQueryHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP stores the query string query in the q parameter, or the query in a {"query": ...} body,
+// as a percolator query of the index.
+func (h *PutPercolatorQueryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	q, err := newRequestQuery(r)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+	if q == nil {
+		err = goerrors.New("query is required")
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	// query.Query -> index.Query
+	pbQuery, err := protobuf.FromBleveQuery(q)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	err = h.client.PutPercolatorQuery(&pbindex.PercolatorQuery{
+		Index: vars["index"],
+		Id:    vars["id"],
+		Query: pbQuery,
+	})
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type DeletePercolatorQueryHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewDeletePercolatorQueryHandler(client *GRPCClient, logger *log.Logger) *DeletePercolatorQueryHandler {
+	return &DeletePercolatorQueryHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *DeletePercolatorQueryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	err := h.client.DeletePercolatorQuery(&pbindex.PercolatorQuery{
+		Index: vars["index"],
+		Id:    vars["id"],
+	})
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type ListPercolatorQueriesHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewListPercolatorQueriesHandler(client *GRPCClient, logger *log.Logger) *ListPercolatorQueriesHandler {
+	return &ListPercolatorQueriesHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (h *ListPercolatorQueriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	resp, err := h.client.ListPercolatorQueries(vars["index"])
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	queries := make([]map[string]interface{}, 0, len(resp.Queries))
+	for _, percolatorQuery := range resp.Queries {
+		// index.Query -> query.Query
+		q, err := protobuf.ToBleveQuery(percolatorQuery.Query)
+		if err != nil {
+			httpStatus = http.StatusInternalServerError
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+
+		queries = append(queries, map[string]interface{}{
+			"id":    percolatorQuery.Id,
+			"query": q,
+		})
+	}
+
+	respMap := map[string]interface{}{
+		"queries": queries,
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type PercolateHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewPercolateHandler(client *GRPCClient, logger *log.Logger) *PercolateHandler {
+	return &PercolateHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP returns the ids of the percolator queries of the index matching the document fields in the body.
+func (h *PercolateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	var fieldsMap map[string]interface{}
+	err := json.NewDecoder(r.Body).Decode(&fieldsMap)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	queryIds, err := h.client.Percolate(vars["index"], fieldsMap)
+	if err != nil {
+		switch err {
+		case errors.ErrNotFound:
+			httpStatus = http.StatusNotFound
+		default:
+			httpStatus = http.StatusInternalServerError
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	respMap := map[string]interface{}{
+		"query_ids": queryIds,
+	}
+
+	// map[string]interface{} -> bytes
+	content, err = json.MarshalIndent(respMap, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+type SubscribePercolationsHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewSubscribePercolationsHandler(client *GRPCClient, logger *log.Logger) *SubscribePercolationsHandler {
+	return &SubscribePercolationsHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP streams the percolations of the writes to the index that asked for them as newline delimited JSON,
+// until the client goes away.
+func (h *SubscribePercolationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	defer func() {
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	stream, err := h.client.SubscribePercolations(r.Context(), vars["index"])
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err := blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(httpStatus)
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+
+	for {
+		percolation, err := stream.Recv()
+		if err != nil {
+			// the client went away, or the node is shutting down
+			return
+		}
+
+		percolationBytes, err := json.Marshal(map[string]interface{}{
+			"index":     percolation.Index,
+			"id":        percolation.Id,
+			"query_ids": percolation.QueryIds,
+		})
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+			return
+		}
+
+		_, err = w.Write(append(percolationBytes, '\n'))
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+type ChangesHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewChangesHandler(client *GRPCClient, logger *log.Logger) *ChangesHandler {
+	return &ChangesHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP streams the changes of the documents as server-sent events, with the raft index as the event ID,
+// until the client goes away. The stream resumes after the Last-Event-ID header, or from the from parameter.
+func (h *ChangesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	defer func() {
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	from, err := newChangesFrom(r)
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err := blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		return
+	}
+
+	stream, err := h.client.Subscribe(r.Context(), vars["index"], from)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err := blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(httpStatus)
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			st, _ := status.FromError(err)
+
+			var errorStatus int
+			switch st.Code() {
+			case codes.OutOfRange:
+				errorStatus = http.StatusGone
+			case codes.Aborted:
+				errorStatus = http.StatusConflict
+			default:
+				// the client went away, or the node is shutting down
+				return
+			}
+
+			errorBytes, err := json.Marshal(map[string]interface{}{
+				"message": st.Message(),
+				"status":  errorStatus,
+			})
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+				return
+			}
+
+			_, err = fmt.Fprintf(w, "event: error\ndata: %s\n\n", errorBytes)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+			return
+		}
+
+		eventMap, err := newChangeEventMap(event)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+			return
+		}
+
+		eventBytes, err := json.Marshal(eventMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+			return
+		}
+
+		_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.RaftIndex, eventMap["type"], eventBytes)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// newChangesFrom returns the raft index to resume the changes from, 0 to receive only the new ones.
+func newChangesFrom(r *http.Request) (uint64, error) {
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID != "" {
+		last, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid Last-Event-ID: %s", lastEventID)
+		}
+		return last + 1, nil
+	}
+
+	fromStr := r.URL.Query().Get("from")
+	if fromStr != "" {
+		from, err := strconv.ParseUint(fromStr, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid from: %s", fromStr)
+		}
+		return from, nil
+	}
+
+	return 0, nil
+}
+
+func newChangeEventMap(event *pbindex.ChangeEvent) (map[string]interface{}, error) {
+	eventMap := map[string]interface{}{
+		"type":       strings.ToLower(event.Type.String()),
+		"raft_index": event.RaftIndex,
+		"index":      event.Index,
+		"id":         event.Id,
+	}
+
+	if event.Fields != nil {
+		fieldsMap, err := protobuf.FromStruct(event.Fields)
+		if err != nil {
+			return nil, err
+		}
+		eventMap["fields"] = fieldsMap
+	}
+
+	return eventMap, nil
+}
+
+type SimulatePipelineHandler struct {
+	client *GRPCClient
+	logger *log.Logger
+}
+
+func NewSimulatePipelineHandler(client *GRPCClient, logger *log.Logger) *SimulatePipelineHandler {
+	return &SimulatePipelineHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ServeHTTP transforms the documents in the body with the named pipeline, or with the pipeline in the body,
+// without indexing them.
+func (h *SimulatePipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	httpStatus := http.StatusOK
+	content := make([]byte, 0)
+	defer func() {
+		blasthttp.WriteResponse(w, content, httpStatus, h.logger)
+		blasthttp.RecordMetrics(start, httpStatus, w, r, h.logger)
+	}()
+
+	vars := mux.Vars(r)
+
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	var body struct {
+		Pipeline map[string]interface{}   `json:"pipeline"`
+		Docs     []map[string]interface{} `json:"docs"`
+	}
+	err = json.Unmarshal(bodyBytes, &body)
+	if err == nil && vars["name"] == "" && body.Pipeline == nil {
+		err = goerrors.New("pipeline must be set")
+	}
+	if err != nil {
+		httpStatus = http.StatusBadRequest
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	resp, err := h.client.SimulatePipeline(vars["name"], body.Pipeline, body.Docs)
+	if err != nil {
+		switch err.(type) {
+		case *PipelineError:
+			httpStatus = http.StatusBadRequest
+		default:
+			switch err {
+			case errors.ErrNotFound:
+				httpStatus = http.StatusNotFound
+			default:
+				httpStatus = http.StatusInternalServerError
+			}
+		}
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+
+	docMaps := make([]map[string]interface{}, 0, len(resp.Docs))
+	for _, doc := range resp.Docs {
+		if doc.Error != "" {
+			docMaps = append(docMaps, map[string]interface{}{
+				"error": doc.Error,
+			})
+			continue
+		}
+
+		// Struct -> map[string]interface{}
+		fieldsMap, err := protobuf.FromStruct(doc.Fields)
+		if err != nil {
+			httpStatus = http.StatusInternalServerError
+
+			msgMap := map[string]interface{}{
+				"message": err.Error(),
+				"status":  httpStatus,
+			}
+
+			content, err = blasthttp.NewJSONMessage(msgMap)
+			if err != nil {
+				h.logger.Printf("[ERR] %v", err)
+			}
+
+			return
+		}
+
+		docMaps = append(docMaps, map[string]interface{}{
+			"fields": fieldsMap,
+		})
+	}
+
+	content, err = json.MarshalIndent(map[string]interface{}{
+		"docs": docMaps,
+	}, "", "  ")
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+
+		msgMap := map[string]interface{}{
+			"message": err.Error(),
+			"status":  httpStatus,
+		}
+
+		content, err = blasthttp.NewJSONMessage(msgMap)
+		if err != nil {
+			h.logger.Printf("[ERR] %v", err)
+		}
+
+		return
+	}
+}
+
+func newIndexInfo(name string, bodyBytes []byte) (*pbindex.IndexInfo, error) {
+	indexInfo := &pbindex.IndexInfo{
+		Name: name,
+	}
+
+	if len(bodyBytes) <= 0 {
+		return indexInfo, nil
+	}
+
+	var body struct {
+		IndexMapping     *mapping.IndexMappingImpl `json:"index_mapping"`
+		IndexStorageType string                    `json:"index_storage_type"`
+		DefaultTTL       interface{}               `json:"default_ttl"`
+	}
+	err := json.Unmarshal(bodyBytes, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	if body.IndexMapping != nil {
+		err = body.IndexMapping.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		// mapping.IndexMappingImpl -> Any
+		indexMappingAny := &any.Any{}
+		err = protobuf.UnmarshalAny(body.IndexMapping, indexMappingAny)
+		if err != nil {
+			return nil, err
+		}
+		indexInfo.IndexMapping = indexMappingAny
+	}
+	indexInfo.IndexStorageType = body.IndexStorageType
+
+	indexInfo.DefaultTtl, _, err = newExpiryValues(body.DefaultTTL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexInfo, nil
+}
+
+func newIndexInfoMap(indexInfo *pbindex.IndexInfo) (map[string]interface{}, error) {
+	// Any -> mapping.IndexMappingImpl
+	indexMapping, err := protobuf.MarshalAny(indexInfo.IndexMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	indexInfoMap := map[string]interface{}{
+		"name":               indexInfo.Name,
+		"index_mapping":      indexMapping,
+		"index_storage_type": indexInfo.IndexStorageType,
+	}
+	if indexInfo.DefaultTtl > 0 {
+		indexInfoMap["default_ttl"] = (time.Duration(indexInfo.DefaultTtl) * time.Second).String()
+	}
 
+	return indexInfoMap, nil
 }
@@ -0,0 +1,135 @@
+package indexer
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	pbindex "github.com/mosuka/blast/protobuf/index"
+)
+
+func TestBulkLineReader(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected []string
+		lineNums []int
+	}{
+		{"", nil, nil},
+		{"{\"a\":1}", []string{"{\"a\":1}"}, []int{1}},
+		{"{\"a\":1}\n{\"b\":2}\n", []string{"{\"a\":1}", "{\"b\":2}"}, []int{1, 2}},
+		{"\n  \n{\"a\":1}\r\n\n{\"b\":2}", []string{"{\"a\":1}", "{\"b\":2}"}, []int{3, 5}},
+	}
+
+	for _, c := range cases {
+		reader := newBulkLineReader(strings.NewReader(c.body))
+
+		var lines []string
+		var lineNums []int
+		for {
+			line, err := reader.next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			lines = append(lines, string(line))
+			lineNums = append(lineNums, reader.lineNum)
+		}
+
+		if !reflect.DeepEqual(c.expected, lines) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, lines)
+		}
+		if !reflect.DeepEqual(c.lineNums, lineNums) {
+			t.Errorf("expected content to see %v, saw %v", c.lineNums, lineNums)
+		}
+	}
+}
+
+func TestNewExpiry(t *testing.T) {
+	cases := []struct {
+		ttl      string
+		expireAt string
+		expected [2]int64
+		err      bool
+	}{
+		{"", "", [2]int64{0, 0}, false},
+		{"60s", "", [2]int64{60, 0}, false},
+		{"1h", "", [2]int64{3600, 0}, false},
+		{"1500ms", "", [2]int64{2, 0}, false},
+		{"", "2019-01-02T03:04:05Z", [2]int64{0, 1546398245}, false},
+		{"10s", "2019-01-02T03:04:05+09:00", [2]int64{10, 1546365845}, false},
+		{"0s", "", [2]int64{0, 0}, true},
+		{"-1s", "", [2]int64{0, 0}, true},
+		{"60", "", [2]int64{0, 0}, true},
+		{"", "2019-01-02", [2]int64{0, 0}, true},
+		{"", "1970-01-01T00:00:00Z", [2]int64{0, 0}, true},
+	}
+
+	for _, c := range cases {
+		ttl, expireAt, err := newExpiry(c.ttl, c.expireAt)
+		if (err != nil) != c.err {
+			t.Errorf("expected content to see error %v for %q and %q, saw %v", c.err, c.ttl, c.expireAt, err)
+			continue
+		}
+		if actual := [2]int64{ttl, expireAt}; actual != c.expected {
+			t.Errorf("expected content to see %v, saw %v", c.expected, actual)
+		}
+	}
+}
+
+func TestNewExpiryValues(t *testing.T) {
+	cases := []struct {
+		ttl      interface{}
+		expireAt interface{}
+		expected [2]int64
+		err      bool
+	}{
+		{nil, nil, [2]int64{0, 0}, false},
+		{"90s", nil, [2]int64{90, 0}, false},
+		{float64(90), nil, [2]int64{90, 0}, false},
+		{1.5, nil, [2]int64{2, 0}, false},
+		{nil, "2019-01-02T03:04:05Z", [2]int64{0, 1546398245}, false},
+		{float64(0), nil, [2]int64{0, 0}, true},
+		{true, nil, [2]int64{0, 0}, true},
+		{map[string]interface{}{}, nil, [2]int64{0, 0}, true},
+		{nil, float64(1546398245), [2]int64{0, 0}, true},
+	}
+
+	for _, c := range cases {
+		ttl, expireAt, err := newExpiryValues(c.ttl, c.expireAt)
+		if (err != nil) != c.err {
+			t.Errorf("expected content to see error %v for %v and %v, saw %v", c.err, c.ttl, c.expireAt, err)
+			continue
+		}
+		if actual := [2]int64{ttl, expireAt}; actual != c.expected {
+			t.Errorf("expected content to see %v, saw %v", c.expected, actual)
+		}
+	}
+}
+
+func TestNewOpType(t *testing.T) {
+	cases := []struct {
+		opType   string
+		expected pbindex.Document_OpType
+		err      bool
+	}{
+		{"", pbindex.Document_UPSERT, false},
+		{"upsert", pbindex.Document_UPSERT, false},
+		{"create", pbindex.Document_CREATE, false},
+		{"update", pbindex.Document_UPDATE, false},
+		{"CREATE", pbindex.Document_UPSERT, true},
+		{"replace", pbindex.Document_UPSERT, true},
+	}
+
+	for _, c := range cases {
+		actual, err := newOpType(c.opType)
+		if (err != nil) != c.err {
+			t.Errorf("expected content to see error %v for %q, saw %v", c.err, c.opType, err)
+		}
+		if actual != c.expected {
+			t.Errorf("expected content to see %v, saw %v", c.expected, actual)
+		}
+	}
+}
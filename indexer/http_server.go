@@ -45,11 +45,70 @@ func NewHTTPServer(httpAddr string, grpcClient *GRPCClient, logger *log.Logger,
 
 	router.Handle("/", NewRootHandler(logger)).Methods("GET")
 	router.Handle("/documents", NewIndexHandler(grpcClient, logger)).Methods("PUT")
+	router.Handle("/documents", NewIndexHandler(grpcClient, logger)).Methods("POST")
 	router.Handle("/documents", NewDeleteHandler(grpcClient, logger)).Methods("DELETE")
+	router.Handle("/documents/_mget", NewMultiGetHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/documents/_export", NewExportHandler(grpcClient, logger)).Methods("GET")
 	router.Handle("/documents/{id}", NewGetHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/documents/{id}/similar", NewSimilarHandler(grpcClient, logger)).Methods("GET")
 	router.Handle("/documents/{id}", NewIndexHandler(grpcClient, logger)).Methods("PUT")
 	router.Handle("/documents/{id}", NewDeleteHandler(grpcClient, logger)).Methods("DELETE")
-	router.Handle("/search", NewSearchHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/_bulk", NewBulkHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/search", NewSearchHandler(grpcClient, logger)).Methods("GET", "POST")
+	router.Handle("/count", NewCountHandler(grpcClient, logger)).Methods("GET", "POST")
+	router.Handle("/explain/{id}", NewExplainHandler(grpcClient, logger)).Methods("GET", "POST")
+	router.Handle("/analyze", NewAnalyzeHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/suggest", NewSuggestHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/fields", NewFieldStatsHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/fields/{name}/terms", NewFieldTermsHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/search/scroll", NewScrollHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/search/scroll", NewClearScrollHandler(grpcClient, logger)).Methods("DELETE")
+	router.Handle("/mapping", NewGetIndexMappingHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/mapping", NewPutIndexMappingHandler(grpcClient, logger)).Methods("PUT")
+	router.Handle("/reindex", NewReindexStatusHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes", NewListIndexesHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}", NewGetIndexHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}", NewCreateIndexHandler(grpcClient, logger)).Methods("PUT")
+	router.Handle("/indexes/{index}", NewDeleteIndexHandler(grpcClient, logger)).Methods("DELETE")
+	router.Handle("/indexes/{index}/documents", NewIndexHandler(grpcClient, logger)).Methods("PUT")
+	router.Handle("/indexes/{index}/documents", NewIndexHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/indexes/{index}/documents", NewDeleteHandler(grpcClient, logger)).Methods("DELETE")
+	router.Handle("/indexes/{index}/documents/_mget", NewMultiGetHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/indexes/{index}/documents/_export", NewExportHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}/documents/{id}", NewGetHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}/documents/{id}/similar", NewSimilarHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}/documents/{id}", NewIndexHandler(grpcClient, logger)).Methods("PUT")
+	router.Handle("/indexes/{index}/documents/{id}", NewDeleteHandler(grpcClient, logger)).Methods("DELETE")
+	router.Handle("/indexes/{index}/_bulk", NewBulkHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/indexes/{index}/search", NewSearchHandler(grpcClient, logger)).Methods("GET", "POST")
+	router.Handle("/indexes/{index}/count", NewCountHandler(grpcClient, logger)).Methods("GET", "POST")
+	router.Handle("/indexes/{index}/explain/{id}", NewExplainHandler(grpcClient, logger)).Methods("GET", "POST")
+	router.Handle("/indexes/{index}/analyze", NewAnalyzeHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/indexes/{index}/suggest", NewSuggestHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}/fields", NewFieldStatsHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}/fields/{name}/terms", NewFieldTermsHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}/mapping", NewGetIndexMappingHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}/mapping", NewPutIndexMappingHandler(grpcClient, logger)).Methods("PUT")
+	router.Handle("/indexes/{index}/reindex", NewReindexStatusHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/aliases", NewListAliasesHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/aliases/{alias}", NewGetAliasHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/aliases/{alias}", NewPutAliasHandler(grpcClient, logger)).Methods("PUT")
+	router.Handle("/aliases/{alias}", NewDeleteAliasHandler(grpcClient, logger)).Methods("DELETE")
+	router.Handle("/aliases/{alias}/swap", NewSwapAliasHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/percolator", NewListPercolatorQueriesHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/percolator/{id}", NewPutPercolatorQueryHandler(grpcClient, logger)).Methods("PUT")
+	router.Handle("/percolator/{id}", NewDeletePercolatorQueryHandler(grpcClient, logger)).Methods("DELETE")
+	router.Handle("/percolate", NewPercolateHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/percolate/_subscribe", NewSubscribePercolationsHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/_changes", NewChangesHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/pipelines/_simulate", NewSimulatePipelineHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/pipelines/{name}/_simulate", NewSimulatePipelineHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/indexes/{index}/percolator", NewListPercolatorQueriesHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}/percolator/{id}", NewPutPercolatorQueryHandler(grpcClient, logger)).Methods("PUT")
+	router.Handle("/indexes/{index}/percolator/{id}", NewDeletePercolatorQueryHandler(grpcClient, logger)).Methods("DELETE")
+	router.Handle("/indexes/{index}/percolate", NewPercolateHandler(grpcClient, logger)).Methods("POST")
+	router.Handle("/indexes/{index}/percolate/_subscribe", NewSubscribePercolationsHandler(grpcClient, logger)).Methods("GET")
+	router.Handle("/indexes/{index}/_changes", NewChangesHandler(grpcClient, logger)).Methods("GET")
 	router.Handle("/metrics", promhttp.Handler()).Methods("GET")
 
 	return &HTTPServer{
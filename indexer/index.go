@@ -15,29 +15,63 @@
 package indexer
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis"
+	bleveindex "github.com/blevesearch/bleve/index"
 	"github.com/blevesearch/bleve/mapping"
-	"github.com/golang/protobuf/ptypes/any"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
 	blasterrors "github.com/mosuka/blast/errors"
 	"github.com/mosuka/blast/protobuf"
 	pbindex "github.com/mosuka/blast/protobuf/index"
 )
 
 type Index struct {
-	index  bleve.Index
+	dir              string
+	indexStorageType string
+
+	index bleve.Index
+	mutex sync.RWMutex
+
+	reindexer      *Reindexer
+	reindexerMutex sync.Mutex
+
+	scrolls      map[string]*scrollContext
+	scrollsMutex sync.Mutex
+	stopCh       chan struct{}
+
+	percolatorQueries      map[string]query.Query
+	percolatorQueriesMutex sync.RWMutex
+
+	defaultTTL      int64
+	defaultTTLMutex sync.RWMutex
+
 	logger *log.Logger
 }
 
 func NewIndex(dir string, indexMapping *mapping.IndexMappingImpl, indexStorageType string, logger *log.Logger) (*Index, error) {
 	bleve.SetLog(logger)
 
+	// recover from a swap interrupted by a crash
+	err := recoverSwappedIndex(dir)
+	if err != nil {
+		return nil, err
+	}
+
 	var index bleve.Index
-	_, err := os.Stat(dir)
+	_, err = os.Stat(dir)
 	if os.IsNotExist(err) {
 		// create new index
 		index, err = bleve.NewUsing(dir, indexMapping, bleve.Config.DefaultIndexType, indexStorageType, nil)
@@ -46,22 +80,42 @@ func NewIndex(dir string, indexMapping *mapping.IndexMappingImpl, indexStorageTy
 		}
 	} else {
 		// open existing index
-		index, err = bleve.OpenUsing(dir, map[string]interface{}{
-			"create_if_missing": false,
-			"error_if_exists":   false,
-		})
+		index, err = openIndex(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		// use the storage type the index was created with
+		indexStorageType, err = readIndexStorageType(dir)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return &Index{
-		index:  index,
-		logger: logger,
-	}, nil
+	b := &Index{
+		dir:               dir,
+		indexStorageType:  indexStorageType,
+		index:             index,
+		scrolls:           make(map[string]*scrollContext),
+		stopCh:            make(chan struct{}),
+		percolatorQueries: make(map[string]query.Query),
+		logger:            logger,
+	}
+
+	go b.reapScrolls()
+
+	return b, nil
 }
 
 func (b *Index) Close() error {
+	b.cancelReindex()
+
+	close(b.stopCh)
+	b.closeScrolls()
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
 	err := b.index.Close()
 	if err != nil {
 		return err
@@ -76,6 +130,9 @@ func (b *Index) Get(id string) (map[string]interface{}, error) {
 		b.logger.Printf("[DEBUG] get %s %f", id, float64(time.Since(start))/float64(time.Second))
 	}()
 
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
 	fieldsBytes, err := b.index.GetInternal([]byte(id))
 	if err != nil {
 		return nil, err
@@ -94,6 +151,19 @@ func (b *Index) Get(id string) (map[string]interface{}, error) {
 	return fieldsMap, nil
 }
 
+// Exists reports whether the original document is stored.
+func (b *Index) Exists(id string) (bool, error) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	fieldsBytes, err := b.index.GetInternal([]byte(id))
+	if err != nil {
+		return false, err
+	}
+
+	return len(fieldsBytes) > 0, nil
+}
+
 func (b *Index) Search(request *bleve.SearchRequest) (*bleve.SearchResult, error) {
 	start := time.Now()
 	defer func() {
@@ -101,6 +171,9 @@ func (b *Index) Search(request *bleve.SearchRequest) (*bleve.SearchResult, error
 		b.logger.Printf("[DEBUG] search %s %f", rb, float64(time.Since(start))/float64(time.Second))
 	}()
 
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
 	result, err := b.index.Search(request)
 	if err != nil {
 		return nil, err
@@ -109,19 +182,128 @@ func (b *Index) Search(request *bleve.SearchRequest) (*bleve.SearchResult, error
 	return result, nil
 }
 
-func (b *Index) Index(id string, fields map[string]interface{}) error {
+// SearchAfter searches the hits that sort after the given sort values of the last hit of the previous page.
+func (b *Index) SearchAfter(request *bleve.SearchRequest, after []string) (*bleve.SearchResult, error) {
+	start := time.Now()
+	defer func() {
+		rb, _ := json.Marshal(request)
+		b.logger.Printf("[DEBUG] search after %s %v %f", rb, after, float64(time.Since(start))/float64(time.Second))
+	}()
+
+	err := validateSearchAfter(request, after)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Sort) <= 0 {
+		request.Sort = search.SortOrder{&search.SortScore{Desc: true}}
+	}
+
+	afterMatch, err := newAfterDocumentMatch(request.Sort, after)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		return nil, err
+	}
+	reader, err := i.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := reader.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	return searchReader(context.Background(), reader, b.index.Mapping(), b.index.Name(), request, afterMatch)
+}
+
+// SearchEach passes all the hits of a search to f, one page at a time. Each page is searched under the lock
+// and passed to f after releasing it, so that a slow f holds up neither the writes nor a reindex swap,
+// and the pages follow one another by search after, seeing the changes made in between.
+func (b *Index) SearchEach(request *bleve.SearchRequest, pageSize int, f func(search.DocumentMatchCollection) error) error {
+	start := time.Now()
+	defer func() {
+		rb, _ := json.Marshal(request)
+		b.logger.Printf("[DEBUG] search each %s %f", rb, float64(time.Since(start))/float64(time.Second))
+	}()
+
+	pageRequest := *request
+	pageRequest.Size = pageSize
+	pageRequest.From = 0
+	if len(pageRequest.Sort) <= 0 {
+		pageRequest.Sort = search.SortOrder{&search.SortScore{Desc: true}}
+	}
+	if !hasDocIDSort(pageRequest.Sort) {
+		pageRequest.Sort = append(pageRequest.Sort.Copy(), &search.SortDocID{})
+	}
+
+	var after *search.DocumentMatch
+	for {
+		hits, err := b.searchPage(&pageRequest, after)
+		if err != nil {
+			return err
+		}
+		if len(hits) <= 0 {
+			return nil
+		}
+
+		err = f(hits)
+		if err != nil {
+			return err
+		}
+
+		after = hits[len(hits)-1]
+	}
+}
+
+func (b *Index) searchPage(request *bleve.SearchRequest, after *search.DocumentMatch) (search.DocumentMatchCollection, error) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		return nil, err
+	}
+	reader, err := i.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := reader.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	result, err := searchReader(context.Background(), reader, b.index.Mapping(), b.index.Name(), request, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Hits, nil
+}
+
+// Index indexes the document, which expires at expireAt in seconds since the epoch unless it is 0.
+func (b *Index) Index(id string, fields map[string]interface{}, expireAt int64) error {
 	start := time.Now()
 	defer func() {
 		b.logger.Printf("[DEBUG] index %s %v %f", id, fields, float64(time.Since(start))/float64(time.Second))
 	}()
 
-	// index
-	b.logger.Printf("[DEBUG] index %s, %v", id, fields)
-	err := b.index.Index(id, fields)
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	doc, err := newDocument(b.index.Mapping(), id, fields, expireAt)
 	if err != nil {
 		return err
 	}
-	b.logger.Printf("[DEBUG] indexed %s, %v", id, fields)
 
 	// map[string]interface{} -> bytes
 	fieldsBytes, err := json.Marshal(fields)
@@ -129,11 +311,22 @@ func (b *Index) Index(id string, fields map[string]interface{}) error {
 		return err
 	}
 
-	// set original document
-	err = b.index.SetInternal([]byte(id), fieldsBytes)
+	// index along with the original document
+	b.logger.Printf("[DEBUG] index %s, %v", id, fields)
+	batch := b.index.NewBatch()
+	err = batch.IndexAdvanced(doc)
+	if err != nil {
+		return err
+	}
+	batch.SetInternal([]byte(id), fieldsBytes)
+	err = b.index.Batch(batch)
 	if err != nil {
 		return err
 	}
+	b.logger.Printf("[DEBUG] indexed %s, %v", id, fields)
+
+	// let a running reindex catch up with this document
+	b.markDirty(id)
 
 	return nil
 }
@@ -144,6 +337,9 @@ func (b *Index) Delete(id string) error {
 		b.logger.Printf("[DEBUG] delete %s %f", id, float64(time.Since(start))/float64(time.Second))
 	}()
 
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
 	err := b.index.Delete(id)
 	if err != nil {
 		return err
@@ -155,6 +351,9 @@ func (b *Index) Delete(id string) error {
 		return err
 	}
 
+	// let a running reindex catch up with this document
+	b.markDirty(id)
+
 	return nil
 }
 
@@ -164,30 +363,74 @@ func (b *Index) Stats() (map[string]interface{}, error) {
 		b.logger.Printf("[DEBUG] stats %f", float64(time.Since(start))/float64(time.Second))
 	}()
 
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
 	stats := b.index.StatsMap()
 
 	return stats, nil
 }
 
+func (b *Index) Name() string {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.index.Name()
+}
+
+func (b *Index) StorageType() string {
+	return b.indexStorageType
+}
+
+func (b *Index) Mapping() *mapping.IndexMappingImpl {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.index.Mapping().(*mapping.IndexMappingImpl)
+}
+
 func (b *Index) SnapshotItems() <-chan *pbindex.Document {
 	ch := make(chan *pbindex.Document, 1024)
 
 	go func() {
+		// keep the index from being swapped by a reindex while taking a snapshot
+		b.mutex.RLock()
+		defer b.mutex.RUnlock()
+
 		i, _, err := b.index.Advanced()
 		if err != nil {
 			b.logger.Printf("[ERR] %v", err)
+			ch <- nil
 			return
 		}
 
 		r, err := i.Reader()
 		if err != nil {
 			b.logger.Printf("[ERR] %v", err)
+			ch <- nil
 			return
 		}
+		defer func() {
+			err := r.Close()
+			if err != nil {
+				b.logger.Printf("[ERR] %v", err)
+			}
+		}()
 
 		docCount := 0
 
 		dr, err := r.DocIDReaderAll()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+			ch <- nil
+			return
+		}
+		defer func() {
+			err := dr.Close()
+			if err != nil {
+				b.logger.Printf("[ERR] %v", err)
+			}
+		}()
 		for {
 			id, err := dr.Next()
 			if id == nil {
@@ -210,17 +453,23 @@ func (b *Index) SnapshotItems() <-chan *pbindex.Document {
 			}
 			b.logger.Printf("[DEBUG] %v", fieldsMap)
 
-			// map[string]interface{} -> Any
-			fieldsAny := &any.Any{}
-			err = protobuf.UnmarshalAny(fieldsMap, fieldsAny)
+			// map[string]interface{} -> Struct
+			fields, err := protobuf.ToStruct(fieldsMap)
+			if err != nil {
+				b.logger.Printf("[ERR] %v", err)
+				break
+			}
+
+			expireAt, err := readExpireAt(r, string(id))
 			if err != nil {
 				b.logger.Printf("[ERR] %v", err)
 				break
 			}
 
 			doc := &pbindex.Document{
-				Id:     string(id),
-				Fields: fieldsAny,
+				Id:       string(id),
+				Fields:   fields,
+				ExpireAt: expireAt,
 			}
 
 			ch <- doc
@@ -238,3 +487,484 @@ func (b *Index) SnapshotItems() <-chan *pbindex.Document {
 
 	return ch
 }
+
+// Explain returns the hit of the document with its score explanation, or nil if the document does not match the query.
+func (b *Index) Explain(id string, q query.Query) (*search.DocumentMatch, error) {
+	start := time.Now()
+	defer func() {
+		b.logger.Printf("[DEBUG] explain %s %f", id, float64(time.Since(start))/float64(time.Second))
+	}()
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := i.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := r.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	// find the document
+	dr, err := r.DocIDReaderOnly([]string{id})
+	if err != nil {
+		return nil, err
+	}
+	internalId, err := dr.Next()
+	_ = dr.Close()
+	if err != nil {
+		return nil, err
+	}
+	if internalId == nil {
+		return nil, blasterrors.ErrNotFound
+	}
+
+	searcher, err := q.Searcher(r, b.index.Mapping(), search.SearcherOptions{Explain: true})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := searcher.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	// jump to the document instead of scoring all the matches before it
+	searchContext := &search.SearchContext{
+		DocumentMatchPool: search.NewDocumentMatchPool(searcher.DocumentMatchPoolSize()+1, 0),
+	}
+	d, err := searcher.Advance(searchContext, internalId)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil || !d.IndexInternalID.Equals(internalId) {
+		return nil, nil
+	}
+
+	d.ID = id
+	d.Index = b.index.Name()
+
+	return d, nil
+}
+
+// Analyze returns the tokens the named analyzer, or the analyzer of the field, produces for the text,
+// using the analyzers of the index mapping. Without either the default analyzer is used.
+func (b *Index) Analyze(text string, analyzerName string, field string) (string, analysis.TokenStream, error) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	indexMapping, ok := b.index.Mapping().(*mapping.IndexMappingImpl)
+	if !ok {
+		return "", nil, errors.New("unsupported index mapping")
+	}
+
+	if analyzerName == "" {
+		if field != "" {
+			analyzerName = indexMapping.AnalyzerNameForPath(field)
+		} else {
+			analyzerName = indexMapping.DefaultAnalyzer
+		}
+	}
+
+	analyzer := indexMapping.AnalyzerNamed(analyzerName)
+	if analyzer == nil {
+		return "", nil, fmt.Errorf("no analyzer named %s", analyzerName)
+	}
+
+	return analyzerName, analyzer.Analyze([]byte(text)), nil
+}
+
+// Count returns the number of documents matching the query, all of them if the query is nil,
+// and the number of documents in the index. Matches are counted without collecting hits.
+func (b *Index) Count(q query.Query) (uint64, uint64, error) {
+	start := time.Now()
+	defer func() {
+		b.logger.Printf("[DEBUG] count %f", float64(time.Since(start))/float64(time.Second))
+	}()
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	r, err := i.Reader()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		err := r.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	docCount, err := r.DocCount()
+	if err != nil {
+		return 0, 0, err
+	}
+	if q == nil {
+		return docCount, docCount, nil
+	}
+
+	searcher, err := q.Searcher(r, b.index.Mapping(), search.SearcherOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		err := searcher.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	searchContext := &search.SearchContext{
+		DocumentMatchPool: search.NewDocumentMatchPool(searcher.DocumentMatchPoolSize()+1, 0),
+	}
+
+	count := uint64(0)
+	for {
+		d, err := searcher.Next(searchContext)
+		if err != nil {
+			return 0, 0, err
+		}
+		if d == nil {
+			return count, docCount, nil
+		}
+
+		count++
+
+		searchContext.DocumentMatchPool.Put(d)
+	}
+}
+
+const exportPageSize = 1000
+
+type exportedDocument struct {
+	id     string
+	fields map[string]interface{}
+}
+
+// Export passes the original documents to f, either all of them or the ones matching the query, in the order
+// of their IDs. The documents are read a page at a time under the lock and passed to f after releasing it,
+// so that a slow f holds up neither the writes nor a reindex swap.
+func (b *Index) Export(q query.Query, f func(id string, fields map[string]interface{}) error) error {
+	start := time.Now()
+	docCount := 0
+	defer func() {
+		b.logger.Printf("[DEBUG] export %d documents %f", docCount, float64(time.Since(start))/float64(time.Second))
+	}()
+
+	var after bleveindex.IndexInternalID
+	for {
+		docs, last, err := b.exportPage(q, after, exportPageSize)
+		if err != nil {
+			return err
+		}
+		if last == nil {
+			return nil
+		}
+
+		for _, doc := range docs {
+			docCount++
+
+			err = f(doc.id, doc.fields)
+			if err != nil {
+				return err
+			}
+		}
+
+		after = last
+	}
+}
+
+// exportPage reads up to size documents following the internal ID after, which is the document ID itself
+// in the upside down index, and returns them with the internal ID of the last one read, nil at the end.
+func (b *Index) exportPage(q query.Query, after bleveindex.IndexInternalID, size int) ([]*exportedDocument, bleveindex.IndexInternalID, error) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r, err := i.Reader()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		err := r.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	var next func() (bleveindex.IndexInternalID, error)
+	var advance func(bleveindex.IndexInternalID) (bleveindex.IndexInternalID, error)
+	if q == nil {
+		dr, err := r.DocIDReaderAll()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			err := dr.Close()
+			if err != nil {
+				b.logger.Printf("[ERR] %v", err)
+			}
+		}()
+
+		next = dr.Next
+		advance = dr.Advance
+	} else {
+		searcher, err := q.Searcher(r, b.index.Mapping(), search.SearcherOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			err := searcher.Close()
+			if err != nil {
+				b.logger.Printf("[ERR] %v", err)
+			}
+		}()
+
+		searchContext := &search.SearchContext{
+			DocumentMatchPool: search.NewDocumentMatchPool(searcher.DocumentMatchPoolSize()+1, 0),
+		}
+		internalID := func(d *search.DocumentMatch, err error) (bleveindex.IndexInternalID, error) {
+			if d == nil || err != nil {
+				return nil, err
+			}
+			defer searchContext.DocumentMatchPool.Put(d)
+
+			return append(bleveindex.IndexInternalID{}, d.IndexInternalID...), nil
+		}
+
+		next = func() (bleveindex.IndexInternalID, error) {
+			return internalID(searcher.Next(searchContext))
+		}
+		advance = func(id bleveindex.IndexInternalID) (bleveindex.IndexInternalID, error) {
+			return internalID(searcher.Advance(searchContext, id))
+		}
+	}
+
+	docs := make([]*exportedDocument, 0, size)
+	var last bleveindex.IndexInternalID
+	advanced := after == nil
+	for len(docs) < size {
+		var internalId bleveindex.IndexInternalID
+		var err error
+		if !advanced {
+			internalId, err = advance(after)
+			advanced = true
+		} else {
+			internalId, err = next()
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if internalId == nil {
+			break
+		}
+		if after != nil && internalId.Equals(after) {
+			// advanced to the last one of the previous page
+			continue
+		}
+		last = append(bleveindex.IndexInternalID{}, internalId...)
+
+		id, err := r.ExternalID(internalId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// get original document
+		fieldsBytes, err := r.GetInternal([]byte(id))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(fieldsBytes) <= 0 {
+			continue
+		}
+
+		// bytes -> map[string]interface{}
+		var fieldsMap map[string]interface{}
+		err = json.Unmarshal(fieldsBytes, &fieldsMap)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		docs = append(docs, &exportedDocument{id: id, fields: fieldsMap})
+	}
+
+	return docs, last, nil
+}
+
+func (b *Index) Reindex(indexMapping *mapping.IndexMappingImpl) error {
+	// a newer mapping supersedes the one being built
+	b.cancelReindex()
+
+	reindexer, err := NewReindexer(b, indexMapping, b.logger)
+	if err != nil {
+		return err
+	}
+
+	b.reindexerMutex.Lock()
+	b.reindexer = reindexer
+	b.reindexerMutex.Unlock()
+
+	go reindexer.Run()
+
+	return nil
+}
+
+func (b *Index) ReindexStatus() *pbindex.ReindexStatus {
+	b.reindexerMutex.Lock()
+	reindexer := b.reindexer
+	b.reindexerMutex.Unlock()
+
+	if reindexer == nil {
+		return &pbindex.ReindexStatus{
+			State: pbindex.ReindexStatus_IDLE,
+		}
+	}
+
+	return reindexer.Status()
+}
+
+func (b *Index) cancelReindex() {
+	b.reindexerMutex.Lock()
+	reindexer := b.reindexer
+	b.reindexerMutex.Unlock()
+
+	if reindexer != nil {
+		reindexer.Cancel()
+	}
+}
+
+func (b *Index) markDirty(id string) {
+	b.reindexerMutex.Lock()
+	reindexer := b.reindexer
+	b.reindexerMutex.Unlock()
+
+	if reindexer != nil {
+		reindexer.MarkDirty(id)
+	}
+}
+
+// swap replaces the serving index with the one built in newDir.
+// The caller must hold the write lock.
+func (b *Index) swap(newIndex bleve.Index, newDir string) error {
+	err := newIndex.Close()
+	if err != nil {
+		return err
+	}
+
+	// open scrolls hold readers of the old index
+	b.closeScrolls()
+
+	err = b.index.Close()
+	if err != nil {
+		return err
+	}
+
+	oldDir := b.dir + oldIndexDirSuffix
+	err = os.RemoveAll(oldDir)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(b.dir, oldDir)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(newDir, b.dir)
+	if err != nil {
+		// put the old index back
+		_ = os.Rename(oldDir, b.dir)
+		index, openErr := openIndex(b.dir)
+		if openErr == nil {
+			b.index = index
+		}
+		return err
+	}
+
+	index, err := openIndex(b.dir)
+	if err != nil {
+		return err
+	}
+	b.index = index
+
+	err = os.RemoveAll(oldDir)
+	if err != nil {
+		b.logger.Printf("[WARN] %v", err)
+	}
+
+	return nil
+}
+
+// searchIndexes searches across the given indexes.
+func searchIndexes(indexes []*Index, request *bleve.SearchRequest) (*bleve.SearchResult, error) {
+	start := time.Now()
+	defer func() {
+		rb, _ := json.Marshal(request)
+		indexes[0].logger.Printf("[DEBUG] search %s %f", rb, float64(time.Since(start))/float64(time.Second))
+	}()
+
+	// lock in a consistent order to avoid deadlocks with concurrent searches
+	sortedIndexes := append([]*Index{}, indexes...)
+	sort.Slice(sortedIndexes, func(i, j int) bool {
+		return sortedIndexes[i].dir < sortedIndexes[j].dir
+	})
+
+	bleveIndexes := make([]bleve.Index, 0, len(sortedIndexes))
+	for _, index := range sortedIndexes {
+		index.mutex.RLock()
+		defer index.mutex.RUnlock()
+
+		bleveIndexes = append(bleveIndexes, index.index)
+	}
+
+	result, err := bleve.NewIndexAlias(bleveIndexes...).Search(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func readIndexStorageType(dir string) (string, error) {
+	metaBytes, err := ioutil.ReadFile(filepath.Join(dir, "index_meta.json"))
+	if err != nil {
+		return "", err
+	}
+
+	var meta struct {
+		Storage string `json:"storage"`
+	}
+	err = json.Unmarshal(metaBytes, &meta)
+	if err != nil {
+		return "", err
+	}
+
+	return meta.Storage, nil
+}
+
+func openIndex(dir string) (bleve.Index, error) {
+	return bleve.OpenUsing(dir, map[string]interface{}{
+		"create_if_missing": false,
+		"error_if_exists":   false,
+	})
+}
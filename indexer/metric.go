@@ -46,11 +46,32 @@ var (
 			"func",
 		},
 	)
+	ExpiredDocumentsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "expired_documents_total",
+			Help:      "The number of expired documents deleted by the reaper.",
+		},
+		[]string{
+			"index",
+		},
+	)
+	ExpiryReaperErrorsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "expiry_reaper_errors_total",
+			Help:      "The number of errors of the reaper deleting expired documents.",
+		},
+	)
 )
 
 func init() {
 	prometheus.MustRegister(DurationSeconds)
 	prometheus.MustRegister(OperationsTotal)
+	prometheus.MustRegister(ExpiredDocumentsTotal)
+	prometheus.MustRegister(ExpiryReaperErrorsTotal)
 }
 
 func RecordMetrics(start time.Time, funcName string) {
@@ -0,0 +1,111 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"sort"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	blasterrors "github.com/mosuka/blast/errors"
+)
+
+const percolateDocId = "_percolate"
+
+func (b *Index) PutPercolatorQuery(id string, q query.Query) {
+	b.percolatorQueriesMutex.Lock()
+	defer b.percolatorQueriesMutex.Unlock()
+
+	b.percolatorQueries[id] = q
+}
+
+func (b *Index) DeletePercolatorQuery(id string) error {
+	b.percolatorQueriesMutex.Lock()
+	defer b.percolatorQueriesMutex.Unlock()
+
+	_, exists := b.percolatorQueries[id]
+	if !exists {
+		return blasterrors.ErrNotFound
+	}
+	delete(b.percolatorQueries, id)
+
+	return nil
+}
+
+// PercolatorQueries returns a copy of the stored percolator queries by id.
+func (b *Index) PercolatorQueries() map[string]query.Query {
+	b.percolatorQueriesMutex.RLock()
+	defer b.percolatorQueriesMutex.RUnlock()
+
+	queries := make(map[string]query.Query, len(b.percolatorQueries))
+	for id, q := range b.percolatorQueries {
+		queries[id] = q
+	}
+
+	return queries
+}
+
+// Percolate returns the ids of the stored percolator queries that match the document, in order.
+// The document is indexed alone into an in-memory index with the mapping of the index,
+// against which each of the queries is run.
+func (b *Index) Percolate(fields map[string]interface{}) ([]string, error) {
+	start := time.Now()
+	defer func() {
+		b.logger.Printf("[DEBUG] percolate %f", float64(time.Since(start))/float64(time.Second))
+	}()
+
+	queries := b.PercolatorQueries()
+	if len(queries) <= 0 {
+		return []string{}, nil
+	}
+
+	ids := make([]string, 0, len(queries))
+	for id := range queries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	memIndex, err := bleve.NewMemOnly(b.Mapping())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := memIndex.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	err = memIndex.Index(percolateDocId, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	matchedIds := make([]string, 0)
+	for _, id := range ids {
+		result, err := memIndex.Search(bleve.NewSearchRequestOptions(queries[id], 0, 0, false))
+		if err != nil {
+			// a query that cannot run against the document does not match it
+			b.logger.Printf("[WARN] percolator query %s: %v", id, err)
+			continue
+		}
+		if result.Total > 0 {
+			matchedIds = append(matchedIds, id)
+		}
+	}
+
+	return matchedIds, nil
+}
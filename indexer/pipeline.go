@@ -0,0 +1,233 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"fmt"
+	"log"
+
+	blasterrors "github.com/mosuka/blast/errors"
+	"github.com/mosuka/blast/manager"
+	"github.com/mosuka/blast/pipeline"
+	"github.com/mosuka/blast/protobuf"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+	"github.com/mosuka/blast/protobuf/management"
+)
+
+// pipelinesKey is the key the pipelines are stored under in the manager.
+const pipelinesKey = "/pipelines"
+
+// PipelineError reports a document that could not be transformed by its ingest pipeline.
+type PipelineError struct {
+	message string
+}
+
+func newPipelineError(name string, id string, err error) *PipelineError {
+	return &PipelineError{
+		message: fmt.Sprintf("pipeline %s failed on document %s: %v", name, id, err),
+	}
+}
+
+func (e *PipelineError) Error() string {
+	return e.message
+}
+
+// PipelineStore looks up the ingest pipelines in the manager if any, and then in the pipelines file.
+type PipelineStore struct {
+	pipelines map[string]*pipeline.Pipeline
+	client    *manager.GRPCClient
+
+	logger *log.Logger
+}
+
+func NewPipelineStore(path string, managerAddr string, logger *log.Logger) (*PipelineStore, error) {
+	pipelines := make(map[string]*pipeline.Pipeline, 0)
+	if path != "" {
+		var err error
+		pipelines, err = pipeline.LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the client connects in the background and reconnects by itself, so it is kept for all the lookups
+	var client *manager.GRPCClient
+	if managerAddr != "" {
+		var err error
+		client, err = manager.NewGRPCClient(managerAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &PipelineStore{
+		pipelines: pipelines,
+		client:    client,
+		logger:    logger,
+	}, nil
+}
+
+func (s *PipelineStore) Close() error {
+	if s.client == nil {
+		return nil
+	}
+
+	return s.client.Close()
+}
+
+// Get returns the pipeline with the given name, or ErrNotFound.
+// The pipelines file is used as well when the manager cannot be reached.
+func (s *PipelineStore) Get(name string) (*pipeline.Pipeline, error) {
+	if s.client != nil {
+		p, err := s.getFromManager(name)
+		if err == nil {
+			return p, nil
+		}
+		if err != blasterrors.ErrNotFound {
+			s.logger.Printf("[WARN] failed to get pipeline %s from the manager: %v", name, err)
+		}
+	}
+
+	p, exists := s.pipelines[name]
+	if !exists {
+		return nil, blasterrors.ErrNotFound
+	}
+
+	return p, nil
+}
+
+// getFromManager reads the pipelines object stored under pipelinesKey in the manager, which maps the pipeline
+// names to their definitions, and builds the named one.
+func (s *PipelineStore) getFromManager(name string) (*pipeline.Pipeline, error) {
+	kvp, err := s.client.Get(&management.KeyValuePair{Key: pipelinesKey})
+	if err != nil {
+		return nil, err
+	}
+
+	// Any -> map[string]interface{}
+	value, err := protobuf.MarshalAny(kvp.Value)
+	if err != nil {
+		return nil, err
+	}
+	configs, ok := value.(*map[string]interface{})
+	if !ok || configs == nil {
+		return nil, fmt.Errorf("%s in the manager must be an object", pipelinesKey)
+	}
+
+	config, exists := (*configs)[name]
+	if !exists {
+		return nil, blasterrors.ErrNotFound
+	}
+	configMap, ok := config.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("pipeline %s in the manager must be an object", name)
+	}
+
+	return pipeline.NewPipeline(configMap)
+}
+
+// applyPipelines transforms the fields of the documents with their pipelines, so that the raft log holds
+// the transformed documents and the replicas do not depend on the pipelines.
+func (s *RaftServer) applyPipelines(docs []*pbindex.Document) error {
+	pipelines := make(map[string]*pipeline.Pipeline, 0)
+
+	for _, doc := range docs {
+		if doc.Pipeline == "" {
+			continue
+		}
+
+		p, exists := pipelines[doc.Pipeline]
+		if !exists {
+			var err error
+			p, err = s.pipelines.Get(doc.Pipeline)
+			if err == blasterrors.ErrNotFound {
+				return newPipelineError(doc.Pipeline, doc.Id, err)
+			}
+			if err != nil {
+				return err
+			}
+			pipelines[doc.Pipeline] = p
+		}
+
+		// Struct -> map[string]interface{}
+		fields, err := protobuf.FromStruct(doc.Fields)
+		if err != nil {
+			return err
+		}
+
+		processed, err := p.Process(fields)
+		if err != nil {
+			return newPipelineError(doc.Pipeline, doc.Id, err)
+		}
+
+		// map[string]interface{} -> Struct
+		doc.Fields, err = protobuf.ToStruct(processed)
+		if err != nil {
+			return newPipelineError(doc.Pipeline, doc.Id, err)
+		}
+		doc.Pipeline = ""
+	}
+
+	return nil
+}
+
+// SimulatePipeline transforms the documents with the named pipeline, or with the given one if the name is empty,
+// without indexing them.
+func (s *RaftServer) SimulatePipeline(req *pbindex.SimulatePipelineRequest) (*pbindex.SimulatePipelineResponse, error) {
+	var p *pipeline.Pipeline
+	var err error
+	if req.Name != "" {
+		p, err = s.pipelines.Get(req.Name)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Struct -> map[string]interface{}
+		config, err := protobuf.FromStruct(req.Pipeline)
+		if err != nil {
+			return nil, err
+		}
+		p, err = pipeline.NewPipeline(config)
+		if err != nil {
+			return nil, &PipelineError{message: err.Error()}
+		}
+	}
+
+	resp := &pbindex.SimulatePipelineResponse{
+		Docs: make([]*pbindex.SimulatedDocument, 0, len(req.Docs)),
+	}
+	for _, docFields := range req.Docs {
+		simulated := &pbindex.SimulatedDocument{}
+
+		// Struct -> map[string]interface{}
+		fields, err := protobuf.FromStruct(docFields)
+		if err != nil {
+			return nil, err
+		}
+
+		processed, err := p.Process(fields)
+		if err == nil {
+			// map[string]interface{} -> Struct
+			simulated.Fields, err = protobuf.ToStruct(processed)
+		}
+		if err != nil {
+			simulated.Error = err.Error()
+		}
+
+		resp.Docs = append(resp.Docs, simulated)
+	}
+
+	return resp, nil
+}
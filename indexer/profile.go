@@ -0,0 +1,108 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/mosuka/blast/protobuf/index"
+)
+
+// SearchProfile holds the timings of the phases of a search on the node that served it.
+type SearchProfile struct {
+	NodeId string                `json:"node_id"`
+	Phases []*SearchProfilePhase `json:"phases"`
+	Took   time.Duration         `json:"took"`
+}
+
+type SearchProfilePhase struct {
+	Name string        `json:"name"`
+	Took time.Duration `json:"took"`
+}
+
+func NewSearchProfile(profile *index.SearchProfile) (*SearchProfile, error) {
+	if profile == nil {
+		return nil, nil
+	}
+
+	took, err := ptypes.Duration(profile.Took)
+	if err != nil {
+		return nil, err
+	}
+
+	searchProfile := &SearchProfile{
+		NodeId: profile.NodeId,
+		Phases: make([]*SearchProfilePhase, 0, len(profile.Phases)),
+		Took:   took,
+	}
+	for _, phase := range profile.Phases {
+		phaseTook, err := ptypes.Duration(phase.Took)
+		if err != nil {
+			return nil, err
+		}
+		searchProfile.Phases = append(searchProfile.Phases, &SearchProfilePhase{
+			Name: phase.Name,
+			Took: phaseTook,
+		})
+	}
+
+	return searchProfile, nil
+}
+
+// searchProfiler records the phases of a search. A nil profiler records nothing.
+type searchProfiler struct {
+	profile    *index.SearchProfile
+	start      time.Time
+	phaseStart time.Time
+}
+
+func newSearchProfiler(enabled bool, nodeId string, start time.Time) *searchProfiler {
+	if !enabled {
+		return nil
+	}
+
+	return &searchProfiler{
+		profile: &index.SearchProfile{
+			NodeId: nodeId,
+		},
+		start:      start,
+		phaseStart: start,
+	}
+}
+
+// phase ends the current phase, which started when the previous one ended.
+func (p *searchProfiler) phase(name string) {
+	if p == nil {
+		return
+	}
+
+	now := time.Now()
+	p.profile.Phases = append(p.profile.Phases, &index.SearchProfile_Phase{
+		Name: name,
+		Took: ptypes.DurationProto(now.Sub(p.phaseStart)),
+	})
+	p.phaseStart = now
+}
+
+func (p *searchProfiler) finish() *index.SearchProfile {
+	if p == nil {
+		return nil
+	}
+
+	p.profile.Took = ptypes.DurationProto(time.Since(p.start))
+
+	return p.profile
+}
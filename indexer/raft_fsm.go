@@ -16,13 +16,25 @@ package indexer
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis"
 	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/raft"
 	blasterrors "github.com/mosuka/blast/errors"
 	"github.com/mosuka/blast/protobuf"
@@ -30,74 +42,1046 @@ import (
 	blastraft "github.com/mosuka/blast/protobuf/raft"
 )
 
+const (
+	DefaultIndexName = "default"
+
+	scrollIDSeparator = ":"
+
+	percolationBufferSize = 1000
+)
+
+var indexNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]*$`)
+
+var (
+	errDocumentExists  = errors.New("document already exists")
+	errDocumentMissing = errors.New("document does not exist")
+)
+
+func ValidateIndexName(name string) error {
+	if !indexNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid index name: %s", name)
+	}
+
+	return nil
+}
+
 type RaftFSM struct {
-	index *Index
+	dir              string
+	indexMapping     *mapping.IndexMappingImpl
+	indexStorageType string
+
+	indexes      map[string]*Index
+	aliases      map[string][]string
+	indexesMutex sync.RWMutex
+
+	metadata map[string]*blastraft.Node
+
+	percolationSubscribers      map[*percolationSubscriber]struct{}
+	percolationSubscribersMutex sync.Mutex
+	percolations                chan *percolationRequest
+
+	stopCh chan struct{}
+
+	changeSubscribers      map[*changeSubscriber]struct{}
+	changeSubscribersMutex sync.Mutex
+
+	// the raft indexes of the document commands that were not applied, to leave them out of the log replay
+	rejectedChanges      map[uint64]struct{}
+	rejectedChangesMutex sync.RWMutex
+	appliedIndex         uint64
+	trailingLogs         uint64
+
+	logger *log.Logger
+}
+
+func NewRaftFSM(dir string, indexMapping *mapping.IndexMappingImpl, indexStorageType string, logger *log.Logger) (*RaftFSM, error) {
+	f := &RaftFSM{
+		dir:              dir,
+		indexMapping:     indexMapping,
+		indexStorageType: indexStorageType,
+		indexes:          make(map[string]*Index, 0),
+		aliases:          make(map[string][]string, 0),
+		metadata:         make(map[string]*blastraft.Node, 0),
+
+		percolationSubscribers: make(map[*percolationSubscriber]struct{}),
+		percolations:           make(chan *percolationRequest, percolationBufferSize),
+		changeSubscribers:      make(map[*changeSubscriber]struct{}),
+		rejectedChanges:        make(map[uint64]struct{}),
+		trailingLogs:           raft.DefaultConfig().TrailingLogs,
+
+		stopCh: make(chan struct{}),
+
+		logger: logger,
+	}
+
+	// the state is rebuilt from the latest snapshot and the raft log, which is replayed from its beginning
+	// when there is no snapshot, so the indexes are not reopened from the disk, where they hold later writes
+	err := f.removeIndexDirs()
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.createDefaultIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	go f.runPercolations()
+
+	return f, nil
+}
+
+func (f *RaftFSM) Close() error {
+	close(f.stopCh)
+
+	f.indexesMutex.Lock()
+	defer f.indexesMutex.Unlock()
+
+	for name, index := range f.indexes {
+		err := index.Close()
+		if err != nil {
+			return err
+		}
+		delete(f.indexes, name)
+	}
+
+	return nil
+}
+
+func (f *RaftFSM) indexDir(name string) string {
+	if name == DefaultIndexName {
+		return filepath.Join(f.dir, "index")
+	}
+
+	return filepath.Join(f.dir, "indexes", name)
+}
+
+// removeIndexDirs removes the directories of all the indexes, including the ones left by an interrupted reindex.
+func (f *RaftFSM) removeIndexDirs() error {
+	defaultDir := f.indexDir(DefaultIndexName)
+	for _, dir := range []string{defaultDir, defaultDir + reindexDirSuffix, defaultDir + oldIndexDirSuffix, filepath.Join(f.dir, "indexes")} {
+		err := os.RemoveAll(dir)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveIndexes returns the index with the given name, or the indexes the alias with the given name points to.
+func (f *RaftFSM) resolveIndexes(name string) ([]*Index, error) {
+	if name == "" {
+		name = DefaultIndexName
+	}
+
+	f.indexesMutex.RLock()
+	defer f.indexesMutex.RUnlock()
+
+	index, exists := f.indexes[name]
+	if exists {
+		return []*Index{index}, nil
+	}
+
+	indexNames, exists := f.aliases[name]
+	if !exists {
+		return nil, blasterrors.ErrNotFound
+	}
+
+	indexes := make([]*Index, 0, len(indexNames))
+	for _, indexName := range indexNames {
+		index, exists := f.indexes[indexName]
+		if !exists {
+			return nil, blasterrors.ErrNotFound
+		}
+		indexes = append(indexes, index)
+	}
+
+	return indexes, nil
+}
+
+// getIndex returns the index with the given name, or the only index the alias with the given name points to.
+func (f *RaftFSM) getIndex(name string) (*Index, error) {
+	indexes, err := f.resolveIndexes(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) != 1 {
+		return nil, fmt.Errorf("alias %s points to multiple indexes", name)
+	}
+
+	return indexes[0], nil
+}
+
+func (f *RaftFSM) GetIndex(name string) (*pbindex.IndexInfo, error) {
+	if name == "" {
+		name = DefaultIndexName
+	}
+
+	// aliases are not resolved
+	f.indexesMutex.RLock()
+	index, exists := f.indexes[name]
+	f.indexesMutex.RUnlock()
+	if !exists {
+		return nil, blasterrors.ErrNotFound
+	}
+
+	// mapping.IndexMappingImpl -> Any
+	indexMappingAny := &any.Any{}
+	err := protobuf.UnmarshalAny(index.Mapping(), indexMappingAny)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbindex.IndexInfo{
+		Name:             name,
+		IndexMapping:     indexMappingAny,
+		IndexStorageType: index.StorageType(),
+		DefaultTtl:       index.DefaultTTL(),
+	}, nil
+}
+
+// IndexNames returns the sorted names of the indexes.
+func (f *RaftFSM) IndexNames() []string {
+	f.indexesMutex.RLock()
+	names := make([]string, 0, len(f.indexes))
+	for name := range f.indexes {
+		names = append(names, name)
+	}
+	f.indexesMutex.RUnlock()
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (f *RaftFSM) ListIndexes() ([]*pbindex.IndexInfo, error) {
+	names := f.IndexNames()
+
+	indexInfos := make([]*pbindex.IndexInfo, 0, len(names))
+	for _, name := range names {
+		indexInfo, err := f.GetIndex(name)
+		if err == blasterrors.ErrNotFound {
+			// deleted in the meantime
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		indexInfos = append(indexInfos, indexInfo)
+	}
+
+	return indexInfos, nil
+}
+
+func (f *RaftFSM) applyCreateIndex(name string, indexMapping *mapping.IndexMappingImpl, indexStorageType string, defaultTTL int64) interface{} {
+	f.indexesMutex.Lock()
+	defer f.indexesMutex.Unlock()
+
+	_, exists := f.indexes[name]
+	if exists {
+		return blasterrors.ErrAlreadyExists
+	}
+
+	_, exists = f.aliases[name]
+	if exists {
+		return fmt.Errorf("alias %s already exists", name)
+	}
+
+	if indexMapping == nil {
+		indexMapping = f.indexMapping
+	}
+	if indexStorageType == "" {
+		indexStorageType = f.indexStorageType
+	}
+
+	index, err := NewIndex(f.indexDir(name), indexMapping, indexStorageType, f.logger)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+	index.SetDefaultTTL(defaultTTL)
+	f.indexes[name] = index
+
+	f.logger.Printf("[INFO] index %s created", name)
+
+	return nil
+}
+
+func (f *RaftFSM) applyDeleteIndex(name string) interface{} {
+	if name == DefaultIndexName {
+		return errors.New("the default index cannot be deleted")
+	}
+
+	f.indexesMutex.Lock()
+	defer f.indexesMutex.Unlock()
+
+	index, exists := f.indexes[name]
+	if !exists {
+		return nil
+	}
+	delete(f.indexes, name)
+
+	// remove the index from the aliases
+	for alias, indexNames := range f.aliases {
+		newIndexNames := make([]string, 0, len(indexNames))
+		for _, indexName := range indexNames {
+			if indexName != name {
+				newIndexNames = append(newIndexNames, indexName)
+			}
+		}
+		if len(newIndexNames) > 0 {
+			f.aliases[alias] = newIndexNames
+		} else {
+			delete(f.aliases, alias)
+		}
+	}
+
+	err := index.Close()
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	err = os.RemoveAll(index.dir)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	f.logger.Printf("[INFO] index %s deleted", name)
+
+	return nil
+}
+
+func (f *RaftFSM) Get(name string, id string) (map[string]interface{}, error) {
+	indexes, err := f.resolveIndexes(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		fields, err := index.Get(id)
+		if err == blasterrors.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	}
+
+	return nil, blasterrors.ErrNotFound
+}
+
+// MultiGet returns the documents with the given ids, and the ids of the documents that were not found.
+func (f *RaftFSM) MultiGet(name string, ids []string) ([]map[string]interface{}, []string, error) {
+	indexes, err := f.resolveIndexes(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	docs := make([]map[string]interface{}, len(ids))
+	missingIds := make([]string, 0)
+	for i, id := range ids {
+		for _, index := range indexes {
+			fields, err := index.Get(id)
+			if err == blasterrors.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+
+			docs[i] = fields
+			break
+		}
+		if docs[i] == nil {
+			missingIds = append(missingIds, id)
+		}
+	}
+
+	return docs, missingIds, nil
+}
+
+func (f *RaftFSM) Explain(name string, id string, q query.Query) (*search.DocumentMatch, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := index.Explain(id, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return match, nil
+}
+
+func (f *RaftFSM) Analyze(name string, text string, analyzerName string, field string) (string, analysis.TokenStream, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return index.Analyze(text, analyzerName, field)
+}
+
+// MoreLikeThis returns a query for the documents similar to the document with the id,
+// made from the terms of the index, of the indexes the alias points to, that has the document.
+func (f *RaftFSM) MoreLikeThis(name string, id string, options *MoreLikeThisOptions) (query.Query, error) {
+	indexes, err := f.resolveIndexes(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		q, err := index.MoreLikeThis(id, options)
+		if err == blasterrors.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return q, nil
+	}
+
+	return nil, blasterrors.ErrNotFound
+}
+
+func (f *RaftFSM) FieldStats(name string) ([]*FieldStats, uint64, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return index.FieldStats()
+}
+
+func (f *RaftFSM) Suggest(name string, field string, prefix string, size int, fuzziness int) ([]*Suggestion, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Suggest(field, prefix, size, fuzziness)
+}
+
+// Count returns the number of matching documents and of all documents, summed over the indexes an alias points to.
+func (f *RaftFSM) Count(name string, q query.Query) (uint64, uint64, error) {
+	indexes, err := f.resolveIndexes(name)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	count, docCount := uint64(0), uint64(0)
+	for _, index := range indexes {
+		c, dc, err := index.Count(q)
+		if err != nil {
+			return 0, 0, err
+		}
+		count += c
+		docCount += dc
+	}
+
+	return count, docCount, nil
+}
+
+// Export passes the original documents of the index, or of all the indexes the alias points to, to fn.
+func (f *RaftFSM) Export(name string, q query.Query, fn func(id string, fields map[string]interface{}) error) error {
+	indexes, err := f.resolveIndexes(name)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range indexes {
+		err := index.Export(q, fn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyIndex indexes the document, and with percolate set, queues it to publish the percolator queries matching it.
+// Whether it may be written depending on its existence is decided by opType here rather than by the leader,
+// so that all the replicas decide alike.
+func (f *RaftFSM) applyIndex(name string, id string, fields map[string]interface{}, expireAt int64, percolate bool, opType pbindex.Document_OpType) interface{} {
+	f.logger.Printf("[DEBUG] index %s, %v", id, fields)
+
+	index, err := f.getIndex(name)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	if opType != pbindex.Document_UPSERT {
+		exists, err := index.Exists(id)
+		if err != nil {
+			f.logger.Printf("[ERR] %v", err)
+			return err
+		}
+		if opType == pbindex.Document_CREATE && exists {
+			return errDocumentExists
+		}
+		if opType == pbindex.Document_UPDATE && !exists {
+			return errDocumentMissing
+		}
+	}
+
+	err = index.Index(id, fields, expireAt)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	if percolate {
+		f.queuePercolation(name, index, id, fields)
+	}
+
+	return nil
+}
+
+// applyUpdate merges the fields into the stored original document and indexes the result.
+// The document keeps the time it expires at unless expireAt is set.
+func (f *RaftFSM) applyUpdate(name string, id string, fields map[string]interface{}, expireAt int64, percolate bool) interface{} {
+	f.logger.Printf("[DEBUG] update %s, %v", id, fields)
+
+	index, err := f.getIndex(name)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	fieldsMap, err := index.Get(id)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	if expireAt <= 0 {
+		expireAt, err = index.ExpireAt(id)
+		if err != nil {
+			f.logger.Printf("[ERR] %v", err)
+			return err
+		}
+	}
+
+	merged := mergeFields(fieldsMap, fields)
+	err = index.Index(id, merged, expireAt)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	if percolate {
+		f.queuePercolation(name, index, id, merged)
+	}
+
+	return nil
+}
+
+// applyDelete deletes the document. With expiredAt set, the document is deleted only if it expired at or before it,
+// so that a document written again after it was found expired is kept.
+func (f *RaftFSM) applyDelete(name string, id string, expiredAt int64) interface{} {
+	index, err := f.getIndex(name)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	if expiredAt > 0 {
+		expireAt, err := index.ExpireAt(id)
+		if err != nil {
+			f.logger.Printf("[ERR] %v", err)
+			return err
+		}
+		if expireAt <= 0 || expireAt > expiredAt {
+			return errNotExpired
+		}
+	}
+
+	err = index.Delete(id)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// Expired returns the ids of up to size documents of the index that expired at or before now.
+func (f *RaftFSM) Expired(name string, now int64, size int) ([]string, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Expired(now, size)
+}
+
+// DefaultTTL returns the seconds the documents of the index expire in unless they are written with a ttl or expire_at.
+func (f *RaftFSM) DefaultTTL(name string) (int64, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return 0, err
+	}
+
+	return index.DefaultTTL(), nil
+}
+
+func (f *RaftFSM) GetIndexMapping(name string) (*mapping.IndexMappingImpl, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Mapping(), nil
+}
+
+func (f *RaftFSM) applyPutIndexMapping(name string, indexMapping *mapping.IndexMappingImpl) interface{} {
+	index, err := f.getIndex(name)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	err = index.Reindex(indexMapping)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (f *RaftFSM) GetReindexStatus(name string) (*pbindex.ReindexStatus, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.ReindexStatus(), nil
+}
+
+func (f *RaftFSM) Search(name string, request *bleve.SearchRequest) (*bleve.SearchResult, error) {
+	indexes, err := f.resolveIndexes(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(indexes) == 1 {
+		result, err := indexes[0].Search(request)
+		if err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}
+
+	result, err := searchIndexes(indexes, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (f *RaftFSM) SearchAfter(name string, request *bleve.SearchRequest, after []string) (*bleve.SearchResult, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := index.SearchAfter(request, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (f *RaftFSM) SearchEach(name string, request *bleve.SearchRequest, pageSize int, fn func(search.DocumentMatchCollection) error) error {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return err
+	}
+
+	return index.SearchEach(request, pageSize, fn)
+}
+
+// OpenScroll starts a scroll on an index and returns its first page.
+// The scroll ID starts with the name of the index so that later pages can find it.
+func (f *RaftFSM) OpenScroll(name string, request *bleve.SearchRequest, ttl time.Duration, source *SourceFilter) (*bleve.SearchResult, string, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	indexName := ""
+	f.indexesMutex.RLock()
+	for n, i := range f.indexes {
+		if i == index {
+			indexName = n
+			break
+		}
+	}
+	f.indexesMutex.RUnlock()
+
+	result, id, err := index.OpenScroll(request, ttl, source)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return result, indexName + scrollIDSeparator + id, nil
+}
+
+func (f *RaftFSM) Scroll(scrollID string, ttl time.Duration) (*bleve.SearchResult, *SourceFilter, error) {
+	index, id, err := f.getScrollIndex(scrollID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return index.Scroll(id, ttl)
+}
+
+func (f *RaftFSM) ClearScroll(scrollID string) error {
+	index, id, err := f.getScrollIndex(scrollID)
+	if err != nil {
+		return err
+	}
+
+	return index.ClearScroll(id)
+}
+
+func (f *RaftFSM) getScrollIndex(scrollID string) (*Index, string, error) {
+	pos := strings.LastIndex(scrollID, scrollIDSeparator)
+	if pos < 0 {
+		return nil, "", blasterrors.ErrNotFound
+	}
+
+	f.indexesMutex.RLock()
+	defer f.indexesMutex.RUnlock()
+
+	index, exists := f.indexes[scrollID[:pos]]
+	if !exists {
+		return nil, "", blasterrors.ErrNotFound
+	}
+
+	return index, scrollID[pos+1:], nil
+}
+
+// GetSources returns the original documents of the search hits in the same order.
+// Hits are matched to indexes by the index they were found in. Hits whose documents are no longer stored get nil.
+func (f *RaftFSM) GetSources(hits search.DocumentMatchCollection) ([]map[string]interface{}, error) {
+	f.indexesMutex.RLock()
+	indexesByName := make(map[string]*Index, len(f.indexes))
+	for _, index := range f.indexes {
+		indexesByName[index.Name()] = index
+	}
+	f.indexesMutex.RUnlock()
+
+	sources := make([]map[string]interface{}, len(hits))
+	for i, hit := range hits {
+		index, exists := indexesByName[hit.Index]
+		if !exists {
+			continue
+		}
+
+		source, err := index.Get(hit.ID)
+		if err == blasterrors.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		sources[i] = source
+	}
+
+	return sources, nil
+}
+
+func (f *RaftFSM) GetAlias(name string) (*pbindex.Alias, error) {
+	f.indexesMutex.RLock()
+	defer f.indexesMutex.RUnlock()
+
+	indexNames, exists := f.aliases[name]
+	if !exists {
+		return nil, blasterrors.ErrNotFound
+	}
+
+	return &pbindex.Alias{
+		Name:    name,
+		Indexes: append([]string{}, indexNames...),
+	}, nil
+}
+
+func (f *RaftFSM) ListAliases() ([]*pbindex.Alias, error) {
+	f.indexesMutex.RLock()
+	defer f.indexesMutex.RUnlock()
+
+	names := make([]string, 0, len(f.aliases))
+	for name := range f.aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	aliases := make([]*pbindex.Alias, 0, len(names))
+	for _, name := range names {
+		aliases = append(aliases, &pbindex.Alias{
+			Name:    name,
+			Indexes: append([]string{}, f.aliases[name]...),
+		})
+	}
+
+	return aliases, nil
+}
+
+// checkAliasIndexes checks that an alias can point to the given indexes.
+// The caller must hold the lock.
+func (f *RaftFSM) checkAliasIndexes(name string, indexNames []string) error {
+	_, exists := f.indexes[name]
+	if exists {
+		return fmt.Errorf("index %s already exists", name)
+	}
+
+	if len(indexNames) <= 0 {
+		return errors.New("indexes are not specified")
+	}
+
+	for _, indexName := range indexNames {
+		_, exists := f.indexes[indexName]
+		if !exists {
+			return fmt.Errorf("index %s does not exist", indexName)
+		}
+	}
+
+	return nil
+}
+
+func (f *RaftFSM) applyPutAlias(name string, indexNames []string) interface{} {
+	f.indexesMutex.Lock()
+	defer f.indexesMutex.Unlock()
+
+	err := f.checkAliasIndexes(name, indexNames)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	f.aliases[name] = append([]string{}, indexNames...)
+
+	f.logger.Printf("[INFO] alias %s points to %v", name, indexNames)
+
+	return nil
+}
+
+func (f *RaftFSM) applyDeleteAlias(name string) interface{} {
+	f.indexesMutex.Lock()
+	defer f.indexesMutex.Unlock()
+
+	_, exists := f.aliases[name]
+	if exists {
+		delete(f.aliases, name)
+	}
+
+	return nil
+}
+
+// applySwapAlias points the alias to the new indexes only if it points to the expected indexes,
+// so that concurrent swaps do not overwrite each other.
+func (f *RaftFSM) applySwapAlias(name string, fromIndexNames []string, toIndexNames []string) interface{} {
+	f.indexesMutex.Lock()
+	defer f.indexesMutex.Unlock()
+
+	indexNames, exists := f.aliases[name]
+	if !exists {
+		return blasterrors.ErrNotFound
+	}
+
+	if len(fromIndexNames) > 0 && !sameIndexNames(indexNames, fromIndexNames) {
+		err := fmt.Errorf("alias %s points to %v, not %v", name, indexNames, fromIndexNames)
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
+	err := f.checkAliasIndexes(name, toIndexNames)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
 
-	metadata map[string]*blastraft.Node
+	f.aliases[name] = append([]string{}, toIndexNames...)
 
-	logger *log.Logger
+	f.logger.Printf("[INFO] alias %s swapped from %v to %v", name, indexNames, toIndexNames)
+
+	return nil
 }
 
-func NewRaftFSM(path string, indexMapping *mapping.IndexMappingImpl, indexStorageType string, logger *log.Logger) (*RaftFSM, error) {
-	index, err := NewIndex(path, indexMapping, indexStorageType, logger)
-	if err != nil {
-		return nil, err
+func sameIndexNames(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
 	}
 
-	return &RaftFSM{
-		metadata: make(map[string]*blastraft.Node, 0),
-		index:    index,
-		logger:   logger,
-	}, nil
+	sortedA := append([]string{}, a...)
+	sort.Strings(sortedA)
+	sortedB := append([]string{}, b...)
+	sort.Strings(sortedB)
+
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+
+	return true
 }
 
-func (f *RaftFSM) Close() error {
-	err := f.index.Close()
-	if err != nil {
-		return err
+type percolationSubscriber struct {
+	// the index name to receive the percolations of, all the indexes if empty
+	index string
+	ch    chan *pbindex.Percolation
+}
+
+// SubscribePercolations returns a channel receiving the percolations of the writes to the index
+// that asked for them, and a function to cancel the subscription. Percolations are dropped
+// for a subscriber that does not keep up.
+func (f *RaftFSM) SubscribePercolations(name string) (<-chan *pbindex.Percolation, func()) {
+	subscriber := &percolationSubscriber{
+		index: name,
+		ch:    make(chan *pbindex.Percolation, percolationBufferSize),
 	}
 
-	return nil
+	f.percolationSubscribersMutex.Lock()
+	f.percolationSubscribers[subscriber] = struct{}{}
+	f.percolationSubscribersMutex.Unlock()
+
+	cancel := func() {
+		f.percolationSubscribersMutex.Lock()
+		defer f.percolationSubscribersMutex.Unlock()
+
+		delete(f.percolationSubscribers, subscriber)
+	}
+
+	return subscriber.ch, cancel
 }
 
-func (f *RaftFSM) Get(id string) (map[string]interface{}, error) {
-	fields, err := f.index.Get(id)
-	if err != nil {
-		return nil, err
+func (f *RaftFSM) publishPercolation(percolation *pbindex.Percolation) {
+	f.percolationSubscribersMutex.Lock()
+	defer f.percolationSubscribersMutex.Unlock()
+
+	for subscriber := range f.percolationSubscribers {
+		if subscriber.index != "" && subscriber.index != percolation.Index {
+			continue
+		}
+
+		select {
+		case subscriber.ch <- percolation:
+		default:
+			f.logger.Printf("[WARN] percolation of %s dropped for a slow subscriber", percolation.Id)
+		}
+	}
+}
+
+// percolationRequest is a written document to match against the percolator queries of its index.
+type percolationRequest struct {
+	name   string
+	index  *Index
+	id     string
+	fields map[string]interface{}
+}
+
+// queuePercolation queues the written document to be percolated by runPercolations, so that running the
+// percolator queries does not hold up applying the raft log. The document is not percolated if no one
+// subscribes to the percolations, and is dropped if the queue is full.
+func (f *RaftFSM) queuePercolation(name string, index *Index, id string, fields map[string]interface{}) {
+	f.percolationSubscribersMutex.Lock()
+	subscribed := len(f.percolationSubscribers) > 0
+	f.percolationSubscribersMutex.Unlock()
+	if !subscribed {
+		return
 	}
 
-	return fields, nil
+	select {
+	case f.percolations <- &percolationRequest{name: name, index: index, id: id, fields: fields}:
+	default:
+		f.logger.Printf("[WARN] percolation of %s dropped as the queue is full", id)
+	}
 }
 
-func (f *RaftFSM) applyIndex(id string, fields map[string]interface{}) interface{} {
-	f.logger.Printf("[DEBUG] index %s, %v", id, fields)
+// runPercolations percolates the queued documents until the FSM is closed.
+func (f *RaftFSM) runPercolations() {
+	for {
+		select {
+		case <-f.stopCh:
+			return
+		case req := <-f.percolations:
+			f.percolate(req.name, req.index, req.id, req.fields)
+		}
+	}
+}
+
+// percolate publishes the ids of the percolator queries of the index matching the written document.
+func (f *RaftFSM) percolate(name string, index *Index, id string, fields map[string]interface{}) {
+	queryIds, err := index.Percolate(fields)
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return
+	}
 
-	err := f.index.Index(id, fields)
+	if name == "" {
+		name = DefaultIndexName
+	}
+
+	f.publishPercolation(&pbindex.Percolation{
+		Index:    name,
+		Id:       id,
+		QueryIds: queryIds,
+	})
+}
+
+func (f *RaftFSM) applyPutPercolatorQuery(name string, id string, q query.Query) interface{} {
+	index, err := f.getIndex(name)
 	if err != nil {
 		f.logger.Printf("[ERR] %v", err)
 		return err
 	}
 
+	index.PutPercolatorQuery(id, q)
+
 	return nil
 }
 
-func (f *RaftFSM) applyDelete(id string) interface{} {
-	err := f.index.Delete(id)
+func (f *RaftFSM) applyDeletePercolatorQuery(name string, id string) interface{} {
+	index, err := f.getIndex(name)
 	if err != nil {
 		f.logger.Printf("[ERR] %v", err)
 		return err
 	}
 
-	return nil
+	return index.DeletePercolatorQuery(id)
 }
 
-func (f *RaftFSM) Search(request *bleve.SearchRequest) (*bleve.SearchResult, error) {
-	result, err := f.index.Search(request)
+func (f *RaftFSM) ListPercolatorQueries(name string) (map[string]query.Query, error) {
+	index, err := f.getIndex(name)
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	return index.PercolatorQueries(), nil
+}
+
+func (f *RaftFSM) Percolate(name string, fields map[string]interface{}) ([]string, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Percolate(fields)
 }
 
 func (f *RaftFSM) GetMetadata(nodeId string) (*blastraft.Node, error) {
@@ -137,72 +1121,214 @@ func (f *RaftFSM) Apply(l *raft.Log) interface{} {
 
 	f.logger.Printf("[DEBUG] Apply %v", c)
 
+	ret := f.applyCommand(&c)
+
+	_, rejected := ret.(error)
+	f.setApplied(l.Index, &c, rejected)
+	if !rejected {
+		f.publishChange(l.Index, &c)
+	}
+
+	return ret
+}
+
+func (f *RaftFSM) applyCommand(c *pbindex.IndexCommand) interface{} {
 	switch c.Type {
 	case pbindex.IndexCommand_SET_METADATA:
 		// Any -> Node
-		nodeInstance, err := protobuf.MarshalAny(c.Data)
+		metadata := &blastraft.Node{}
+		err := protobuf.MessageFromAny(c.Data, metadata)
 		if err != nil {
 			return err
 		}
-		if nodeInstance == nil {
-			return errors.New("nil")
-		}
-		metadata := nodeInstance.(*blastraft.Node)
 
 		return f.applySetMetadata(metadata.Id, metadata)
 	case pbindex.IndexCommand_DELETE_METADATA:
 		// Any -> Node
-		metadataInstance, err := protobuf.MarshalAny(c.Data)
+		metadata := &blastraft.Node{}
+		err := protobuf.MessageFromAny(c.Data, metadata)
 		if err != nil {
 			return err
 		}
-		if metadataInstance == nil {
-			return errors.New("nil")
-		}
-		metadata := *metadataInstance.(*blastraft.Node)
 
 		return f.applyDeleteMetadata(metadata.Id)
 	case pbindex.IndexCommand_INDEX_DOCUMENT:
 		// Any -> Document
-		docInstance, err := protobuf.MarshalAny(c.Data)
+		doc, err := protobuf.DocumentFromAny(c.Data)
 		if err != nil {
 			return err
 		}
-		if docInstance == nil {
+		if doc == nil {
 			return errors.New("nil")
 		}
-		doc := *docInstance.(*pbindex.Document)
 
-		// Any -> map[string]interface{}
-		fieldsInstance, err := protobuf.MarshalAny(doc.Fields)
+		// Struct -> map[string]interface{}
+		fields, err := protobuf.FromStruct(doc.Fields)
 		if err != nil {
 			return err
 		}
-		if fieldsInstance == nil {
+		if fields == nil {
 			return errors.New("nil")
 		}
-		fields := *fieldsInstance.(*map[string]interface{})
 
-		return f.applyIndex(doc.Id, fields)
+		return f.applyIndex(doc.Index, doc.Id, fields, doc.ExpireAt, doc.Percolate, doc.OpType)
 	case pbindex.IndexCommand_DELETE_DOCUMENT:
 		// Any -> Document
-		docInstance, err := protobuf.MarshalAny(c.Data)
+		doc, err := protobuf.DocumentFromAny(c.Data)
+		if err != nil {
+			return err
+		}
+		if doc == nil {
+			return errors.New("nil")
+		}
+
+		return f.applyDelete(doc.Index, doc.Id, doc.ExpireAt)
+	case pbindex.IndexCommand_UPDATE_DOCUMENT:
+		// Any -> Document
+		doc, err := protobuf.DocumentFromAny(c.Data)
+		if err != nil {
+			return err
+		}
+		if doc == nil {
+			return errors.New("nil")
+		}
+
+		// Struct -> map[string]interface{}
+		fields, err := protobuf.FromStruct(doc.Fields)
+		if err != nil {
+			return err
+		}
+		if fields == nil {
+			return errors.New("nil")
+		}
+
+		return f.applyUpdate(doc.Index, doc.Id, fields, doc.ExpireAt, doc.Percolate)
+	case pbindex.IndexCommand_PUT_INDEX_MAPPING:
+		// Any -> IndexInfo
+		indexInfo := &pbindex.IndexInfo{}
+		err := protobuf.MessageFromAny(c.Data, indexInfo)
+		if err != nil {
+			return err
+		}
+
+		// Any -> mapping.IndexMappingImpl
+		indexMappingInstance, err := protobuf.MarshalAny(indexInfo.IndexMapping)
 		if err != nil {
 			return err
 		}
-		if docInstance == nil {
+		if indexMappingInstance == nil {
 			return errors.New("nil")
 		}
-		doc := *docInstance.(*pbindex.Document)
+		indexMapping, ok := indexMappingInstance.(*mapping.IndexMappingImpl)
+		if !ok {
+			return errors.New("unsupported index mapping type")
+		}
+
+		return f.applyPutIndexMapping(indexInfo.Name, indexMapping)
+	case pbindex.IndexCommand_CREATE_INDEX:
+		// Any -> IndexInfo
+		indexInfo := &pbindex.IndexInfo{}
+		err := protobuf.MessageFromAny(c.Data, indexInfo)
+		if err != nil {
+			return err
+		}
+
+		// Any -> mapping.IndexMappingImpl
+		var indexMapping *mapping.IndexMappingImpl
+		indexMappingInstance, err := protobuf.MarshalAny(indexInfo.IndexMapping)
+		if err != nil {
+			return err
+		}
+		if indexMappingInstance != nil {
+			v, ok := indexMappingInstance.(*mapping.IndexMappingImpl)
+			if !ok {
+				return errors.New("unsupported index mapping type")
+			}
+			indexMapping = v
+		}
+
+		return f.applyCreateIndex(indexInfo.Name, indexMapping, indexInfo.IndexStorageType, indexInfo.DefaultTtl)
+	case pbindex.IndexCommand_DELETE_INDEX:
+		// Any -> IndexInfo
+		indexInfo := &pbindex.IndexInfo{}
+		err := protobuf.MessageFromAny(c.Data, indexInfo)
+		if err != nil {
+			return err
+		}
+
+		return f.applyDeleteIndex(indexInfo.Name)
+	case pbindex.IndexCommand_PUT_ALIAS:
+		// Any -> Alias
+		alias := &pbindex.Alias{}
+		err := protobuf.MessageFromAny(c.Data, alias)
+		if err != nil {
+			return err
+		}
+
+		return f.applyPutAlias(alias.Name, alias.Indexes)
+	case pbindex.IndexCommand_DELETE_ALIAS:
+		// Any -> Alias
+		alias := &pbindex.Alias{}
+		err := protobuf.MessageFromAny(c.Data, alias)
+		if err != nil {
+			return err
+		}
+
+		return f.applyDeleteAlias(alias.Name)
+	case pbindex.IndexCommand_SWAP_ALIAS:
+		// Any -> SwapAliasRequest
+		swapAlias := &pbindex.SwapAliasRequest{}
+		err := protobuf.MessageFromAny(c.Data, swapAlias)
+		if err != nil {
+			return err
+		}
+
+		return f.applySwapAlias(swapAlias.Name, swapAlias.FromIndexes, swapAlias.ToIndexes)
+	case pbindex.IndexCommand_PUT_PERCOLATOR_QUERY:
+		// Any -> PercolatorQuery
+		percolatorQuery := &pbindex.PercolatorQuery{}
+		err := protobuf.MessageFromAny(c.Data, percolatorQuery)
+		if err != nil {
+			return err
+		}
 
-		return f.applyDelete(doc.Id)
+		// index.Query -> query.Query
+		q, err := protobuf.ToBleveQuery(percolatorQuery.Query)
+		if err != nil {
+			return err
+		}
+
+		return f.applyPutPercolatorQuery(percolatorQuery.Index, percolatorQuery.Id, q)
+	case pbindex.IndexCommand_DELETE_PERCOLATOR_QUERY:
+		// Any -> PercolatorQuery
+		percolatorQuery := &pbindex.PercolatorQuery{}
+		err := protobuf.MessageFromAny(c.Data, percolatorQuery)
+		if err != nil {
+			return err
+		}
+
+		return f.applyDeletePercolatorQuery(percolatorQuery.Index, percolatorQuery.Id)
+	case pbindex.IndexCommand_SET_REJECTED_CHANGES:
+		// Any -> RejectedChanges
+		rejectedChanges := &pbindex.RejectedChanges{}
+		err := protobuf.MessageFromAny(c.Data, rejectedChanges)
+		if err != nil {
+			return err
+		}
+
+		return f.applySetRejectedChanges(rejectedChanges)
 	default:
 		return errors.New("command type not support")
 	}
 }
 
-func (f *RaftFSM) Stats() (map[string]interface{}, error) {
-	stats, err := f.index.Stats()
+func (f *RaftFSM) Stats(name string) (map[string]interface{}, error) {
+	index, err := f.getIndex(name)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := index.Stats()
 	if err != nil {
 		return nil, err
 	}
@@ -211,12 +1337,77 @@ func (f *RaftFSM) Stats() (map[string]interface{}, error) {
 }
 
 func (f *RaftFSM) Snapshot() (raft.FSMSnapshot, error) {
+	f.indexesMutex.RLock()
+	defer f.indexesMutex.RUnlock()
+
+	indexes := make(map[string]*Index, len(f.indexes))
+	for name, index := range f.indexes {
+		indexes[name] = index
+	}
+
+	aliases := make(map[string][]string, len(f.aliases))
+	for name, indexNames := range f.aliases {
+		aliases[name] = append([]string{}, indexNames...)
+	}
+
+	percolatorQueries := make(map[string]map[string]query.Query, len(f.indexes))
+	for name, index := range f.indexes {
+		percolatorQueries[name] = index.PercolatorQueries()
+	}
+
 	return &IndexFSMSnapshot{
-		index:  f.index,
-		logger: f.logger,
+		indexes:           indexes,
+		aliases:           aliases,
+		percolatorQueries: percolatorQueries,
+		rejectedChanges:   f.snapshotRejectedChanges(),
+		logger:            f.logger,
 	}, nil
 }
 
+// reset closes and removes all the indexes with their percolator queries, and forgets the aliases and
+// the rejected changes, so that a snapshot is restored into an empty state rather than on top of the current one.
+func (f *RaftFSM) reset() error {
+	f.indexesMutex.Lock()
+	defer f.indexesMutex.Unlock()
+
+	for name, index := range f.indexes {
+		err := index.Close()
+		if err != nil {
+			return err
+		}
+		delete(f.indexes, name)
+	}
+	err := f.removeIndexDirs()
+	if err != nil {
+		return err
+	}
+	f.aliases = make(map[string][]string, 0)
+
+	f.rejectedChangesMutex.Lock()
+	f.rejectedChanges = make(map[uint64]struct{})
+	f.appliedIndex = 0
+	f.rejectedChangesMutex.Unlock()
+
+	return nil
+}
+
+// createDefaultIndex creates the default index unless it exists, as when a snapshot being restored has created it.
+func (f *RaftFSM) createDefaultIndex() error {
+	f.indexesMutex.RLock()
+	_, exists := f.indexes[DefaultIndexName]
+	f.indexesMutex.RUnlock()
+	if exists {
+		return nil
+	}
+
+	ret := f.applyCreateIndex(DefaultIndexName, nil, "", 0)
+	if err, ok := ret.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
 func (f *RaftFSM) Restore(rc io.ReadCloser) error {
 	defer func() {
 		err := rc.Close()
@@ -231,12 +1422,17 @@ func (f *RaftFSM) Restore(rc io.ReadCloser) error {
 		return err
 	}
 
+	err = f.reset()
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
+	}
+
 	docCount := 0
 
 	buff := proto.NewBuffer(data)
 	for {
-		doc := &pbindex.Document{}
-		err = buff.DecodeMessage(doc)
+		msg, err := buff.DecodeRawBytes(false)
 		if err == io.ErrUnexpectedEOF {
 			break
 		}
@@ -245,24 +1441,62 @@ func (f *RaftFSM) Restore(rc io.ReadCloser) error {
 			return err
 		}
 
-		// Any -> map[string]interface{}
-		fields, err := protobuf.MarshalAny(doc.Fields)
+		c := &pbindex.IndexCommand{}
+		err = proto.Unmarshal(msg, c)
 		if err != nil {
+			f.logger.Printf("[ERR] %v", err)
 			return err
 		}
-		if fields == nil {
-			return nil
+
+		if c.Type == pbindex.IndexCommand_UNKNOWN_COMMAND {
+			// snapshots taken before the named indexes consist of the documents of the default index
+			err = f.createDefaultIndex()
+			if err != nil {
+				f.logger.Printf("[ERR] %v", err)
+				return err
+			}
+
+			legacyDoc := &pbindex.LegacyDocument{}
+			err = proto.Unmarshal(msg, legacyDoc)
+			if err != nil {
+				f.logger.Printf("[ERR] %v", err)
+				return err
+			}
+
+			// LegacyDocument -> Document
+			doc, err := protobuf.DocumentFromLegacy(legacyDoc)
+			if err != nil {
+				f.logger.Printf("[ERR] %v", err)
+				return err
+			}
+
+			// Document -> Any
+			docAny, err := protobuf.DocumentToAny(doc)
+			if err != nil {
+				return err
+			}
+
+			c = &pbindex.IndexCommand{
+				Type: pbindex.IndexCommand_INDEX_DOCUMENT,
+				Data: docAny,
+			}
 		}
-		fieldsMap := *fields.(*map[string]interface{})
 
-		err = f.index.Index(doc.Id, fieldsMap)
-		if err != nil {
+		ret := f.applyCommand(c)
+		if err, ok := ret.(error); ok {
 			f.logger.Printf("[ERR] %v", err)
 			return err
 		}
 
-		f.logger.Printf("[DEBUG] restore %v %v", doc.Id, doc.Fields)
-		docCount = docCount + 1
+		if c.Type == pbindex.IndexCommand_INDEX_DOCUMENT {
+			docCount = docCount + 1
+		}
+	}
+
+	err = f.createDefaultIndex()
+	if err != nil {
+		f.logger.Printf("[ERR] %v", err)
+		return err
 	}
 
 	f.logger.Printf("[INFO] %d documents were restored", docCount)
@@ -273,8 +1507,11 @@ func (f *RaftFSM) Restore(rc io.ReadCloser) error {
 // ---------------------
 
 type IndexFSMSnapshot struct {
-	index  *Index
-	logger *log.Logger
+	indexes           map[string]*Index
+	aliases           map[string][]string
+	percolatorQueries map[string]map[string]query.Query
+	rejectedChanges   *pbindex.RejectedChanges
+	logger            *log.Logger
 }
 
 func (f *IndexFSMSnapshot) Persist(sink raft.SnapshotSink) error {
@@ -285,34 +1522,190 @@ func (f *IndexFSMSnapshot) Persist(sink raft.SnapshotSink) error {
 		}
 	}()
 
-	ch := f.index.SnapshotItems()
+	names := make([]string, 0, len(f.indexes))
+	for name := range f.indexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
 	docCount := 0
 
-	for {
-		doc := <-ch
-		if doc == nil {
-			break
+	for _, name := range names {
+		index := f.indexes[name]
+
+		// mapping.IndexMappingImpl -> Any
+		indexMappingAny := &any.Any{}
+		err := protobuf.UnmarshalAny(index.Mapping(), indexMappingAny)
+		if err != nil {
+			return err
+		}
+
+		indexInfo := &pbindex.IndexInfo{
+			Name:             name,
+			IndexMapping:     indexMappingAny,
+			IndexStorageType: index.StorageType(),
+			DefaultTtl:       index.DefaultTTL(),
+		}
+
+		// IndexInfo -> Any
+		indexInfoAny, err := protobuf.MessageToAny(indexInfo)
+		if err != nil {
+			return err
+		}
+
+		err = f.write(sink, &pbindex.IndexCommand{
+			Type: pbindex.IndexCommand_CREATE_INDEX,
+			Data: indexInfoAny,
+		})
+		if err != nil {
+			return err
+		}
+
+		ch := index.SnapshotItems()
+
+		for {
+			doc := <-ch
+			if doc == nil {
+				break
+			}
+
+			docCount = docCount + 1
+
+			doc.Index = name
+
+			// Document -> Any
+			docAny, err := protobuf.DocumentToAny(doc)
+			if err != nil {
+				return err
+			}
+
+			err = f.write(sink, &pbindex.IndexCommand{
+				Type: pbindex.IndexCommand_INDEX_DOCUMENT,
+				Data: docAny,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		queryIds := make([]string, 0, len(f.percolatorQueries[name]))
+		for id := range f.percolatorQueries[name] {
+			queryIds = append(queryIds, id)
+		}
+		sort.Strings(queryIds)
+
+		for _, id := range queryIds {
+			// query.Query -> index.Query
+			q, err := protobuf.FromBleveQuery(f.percolatorQueries[name][id])
+			if err != nil {
+				return err
+			}
+
+			// PercolatorQuery -> Any
+			percolatorQueryAny, err := protobuf.MessageToAny(&pbindex.PercolatorQuery{
+				Index: name,
+				Id:    id,
+				Query: q,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = f.write(sink, &pbindex.IndexCommand{
+				Type: pbindex.IndexCommand_PUT_PERCOLATOR_QUERY,
+				Data: percolatorQueryAny,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	aliasNames := make([]string, 0, len(f.aliases))
+	for name := range f.aliases {
+		aliasNames = append(aliasNames, name)
+	}
+	sort.Strings(aliasNames)
+
+	for _, name := range aliasNames {
+		alias := &pbindex.Alias{
+			Name:    name,
+			Indexes: f.aliases[name],
+		}
+
+		// Alias -> Any
+		aliasAny, err := protobuf.MessageToAny(alias)
+		if err != nil {
+			return err
 		}
 
-		docCount = docCount + 1
+		err = f.write(sink, &pbindex.IndexCommand{
+			Type: pbindex.IndexCommand_PUT_ALIAS,
+			Data: aliasAny,
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-		buff := proto.NewBuffer([]byte{})
-		err := buff.EncodeMessage(doc)
+	if len(f.rejectedChanges.RaftIndexes) > 0 || f.rejectedChanges.AppliedIndex > 0 {
+		// RejectedChanges -> Any
+		rejectedChangesAny, err := protobuf.MessageToAny(f.rejectedChanges)
 		if err != nil {
 			return err
 		}
 
-		_, err = sink.Write(buff.Bytes())
+		err = f.write(sink, &pbindex.IndexCommand{
+			Type: pbindex.IndexCommand_SET_REJECTED_CHANGES,
+			Data: rejectedChangesAny,
+		})
 		if err != nil {
 			return err
 		}
 	}
+
 	f.logger.Printf("[INFO] %d documents were persisted", docCount)
 
 	return nil
 }
 
+func (f *IndexFSMSnapshot) write(sink raft.SnapshotSink, c *pbindex.IndexCommand) error {
+	buff := proto.NewBuffer([]byte{})
+	err := buff.EncodeMessage(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = sink.Write(buff.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (f *IndexFSMSnapshot) Release() {
 	f.logger.Printf("[INFO] release")
 }
+
+// mergeFields merges the partial fields into the document fields. Objects are merged recursively,
+// any other value replaces the existing one.
+func mergeFields(fields map[string]interface{}, partial map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(fields)+len(partial))
+	for name, value := range fields {
+		merged[name] = value
+	}
+
+	for name, value := range partial {
+		partialObject, ok := value.(map[string]interface{})
+		if ok {
+			if object, ok := merged[name].(map[string]interface{}); ok {
+				merged[name] = mergeFields(object, partialObject)
+				continue
+			}
+		}
+		merged[name] = value
+	}
+
+	return merged
+}
@@ -0,0 +1,480 @@
+package indexer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/hashicorp/raft"
+	blasterrors "github.com/mosuka/blast/errors"
+	"github.com/mosuka/blast/protobuf"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+)
+
+type testSnapshotSink struct {
+	bytes.Buffer
+}
+
+func (s *testSnapshotSink) ID() string {
+	return "test"
+}
+
+func (s *testSnapshotSink) Cancel() error {
+	return nil
+}
+
+func (s *testSnapshotSink) Close() error {
+	return nil
+}
+
+func newTestRaftFSM(t *testing.T) (*RaftFSM, string) {
+	dir, err := ioutil.TempDir("", "blast-indexer-test")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	f, err := NewRaftFSM(dir, bleve.NewIndexMapping(), bleve.Config.DefaultKVStore, newTestLogger())
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		t.Fatalf("%v", err)
+	}
+
+	return f, dir
+}
+
+func newTestMessageAny(t *testing.T, message proto.Message) *any.Any {
+	messageAny, err := protobuf.MessageToAny(message)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return messageAny
+}
+
+func newTestDocumentAny(t *testing.T, doc *pbindex.Document, fields map[string]interface{}) *any.Any {
+	if fields != nil {
+		var err error
+		doc.Fields, err = protobuf.ToStruct(fields)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+
+	docAny, err := protobuf.DocumentToAny(doc)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return docAny
+}
+
+func applyTestCommand(t *testing.T, f *RaftFSM, raftIndex uint64, commandType pbindex.IndexCommand_Type, data *any.Any) interface{} {
+	msg, err := proto.Marshal(&pbindex.IndexCommand{
+		Type: commandType,
+		Data: data,
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return f.Apply(&raft.Log{
+		Index: raftIndex,
+		Type:  raft.LogCommand,
+		Data:  msg,
+	})
+}
+
+func snapshotTestRaftFSM(t *testing.T, f *RaftFSM) []byte {
+	snapshot, err := f.Snapshot()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	sink := &testSnapshotSink{}
+	err = snapshot.Persist(sink)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return sink.Bytes()
+}
+
+func TestRaftFSMRestore(t *testing.T) {
+	f, dir := newTestRaftFSM(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	defer func() {
+		_ = f.Close()
+	}()
+
+	ret := applyTestCommand(t, f, 1, pbindex.IndexCommand_CREATE_INDEX, newTestMessageAny(t, &pbindex.IndexInfo{Name: "books", DefaultTtl: 60}))
+	if ret != nil {
+		t.Fatalf("%v", ret)
+	}
+	applyTestCommand(t, f, 2, pbindex.IndexCommand_INDEX_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Index: "books", Id: "1"}, map[string]interface{}{"title": "a"}))
+	applyTestCommand(t, f, 3, pbindex.IndexCommand_INDEX_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Id: "0"}, map[string]interface{}{"title": "b"}))
+
+	data := snapshotTestRaftFSM(t, f)
+
+	// change the state after the snapshot
+	applyTestCommand(t, f, 4, pbindex.IndexCommand_CREATE_INDEX, newTestMessageAny(t, &pbindex.IndexInfo{Name: "music"}))
+	applyTestCommand(t, f, 5, pbindex.IndexCommand_INDEX_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Index: "books", Id: "2"}, map[string]interface{}{"title": "c"}))
+	applyTestCommand(t, f, 6, pbindex.IndexCommand_DELETE_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Index: "books", Id: "1"}, nil))
+
+	ret = applyTestCommand(t, f, 7, pbindex.IndexCommand_CREATE_INDEX, newTestMessageAny(t, &pbindex.IndexInfo{Name: "books", DefaultTtl: 120}))
+	if ret != blasterrors.ErrAlreadyExists {
+		t.Errorf("expected content to see %v, saw %v", blasterrors.ErrAlreadyExists, ret)
+	}
+
+	err := f.Restore(ioutil.NopCloser(bytes.NewReader(data)))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	expectedNames := []string{"books", DefaultIndexName}
+	if names := f.IndexNames(); !reflect.DeepEqual(expectedNames, names) {
+		t.Errorf("expected content to see %v, saw %v", expectedNames, names)
+	}
+	_, err = os.Stat(filepath.Join(dir, "indexes", "music"))
+	if !os.IsNotExist(err) {
+		t.Errorf("expected content to see the music index removed, saw %v", err)
+	}
+
+	indexInfo, err := f.GetIndex("books")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if indexInfo.DefaultTtl != 60 {
+		t.Errorf("expected content to see %v, saw %v", 60, indexInfo.DefaultTtl)
+	}
+
+	cases := []struct {
+		index    string
+		id       string
+		expected map[string]interface{}
+	}{
+		{"books", "1", map[string]interface{}{"title": "a"}},
+		{"books", "2", nil},
+		{DefaultIndexName, "0", map[string]interface{}{"title": "b"}},
+	}
+
+	for _, c := range cases {
+		fields, err := f.Get(c.index, c.id)
+		if err != nil && err != blasterrors.ErrNotFound {
+			t.Fatalf("%v", err)
+		}
+		if !reflect.DeepEqual(c.expected, fields) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, fields)
+		}
+	}
+}
+
+func TestRaftFSMRestoreAliases(t *testing.T) {
+	f, dir := newTestRaftFSM(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	defer func() {
+		_ = f.Close()
+	}()
+
+	applyTestCommand(t, f, 1, pbindex.IndexCommand_CREATE_INDEX, newTestMessageAny(t, &pbindex.IndexInfo{Name: "books"}))
+	applyTestCommand(t, f, 2, pbindex.IndexCommand_PUT_ALIAS, newTestMessageAny(t, &pbindex.Alias{Name: "library", Indexes: []string{"books"}}))
+
+	data := snapshotTestRaftFSM(t, f)
+
+	// change the aliases after the snapshot
+	applyTestCommand(t, f, 3, pbindex.IndexCommand_PUT_ALIAS, newTestMessageAny(t, &pbindex.Alias{Name: "shelf", Indexes: []string{"books"}}))
+	applyTestCommand(t, f, 4, pbindex.IndexCommand_PUT_ALIAS, newTestMessageAny(t, &pbindex.Alias{Name: "library", Indexes: []string{"books", DefaultIndexName}}))
+
+	err := f.Restore(ioutil.NopCloser(bytes.NewReader(data)))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	cases := []struct {
+		name     string
+		expected []string
+	}{
+		{"library", []string{"books"}},
+		{"shelf", nil},
+	}
+
+	for _, c := range cases {
+		alias, err := f.GetAlias(c.name)
+		if err != nil && err != blasterrors.ErrNotFound {
+			t.Fatalf("%v", err)
+		}
+
+		var actual []string
+		if alias != nil {
+			actual = alias.Indexes
+		}
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, actual)
+		}
+	}
+}
+
+func TestMergeFields(t *testing.T) {
+	cases := []struct {
+		fields   map[string]interface{}
+		partial  map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			map[string]interface{}{"title": "a", "count": 1.0},
+			map[string]interface{}{"title": "b"},
+			map[string]interface{}{"title": "b", "count": 1.0},
+		},
+		{
+			map[string]interface{}{"title": "a"},
+			map[string]interface{}{"count": 2.0},
+			map[string]interface{}{"title": "a", "count": 2.0},
+		},
+		{
+			map[string]interface{}{"author": map[string]interface{}{"name": "a", "email": "a@example.com"}},
+			map[string]interface{}{"author": map[string]interface{}{"name": "b"}},
+			map[string]interface{}{"author": map[string]interface{}{"name": "b", "email": "a@example.com"}},
+		},
+		{
+			map[string]interface{}{"author": "a"},
+			map[string]interface{}{"author": map[string]interface{}{"name": "b"}},
+			map[string]interface{}{"author": map[string]interface{}{"name": "b"}},
+		},
+		{
+			map[string]interface{}{"tags": []interface{}{"a", "b"}},
+			map[string]interface{}{"tags": []interface{}{"c"}},
+			map[string]interface{}{"tags": []interface{}{"c"}},
+		},
+		{
+			nil,
+			map[string]interface{}{"title": "a"},
+			map[string]interface{}{"title": "a"},
+		},
+	}
+
+	for _, c := range cases {
+		actual := mergeFields(c.fields, c.partial)
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, actual)
+		}
+	}
+
+	// the stored fields are left unchanged
+	fields := map[string]interface{}{"author": map[string]interface{}{"name": "a"}}
+	_ = mergeFields(fields, map[string]interface{}{"author": map[string]interface{}{"name": "b"}})
+	if name := fields["author"].(map[string]interface{})["name"]; name != "a" {
+		t.Errorf("expected content to see %v, saw %v", "a", name)
+	}
+}
+
+func TestRaftFSMRestorePercolatorQueries(t *testing.T) {
+	f, dir := newTestRaftFSM(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	defer func() {
+		_ = f.Close()
+	}()
+
+	newPercolatorQueryAny := func(id string, term string) *any.Any {
+		q := bleve.NewTermQuery(term)
+		q.SetField("title")
+		pbQuery, err := protobuf.FromBleveQuery(q)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		return newTestMessageAny(t, &pbindex.PercolatorQuery{Index: "books", Id: id, Query: pbQuery})
+	}
+
+	applyTestCommand(t, f, 1, pbindex.IndexCommand_CREATE_INDEX, newTestMessageAny(t, &pbindex.IndexInfo{Name: "books"}))
+	ret := applyTestCommand(t, f, 2, pbindex.IndexCommand_PUT_PERCOLATOR_QUERY, newPercolatorQueryAny("q1", "blast"))
+	if ret != nil {
+		t.Fatalf("%v", ret)
+	}
+
+	data := snapshotTestRaftFSM(t, f)
+
+	// change the percolator queries after the snapshot
+	applyTestCommand(t, f, 3, pbindex.IndexCommand_PUT_PERCOLATOR_QUERY, newPercolatorQueryAny("q2", "bleve"))
+	applyTestCommand(t, f, 4, pbindex.IndexCommand_DELETE_PERCOLATOR_QUERY, newTestMessageAny(t, &pbindex.PercolatorQuery{Index: "books", Id: "q1"}))
+
+	err := f.Restore(ioutil.NopCloser(bytes.NewReader(data)))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	queries, err := f.ListPercolatorQueries("books")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(queries) != 1 {
+		t.Fatalf("expected content to see %v, saw %v", 1, len(queries))
+	}
+
+	termQuery, ok := queries["q1"].(*query.TermQuery)
+	if !ok {
+		t.Fatalf("expected content to see %T, saw %T", &query.TermQuery{}, queries["q1"])
+	}
+	if termQuery.Term != "blast" || termQuery.FieldVal != "title" {
+		t.Errorf("expected content to see %v:%v, saw %v:%v", "title", "blast", termQuery.FieldVal, termQuery.Term)
+	}
+}
+
+func TestRaftFSMApplyIndexOpType(t *testing.T) {
+	f, dir := newTestRaftFSM(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	defer func() {
+		_ = f.Close()
+	}()
+
+	applyTestCommand(t, f, 1, pbindex.IndexCommand_INDEX_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Id: "1"}, map[string]interface{}{"title": "a"}))
+
+	cases := []struct {
+		opType   pbindex.Document_OpType
+		id       string
+		title    string
+		err      error
+		expected map[string]interface{}
+	}{
+		{pbindex.Document_CREATE, "1", "b", errDocumentExists, map[string]interface{}{"title": "a"}},
+		{pbindex.Document_UPDATE, "2", "b", errDocumentMissing, nil},
+		{pbindex.Document_CREATE, "2", "b", nil, map[string]interface{}{"title": "b"}},
+		{pbindex.Document_UPDATE, "1", "c", nil, map[string]interface{}{"title": "c"}},
+		{pbindex.Document_UPSERT, "3", "d", nil, map[string]interface{}{"title": "d"}},
+		{pbindex.Document_UPSERT, "3", "e", nil, map[string]interface{}{"title": "e"}},
+	}
+
+	for i, c := range cases {
+		docAny := newTestDocumentAny(t, &pbindex.Document{Id: c.id, OpType: c.opType}, map[string]interface{}{"title": c.title})
+		ret := applyTestCommand(t, f, uint64(i+2), pbindex.IndexCommand_INDEX_DOCUMENT, docAny)
+		if ret != c.err {
+			t.Errorf("expected content to see %v, saw %v", c.err, ret)
+		}
+
+		fields, err := f.Get(DefaultIndexName, c.id)
+		if err != nil && err != blasterrors.ErrNotFound {
+			t.Fatalf("%v", err)
+		}
+		if !reflect.DeepEqual(c.expected, fields) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, fields)
+		}
+	}
+}
+
+func TestRaftFSMApplyUnsupportedIndexMapping(t *testing.T) {
+	f, dir := newTestRaftFSM(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	defer func() {
+		_ = f.Close()
+	}()
+
+	indexMappingAny := &any.Any{}
+	err := protobuf.UnmarshalAny(map[string]interface{}{"types": "book"}, indexMappingAny)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	cases := []struct {
+		commandType pbindex.IndexCommand_Type
+	}{
+		{pbindex.IndexCommand_CREATE_INDEX},
+		{pbindex.IndexCommand_PUT_INDEX_MAPPING},
+	}
+
+	for i, c := range cases {
+		data := newTestMessageAny(t, &pbindex.IndexInfo{Name: DefaultIndexName, IndexMapping: indexMappingAny})
+		ret := applyTestCommand(t, f, uint64(i+1), c.commandType, data)
+		if _, ok := ret.(error); !ok {
+			t.Errorf("expected content to see an error for %v, saw %v", c.commandType, ret)
+		}
+	}
+}
+
+func TestRaftFSMReplayAfterRestart(t *testing.T) {
+	f, dir := newTestRaftFSM(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	commands := []struct {
+		commandType pbindex.IndexCommand_Type
+		data        *any.Any
+	}{
+		{pbindex.IndexCommand_CREATE_INDEX, newTestMessageAny(t, &pbindex.IndexInfo{Name: "books", DefaultTtl: 60})},
+		{pbindex.IndexCommand_INDEX_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Index: "books", Id: "1", OpType: pbindex.Document_CREATE}, map[string]interface{}{"title": "a"})},
+		{pbindex.IndexCommand_INDEX_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Id: "2", OpType: pbindex.Document_CREATE}, map[string]interface{}{"title": "b"})},
+		{pbindex.IndexCommand_DELETE_DOCUMENT, newTestDocumentAny(t, &pbindex.Document{Id: "2"}, nil)},
+	}
+
+	for i, c := range commands {
+		ret := applyTestCommand(t, f, uint64(i+1), c.commandType, c.data)
+		if ret != nil {
+			t.Fatalf("%v", ret)
+		}
+	}
+
+	err := f.Close()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// without a snapshot, raft replays the whole log over the indexes left on the disk
+	f, err = NewRaftFSM(dir, bleve.NewIndexMapping(), bleve.Config.DefaultKVStore, newTestLogger())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	for i, c := range commands {
+		ret := applyTestCommand(t, f, uint64(i+1), c.commandType, c.data)
+		if ret != nil {
+			t.Errorf("expected content to see nil, saw %v", ret)
+		}
+		if f.isRejectedChange(uint64(i + 1)) {
+			t.Errorf("expected content to see %d not rejected", i+1)
+		}
+	}
+
+	cases := []struct {
+		index    string
+		id       string
+		expected map[string]interface{}
+	}{
+		{"books", "1", map[string]interface{}{"title": "a"}},
+		{DefaultIndexName, "2", nil},
+	}
+
+	for _, c := range cases {
+		fields, err := f.Get(c.index, c.id)
+		if err != nil && err != blasterrors.ErrNotFound {
+			t.Fatalf("%v", err)
+		}
+		if !reflect.DeepEqual(c.expected, fields) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, fields)
+		}
+	}
+
+	indexInfo, err := f.GetIndex("books")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if indexInfo.DefaultTtl != 60 {
+		t.Errorf("expected content to see %v, saw %v", 60, indexInfo.DefaultTtl)
+	}
+}
@@ -15,13 +15,17 @@
 package indexer
 
 import (
+	goerrors "errors"
 	"log"
 	"net"
 	"path/filepath"
 	"time"
 
 	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis"
 	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/raft"
@@ -31,29 +35,46 @@ import (
 	"github.com/mosuka/blast/protobuf"
 	"github.com/mosuka/blast/protobuf/index"
 	blastraft "github.com/mosuka/blast/protobuf/raft"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	errBulkItemNoId          = goerrors.New("id must be set")
+	errBulkItemNoFields      = goerrors.New("fields must be set")
+	errBulkItemUnknownAction = goerrors.New("unknown action")
+	errNegativeTTL           = goerrors.New("ttl and expire_at must not be negative")
 )
 
 type RaftServer struct {
 	Node      *blastraft.Node
 	bootstrap bool
 
-	raft *raft.Raft
-	fsm  *RaftFSM
+	raft     *raft.Raft
+	fsm      *RaftFSM
+	logStore raft.LogStore
+
+	pipelines   *PipelineStore
+	idGenerator *documentIDGenerator
+
+	stopCh chan struct{}
 
 	logger *log.Logger
 }
 
-func NewRaftServer(node *blastraft.Node, bootstrap bool, indexMapping *mapping.IndexMappingImpl, indexStorageType string, logger *log.Logger) (*RaftServer, error) {
-	fsm, err := NewRaftFSM(filepath.Join(node.DataDir, "index"), indexMapping, indexStorageType, logger)
+func NewRaftServer(node *blastraft.Node, bootstrap bool, indexMapping *mapping.IndexMappingImpl, indexStorageType string, pipelines *PipelineStore, logger *log.Logger) (*RaftServer, error) {
+	fsm, err := NewRaftFSM(node.DataDir, indexMapping, indexStorageType, logger)
 	if err != nil {
 		return nil, err
 	}
 
 	return &RaftServer{
-		Node:      node,
-		bootstrap: bootstrap,
-		fsm:       fsm,
-		logger:    logger,
+		Node:        node,
+		bootstrap:   bootstrap,
+		fsm:         fsm,
+		pipelines:   pipelines,
+		idGenerator: newDocumentIDGenerator(),
+		stopCh:      make(chan struct{}),
+		logger:      logger,
 	}, nil
 }
 
@@ -85,6 +106,7 @@ func (s *RaftServer) Start() error {
 	if err != nil {
 		return err
 	}
+	s.logStore = raftLogStore
 
 	// create raft
 	s.raft, err = raft.NewRaft(config, s.fsm, raftLogStore, raftLogStore, snapshotStore, transport)
@@ -92,6 +114,8 @@ func (s *RaftServer) Start() error {
 		return err
 	}
 
+	go s.reapExpired()
+
 	if s.bootstrap {
 		configuration := raft.Configuration{
 			Servers: []raft.Server{
@@ -126,11 +150,18 @@ func (s *RaftServer) Start() error {
 }
 
 func (s *RaftServer) Stop() error {
+	close(s.stopCh)
+
 	err := s.fsm.Close()
 	if err != nil {
 		return err
 	}
 
+	err = s.pipelines.Close()
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -207,8 +238,7 @@ func (s *RaftServer) getMetadata(nodeId string) (*blastraft.Node, error) {
 
 func (s *RaftServer) setMetadata(nodeId string, node *blastraft.Node) error {
 	// Node -> Any
-	nodeAny := &any.Any{}
-	err := protobuf.UnmarshalAny(node, nodeAny)
+	nodeAny, err := protobuf.MessageToAny(node)
 	if err != nil {
 		return err
 	}
@@ -238,8 +268,7 @@ func (s *RaftServer) deleteMetadata(nodeId string) error {
 	}
 
 	// Node -> Any
-	nodeAny := &any.Any{}
-	err := protobuf.UnmarshalAny(node, nodeAny)
+	nodeAny, err := protobuf.MessageToAny(node)
 	if err != nil {
 		return err
 	}
@@ -474,28 +503,97 @@ func (s *RaftServer) Snapshot() error {
 }
 
 func (s *RaftServer) Get(doc *index.Document) (*index.Document, error) {
-	fieldsMap, err := s.fsm.Get(doc.Id)
+	fieldsMap, err := s.fsm.Get(doc.Index, doc.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	// map[string]interface{} -> Any
-	fieldsAny := &any.Any{}
-	err = protobuf.UnmarshalAny(fieldsMap, fieldsAny)
+	// map[string]interface{} -> Struct
+	fields, err := protobuf.ToStruct(fieldsMap)
 	if err != nil {
 		return nil, err
 	}
 
 	retDoc := &index.Document{
 		Id:     doc.Id,
-		Fields: fieldsAny,
+		Fields: fields,
+		Index:  doc.Index,
 	}
 
 	return retDoc, nil
 }
 
-func (s *RaftServer) Search(request *bleve.SearchRequest) (*bleve.SearchResult, error) {
-	result, err := s.fsm.Search(request)
+func (s *RaftServer) MultiGet(req *index.MultiGetRequest) (*index.MultiGetResponse, error) {
+	fieldsMaps, missingIds, err := s.fsm.MultiGet(req.Index, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &index.MultiGetResponse{
+		Documents:  make([]*index.Document, 0, len(fieldsMaps)),
+		MissingIds: missingIds,
+	}
+	for i, fieldsMap := range fieldsMaps {
+		if fieldsMap == nil {
+			continue
+		}
+
+		// map[string]interface{} -> Struct
+		fields, err := protobuf.ToStruct(filterSource(fieldsMap, req.SourceIncludes, req.SourceExcludes))
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Documents = append(resp.Documents, &index.Document{
+			Id:     req.Ids[i],
+			Fields: fields,
+			Index:  req.Index,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *RaftServer) Explain(name string, id string, q query.Query) (*search.DocumentMatch, error) {
+	match, err := s.fsm.Explain(name, id, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return match, nil
+}
+
+func (s *RaftServer) Analyze(name string, text string, analyzerName string, field string) (string, analysis.TokenStream, error) {
+	return s.fsm.Analyze(name, text, analyzerName, field)
+}
+
+func (s *RaftServer) MoreLikeThis(name string, id string, options *MoreLikeThisOptions) (query.Query, error) {
+	return s.fsm.MoreLikeThis(name, id, options)
+}
+
+func (s *RaftServer) FieldStats(name string) ([]*FieldStats, uint64, error) {
+	return s.fsm.FieldStats(name)
+}
+
+func (s *RaftServer) Suggest(name string, field string, prefix string, size int, fuzziness int) ([]*Suggestion, error) {
+	return s.fsm.Suggest(name, field, prefix, size, fuzziness)
+}
+
+func (s *RaftServer) Count(name string, q query.Query) (uint64, uint64, error) {
+	count, docCount, err := s.fsm.Count(name, q)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return count, docCount, nil
+}
+
+func (s *RaftServer) Export(name string, q query.Query, f func(id string, fields map[string]interface{}) error) error {
+	return s.fsm.Export(name, q, f)
+}
+
+func (s *RaftServer) Search(name string, request *bleve.SearchRequest) (*bleve.SearchResult, error) {
+	result, err := s.fsm.Search(name, request)
 	if err != nil {
 		return nil, err
 	}
@@ -503,6 +601,51 @@ func (s *RaftServer) Search(request *bleve.SearchRequest) (*bleve.SearchResult,
 	return result, nil
 }
 
+func (s *RaftServer) SearchAfter(name string, request *bleve.SearchRequest, after []string) (*bleve.SearchResult, error) {
+	result, err := s.fsm.SearchAfter(name, request, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *RaftServer) SearchEach(name string, request *bleve.SearchRequest, pageSize int, f func(search.DocumentMatchCollection) error) error {
+	return s.fsm.SearchEach(name, request, pageSize, f)
+}
+
+// OpenScroll starts a scroll on this node. Scrolls are not replicated, so the following pages must be read from the same node.
+func (s *RaftServer) OpenScroll(name string, request *bleve.SearchRequest, ttl time.Duration, source *SourceFilter) (*bleve.SearchResult, string, error) {
+	result, scrollID, err := s.fsm.OpenScroll(name, request, ttl, source)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return result, scrollID, nil
+}
+
+func (s *RaftServer) Scroll(scrollID string, ttl time.Duration) (*bleve.SearchResult, *SourceFilter, error) {
+	result, source, err := s.fsm.Scroll(scrollID, ttl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, source, nil
+}
+
+func (s *RaftServer) ClearScroll(scrollID string) error {
+	return s.fsm.ClearScroll(scrollID)
+}
+
+func (s *RaftServer) GetSources(hits search.DocumentMatchCollection) ([]map[string]interface{}, error) {
+	sources, err := s.fsm.GetSources(hits)
+	if err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
 func (s *RaftServer) Index(docs []*index.Document) (*index.UpdateResult, error) {
 	if s.raft.State() != raft.Leader {
 		// forward to leader node
@@ -539,11 +682,32 @@ func (s *RaftServer) Index(docs []*index.Document) (*index.UpdateResult, error)
 		return result, nil
 	}
 
+	err := s.assignIDs(docs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.applyPipelines(docs)
+	if err != nil {
+		return nil, err
+	}
+
 	count := int32(0)
+	results := make([]*index.BulkItemResult, 0, len(docs))
 	for _, doc := range docs {
+		result := &index.BulkItemResult{
+			Action: index.BulkItem_INDEX,
+			Id:     doc.Id,
+			Index:  doc.Index,
+		}
+
+		err := s.resolveExpireAt(doc, true)
+		if err != nil {
+			return nil, err
+		}
+
 		// Document -> Any
-		docAny := &any.Any{}
-		err := protobuf.UnmarshalAny(doc, docAny)
+		docAny, err := protobuf.DocumentToAny(doc)
 		if err != nil {
 			return nil, err
 		}
@@ -564,14 +728,193 @@ func (s *RaftServer) Index(docs []*index.Document) (*index.UpdateResult, error)
 			return nil, err
 		}
 
-		count++
+		// e.g. the index does not exist
+		if err, ok := f.Response().(error); ok {
+			// a document whose op type conflicts fails on its own
+			code, conflict := opTypeConflictCode(err)
+			if !conflict {
+				return nil, err
+			}
+			result.Code = int32(code)
+			result.Error = err.Error()
+		} else {
+			count++
+		}
+
+		results = append(results, result)
 	}
 
 	return &index.UpdateResult{
-		Count: count,
+		Count:   count,
+		Results: results,
 	}, nil
 }
 
+// opTypeConflictCode returns the status of a document that was not written because its op type conflicts
+// with whether it exists.
+func opTypeConflictCode(err error) (codes.Code, bool) {
+	switch err {
+	case errDocumentExists:
+		return codes.AlreadyExists, true
+	case errDocumentMissing:
+		return codes.NotFound, true
+	default:
+		return codes.OK, false
+	}
+}
+
+// Bulk applies each item on its own and reports the result of every item instead of stopping at the first failure.
+func (s *RaftServer) Bulk(req *index.BulkRequest) (*index.BulkResponse, error) {
+	if s.raft.State() != raft.Leader {
+		// forward to leader node
+		leaderId, err := s.LeaderID(60 * time.Second)
+		if err != nil {
+			return nil, err
+		}
+
+		node, err := s.getMetadata(string(leaderId))
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return nil, err
+		}
+
+		client, err := NewGRPCClient(string(node.GrpcAddr))
+		defer func() {
+			err := client.Close()
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+			}
+		}()
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return nil, err
+		}
+
+		resp, err := client.Bulk(req.Items)
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return nil, err
+		}
+
+		return resp, nil
+	}
+
+	resp := &index.BulkResponse{
+		Results: make([]*index.BulkItemResult, 0, len(req.Items)),
+	}
+	for _, item := range req.Items {
+		// an indexed document without an id is given a generated one
+		if item.Action == index.BulkItem_INDEX && item.Document != nil && item.Document.Id == "" {
+			err := s.assignIDs([]*index.Document{item.Document})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result := &index.BulkItemResult{
+			Action: item.Action,
+		}
+		if item.Document != nil {
+			result.Id = item.Document.Id
+			result.Index = item.Document.Index
+		}
+
+		err := s.applyBulkItem(item)
+		if _, ok := err.(*PipelineError); ok {
+			result.Code = int32(codes.InvalidArgument)
+			result.Error = err.Error()
+		} else if err != nil {
+			switch err {
+			case errors.ErrNotFound, errDocumentMissing:
+				result.Code = int32(codes.NotFound)
+			case errDocumentExists:
+				result.Code = int32(codes.AlreadyExists)
+			case errBulkItemNoId, errBulkItemNoFields, errBulkItemUnknownAction, errNegativeTTL:
+				result.Code = int32(codes.InvalidArgument)
+			default:
+				result.Code = int32(codes.Internal)
+			}
+			result.Error = err.Error()
+		}
+
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
+func (s *RaftServer) applyBulkItem(item *index.BulkItem) error {
+	if item.Document == nil || item.Document.Id == "" {
+		return errBulkItemNoId
+	}
+
+	var commandType index.IndexCommand_Type
+	switch item.Action {
+	case index.BulkItem_INDEX:
+		commandType = index.IndexCommand_INDEX_DOCUMENT
+	case index.BulkItem_DELETE:
+		commandType = index.IndexCommand_DELETE_DOCUMENT
+	case index.BulkItem_UPDATE:
+		commandType = index.IndexCommand_UPDATE_DOCUMENT
+	default:
+		return errBulkItemUnknownAction
+	}
+	if commandType != index.IndexCommand_DELETE_DOCUMENT && item.Document.Fields == nil {
+		return errBulkItemNoFields
+	}
+
+	doc := item.Document
+	switch commandType {
+	case index.IndexCommand_DELETE_DOCUMENT:
+		doc = deleteDocument(item.Document)
+	case index.IndexCommand_INDEX_DOCUMENT:
+		err := s.applyPipelines([]*index.Document{item.Document})
+		if err != nil {
+			return err
+		}
+
+		err = s.resolveExpireAt(item.Document, true)
+		if err != nil {
+			return err
+		}
+	case index.IndexCommand_UPDATE_DOCUMENT:
+		// an updated document keeps the time it expires at unless a new one is given
+		err := s.resolveExpireAt(item.Document, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Document -> Any
+	docAny, err := protobuf.DocumentToAny(doc)
+	if err != nil {
+		return err
+	}
+
+	c := &index.IndexCommand{
+		Type: commandType,
+		Data: docAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	// e.g. the document to update does not exist
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
 func (s *RaftServer) Delete(docs []*index.Document) (*index.UpdateResult, error) {
 	if s.raft.State() != raft.Leader {
 		// forward to leader node
@@ -611,8 +954,7 @@ func (s *RaftServer) Delete(docs []*index.Document) (*index.UpdateResult, error)
 	count := int32(0)
 	for _, doc := range docs {
 		// Document -> Any
-		docAny := &any.Any{}
-		err := protobuf.UnmarshalAny(doc, docAny)
+		docAny, err := protobuf.DocumentToAny(deleteDocument(doc))
 		if err != nil {
 			return nil, err
 		}
@@ -633,6 +975,11 @@ func (s *RaftServer) Delete(docs []*index.Document) (*index.UpdateResult, error)
 			return nil, err
 		}
 
+		// e.g. the index does not exist
+		if err, ok := f.Response().(error); ok {
+			return nil, err
+		}
+
 		count++
 	}
 
@@ -641,8 +988,17 @@ func (s *RaftServer) Delete(docs []*index.Document) (*index.UpdateResult, error)
 	}, nil
 }
 
-func (s *RaftServer) Stats() (*index.Stats, error) {
-	statsMap, err := s.fsm.Stats()
+// deleteDocument returns the document to delete with only its index and id, as expire_at on a delete
+// is reserved for the expiry reaper to delete the document only if it is still expired.
+func deleteDocument(doc *index.Document) *index.Document {
+	return &index.Document{
+		Index: doc.Index,
+		Id:    doc.Id,
+	}
+}
+
+func (s *RaftServer) Stats(name string) (*index.Stats, error) {
+	statsMap, err := s.fsm.Stats(name)
 	if err != nil {
 		return nil, err
 	}
@@ -660,3 +1016,627 @@ func (s *RaftServer) Stats() (*index.Stats, error) {
 
 	return indexStats, nil
 }
+
+func (s *RaftServer) GetIndexMapping(name string) (*index.IndexMapping, error) {
+	indexMapping, err := s.fsm.GetIndexMapping(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// mapping.IndexMappingImpl -> Any
+	indexMappingAny := &any.Any{}
+	err = protobuf.UnmarshalAny(indexMapping, indexMappingAny)
+	if err != nil {
+		return nil, err
+	}
+
+	return &index.IndexMapping{
+		IndexMapping: indexMappingAny,
+		Index:        name,
+	}, nil
+}
+
+func (s *RaftServer) PutIndexMapping(indexMapping *index.IndexMapping) error {
+	if s.raft.State() != raft.Leader {
+		// forward to leader node
+		leaderId, err := s.LeaderID(60 * time.Second)
+		if err != nil {
+			return err
+		}
+
+		node, err := s.getMetadata(string(leaderId))
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		client, err := NewGRPCClient(string(node.GrpcAddr))
+		defer func() {
+			err := client.Close()
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+			}
+		}()
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		err = client.PutIndexMapping(indexMapping.Index, indexMapping)
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	indexInfo := &index.IndexInfo{
+		Name:         indexMapping.Index,
+		IndexMapping: indexMapping.IndexMapping,
+	}
+
+	// IndexInfo -> Any
+	indexInfoAny, err := protobuf.MessageToAny(indexInfo)
+	if err != nil {
+		return err
+	}
+
+	c := &index.IndexCommand{
+		Type: index.IndexCommand_PUT_INDEX_MAPPING,
+		Data: indexInfoAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	// the reindex could not be started
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func (s *RaftServer) GetReindexStatus(name string) (*index.ReindexStatus, error) {
+	reindexStatus, err := s.fsm.GetReindexStatus(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return reindexStatus, nil
+}
+
+func (s *RaftServer) CreateIndex(indexInfo *index.IndexInfo) error {
+	if s.raft.State() != raft.Leader {
+		// forward to leader node
+		leaderId, err := s.LeaderID(60 * time.Second)
+		if err != nil {
+			return err
+		}
+
+		node, err := s.getMetadata(string(leaderId))
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		client, err := NewGRPCClient(string(node.GrpcAddr))
+		defer func() {
+			err := client.Close()
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+			}
+		}()
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		err = client.CreateIndex(indexInfo)
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	_, err := s.fsm.GetIndex(indexInfo.Name)
+	if err == nil {
+		return errors.ErrAlreadyExists
+	}
+	_, err = s.fsm.GetAlias(indexInfo.Name)
+	if err == nil {
+		return errors.ErrAlreadyExists
+	}
+
+	// IndexInfo -> Any
+	indexInfoAny, err := protobuf.MessageToAny(indexInfo)
+	if err != nil {
+		return err
+	}
+
+	c := &index.IndexCommand{
+		Type: index.IndexCommand_CREATE_INDEX,
+		Data: indexInfoAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	// the index could not be created
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func (s *RaftServer) DeleteIndex(indexInfo *index.IndexInfo) error {
+	if s.raft.State() != raft.Leader {
+		// forward to leader node
+		leaderId, err := s.LeaderID(60 * time.Second)
+		if err != nil {
+			return err
+		}
+
+		node, err := s.getMetadata(string(leaderId))
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		client, err := NewGRPCClient(string(node.GrpcAddr))
+		defer func() {
+			err := client.Close()
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+			}
+		}()
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		err = client.DeleteIndex(indexInfo)
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	_, err := s.fsm.GetIndex(indexInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	// IndexInfo -> Any
+	indexInfoAny, err := protobuf.MessageToAny(&index.IndexInfo{Name: indexInfo.Name})
+	if err != nil {
+		return err
+	}
+
+	c := &index.IndexCommand{
+		Type: index.IndexCommand_DELETE_INDEX,
+		Data: indexInfoAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	// the index could not be deleted
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func (s *RaftServer) GetIndex(name string) (*index.IndexInfo, error) {
+	indexInfo, err := s.fsm.GetIndex(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexInfo, nil
+}
+
+func (s *RaftServer) ListIndexes() (*index.IndexList, error) {
+	indexInfos, err := s.fsm.ListIndexes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &index.IndexList{
+		Indexes: indexInfos,
+	}, nil
+}
+
+func (s *RaftServer) PutAlias(alias *index.Alias) error {
+	if s.raft.State() != raft.Leader {
+		// forward to leader node
+		leaderId, err := s.LeaderID(60 * time.Second)
+		if err != nil {
+			return err
+		}
+
+		node, err := s.getMetadata(string(leaderId))
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		client, err := NewGRPCClient(string(node.GrpcAddr))
+		defer func() {
+			err := client.Close()
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+			}
+		}()
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		err = client.PutAlias(alias)
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	// Alias -> Any
+	aliasAny, err := protobuf.MessageToAny(alias)
+	if err != nil {
+		return err
+	}
+
+	c := &index.IndexCommand{
+		Type: index.IndexCommand_PUT_ALIAS,
+		Data: aliasAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	// the alias could not be changed
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func (s *RaftServer) DeleteAlias(alias *index.Alias) error {
+	if s.raft.State() != raft.Leader {
+		// forward to leader node
+		leaderId, err := s.LeaderID(60 * time.Second)
+		if err != nil {
+			return err
+		}
+
+		node, err := s.getMetadata(string(leaderId))
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		client, err := NewGRPCClient(string(node.GrpcAddr))
+		defer func() {
+			err := client.Close()
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+			}
+		}()
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		err = client.DeleteAlias(alias)
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	_, err := s.fsm.GetAlias(alias.Name)
+	if err != nil {
+		return err
+	}
+
+	// Alias -> Any
+	aliasAny, err := protobuf.MessageToAny(alias)
+	if err != nil {
+		return err
+	}
+
+	c := &index.IndexCommand{
+		Type: index.IndexCommand_DELETE_ALIAS,
+		Data: aliasAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	// the alias could not be changed
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func (s *RaftServer) SwapAlias(swapAlias *index.SwapAliasRequest) error {
+	if s.raft.State() != raft.Leader {
+		// forward to leader node
+		leaderId, err := s.LeaderID(60 * time.Second)
+		if err != nil {
+			return err
+		}
+
+		node, err := s.getMetadata(string(leaderId))
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		client, err := NewGRPCClient(string(node.GrpcAddr))
+		defer func() {
+			err := client.Close()
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+			}
+		}()
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		err = client.SwapAlias(swapAlias)
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	// SwapAliasRequest -> Any
+	swapAliasAny, err := protobuf.MessageToAny(swapAlias)
+	if err != nil {
+		return err
+	}
+
+	c := &index.IndexCommand{
+		Type: index.IndexCommand_SWAP_ALIAS,
+		Data: swapAliasAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	// the alias could not be changed
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func (s *RaftServer) PutPercolatorQuery(percolatorQuery *index.PercolatorQuery) error {
+	if s.raft.State() != raft.Leader {
+		// forward to leader node
+		leaderId, err := s.LeaderID(60 * time.Second)
+		if err != nil {
+			return err
+		}
+
+		node, err := s.getMetadata(string(leaderId))
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		client, err := NewGRPCClient(string(node.GrpcAddr))
+		defer func() {
+			err := client.Close()
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+			}
+		}()
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		err = client.PutPercolatorQuery(percolatorQuery)
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	// PercolatorQuery -> Any
+	percolatorQueryAny, err := protobuf.MessageToAny(percolatorQuery)
+	if err != nil {
+		return err
+	}
+
+	c := &index.IndexCommand{
+		Type: index.IndexCommand_PUT_PERCOLATOR_QUERY,
+		Data: percolatorQueryAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	// the index does not exist
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func (s *RaftServer) DeletePercolatorQuery(percolatorQuery *index.PercolatorQuery) error {
+	if s.raft.State() != raft.Leader {
+		// forward to leader node
+		leaderId, err := s.LeaderID(60 * time.Second)
+		if err != nil {
+			return err
+		}
+
+		node, err := s.getMetadata(string(leaderId))
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		client, err := NewGRPCClient(string(node.GrpcAddr))
+		defer func() {
+			err := client.Close()
+			if err != nil {
+				s.logger.Printf("[ERR] %v", err)
+			}
+		}()
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		err = client.DeletePercolatorQuery(percolatorQuery)
+		if err != nil {
+			s.logger.Printf("[ERR] %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	// PercolatorQuery -> Any
+	percolatorQueryAny, err := protobuf.MessageToAny(percolatorQuery)
+	if err != nil {
+		return err
+	}
+
+	c := &index.IndexCommand{
+		Type: index.IndexCommand_DELETE_PERCOLATOR_QUERY,
+		Data: percolatorQueryAny,
+	}
+
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(msg, 10*time.Second)
+	err = f.Error()
+	if err != nil {
+		return err
+	}
+
+	// the index or the percolator query does not exist
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func (s *RaftServer) ListPercolatorQueries(name string) (map[string]query.Query, error) {
+	return s.fsm.ListPercolatorQueries(name)
+}
+
+func (s *RaftServer) Percolate(name string, fields map[string]interface{}) ([]string, error) {
+	return s.fsm.Percolate(name, fields)
+}
+
+func (s *RaftServer) SubscribePercolations(name string) (<-chan *index.Percolation, func()) {
+	return s.fsm.SubscribePercolations(name)
+}
+
+func (s *RaftServer) GetAlias(name string) (*index.Alias, error) {
+	alias, err := s.fsm.GetAlias(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return alias, nil
+}
+
+func (s *RaftServer) ListAliases() (*index.AliasList, error) {
+	aliases, err := s.fsm.ListAliases()
+	if err != nil {
+		return nil, err
+	}
+
+	return &index.AliasList{
+		Aliases: aliases,
+	}, nil
+}
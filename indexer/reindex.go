@@ -0,0 +1,439 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+)
+
+const (
+	reindexDirSuffix  = ".reindex"
+	oldIndexDirSuffix = ".old"
+
+	reindexBatchSize = 1000
+
+	// catch-up rounds run without blocking writes until the number of
+	// documents written during the previous round is small enough
+	maxCatchUpRounds = 10
+	catchUpThreshold = 100
+)
+
+var errReindexCanceled = errors.New("reindex canceled")
+
+// Reindexer rebuilds an index with a new mapping from the original documents
+// kept in the internal store, then swaps the rebuilt index in.
+type Reindexer struct {
+	index        *Index
+	indexMapping *mapping.IndexMappingImpl
+
+	status      *pbindex.ReindexStatus
+	statusMutex sync.RWMutex
+
+	dirty      map[string]struct{}
+	dirtyMutex sync.Mutex
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	doneCh   chan struct{}
+
+	logger *log.Logger
+}
+
+func NewReindexer(index *Index, indexMapping *mapping.IndexMappingImpl, logger *log.Logger) (*Reindexer, error) {
+	err := indexMapping.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reindexer{
+		index:        index,
+		indexMapping: indexMapping,
+		status: &pbindex.ReindexStatus{
+			State:     pbindex.ReindexStatus_RUNNING,
+			StartTime: ptypes.TimestampNow(),
+		},
+		dirty:  make(map[string]struct{}, 0),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+		logger: logger,
+	}, nil
+}
+
+func (r *Reindexer) Run() {
+	defer close(r.doneCh)
+
+	r.logger.Print("[INFO] reindex started")
+
+	err := r.reindex()
+
+	// stop tracking writes
+	r.dirtyMutex.Lock()
+	r.dirty = nil
+	r.dirtyMutex.Unlock()
+
+	r.statusMutex.Lock()
+	defer r.statusMutex.Unlock()
+
+	r.status.EndTime = ptypes.TimestampNow()
+	switch err {
+	case nil:
+		r.status.State = pbindex.ReindexStatus_COMPLETED
+		r.logger.Printf("[INFO] reindex completed: %d documents", r.status.IndexedDocuments)
+	case errReindexCanceled:
+		r.status.State = pbindex.ReindexStatus_CANCELED
+		r.logger.Print("[INFO] reindex canceled")
+	default:
+		r.status.State = pbindex.ReindexStatus_FAILED
+		r.status.Error = err.Error()
+		r.logger.Printf("[ERR] reindex failed: %v", err)
+	}
+}
+
+func (r *Reindexer) Cancel() {
+	r.stopOnce.Do(func() {
+		close(r.stopCh)
+	})
+	<-r.doneCh
+}
+
+func (r *Reindexer) Status() *pbindex.ReindexStatus {
+	r.statusMutex.RLock()
+	defer r.statusMutex.RUnlock()
+
+	return proto.Clone(r.status).(*pbindex.ReindexStatus)
+}
+
+func (r *Reindexer) MarkDirty(id string) {
+	r.dirtyMutex.Lock()
+	defer r.dirtyMutex.Unlock()
+
+	if r.dirty != nil {
+		r.dirty[id] = struct{}{}
+	}
+}
+
+func (r *Reindexer) takeDirty() []string {
+	r.dirtyMutex.Lock()
+	defer r.dirtyMutex.Unlock()
+
+	ids := make([]string, 0, len(r.dirty))
+	for id := range r.dirty {
+		ids = append(ids, id)
+	}
+	r.dirty = make(map[string]struct{}, 0)
+
+	return ids
+}
+
+func (r *Reindexer) canceled() bool {
+	select {
+	case <-r.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *Reindexer) reindex() error {
+	newDir := r.index.dir + reindexDirSuffix
+
+	err := os.RemoveAll(newDir)
+	if err != nil {
+		return err
+	}
+
+	newIndex, err := bleve.NewUsing(newDir, r.indexMapping, bleve.Config.DefaultIndexType, r.index.indexStorageType, nil)
+	if err != nil {
+		return err
+	}
+	swapped := false
+	defer func() {
+		if swapped {
+			return
+		}
+		err := newIndex.Close()
+		if err != nil {
+			r.logger.Printf("[ERR] %v", err)
+		}
+		err = os.RemoveAll(newDir)
+		if err != nil {
+			r.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	// copy the original documents
+	err = r.copyAll(newIndex)
+	if err != nil {
+		return err
+	}
+
+	// catch up with the documents written during the copy
+	for i := 0; i < maxCatchUpRounds; i++ {
+		if r.canceled() {
+			return errReindexCanceled
+		}
+
+		ids := r.takeDirty()
+
+		r.index.mutex.RLock()
+		err = r.catchUp(newIndex, ids)
+		r.index.mutex.RUnlock()
+		if err != nil {
+			return err
+		}
+
+		if len(ids) <= catchUpThreshold {
+			break
+		}
+	}
+
+	// block writes while applying the last changes and swapping the index
+	r.index.mutex.Lock()
+	defer r.index.mutex.Unlock()
+
+	if r.canceled() {
+		return errReindexCanceled
+	}
+
+	err = r.catchUp(newIndex, r.takeDirty())
+	if err != nil {
+		return err
+	}
+
+	err = r.index.swap(newIndex, newDir)
+	if err != nil {
+		return err
+	}
+	swapped = true
+
+	return nil
+}
+
+func (r *Reindexer) copyAll(newIndex bleve.Index) error {
+	r.index.mutex.RLock()
+	defer r.index.mutex.RUnlock()
+
+	i, _, err := r.index.index.Advanced()
+	if err != nil {
+		return err
+	}
+
+	reader, err := i.Reader()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := reader.Close()
+		if err != nil {
+			r.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	docCount, err := reader.DocCount()
+	if err != nil {
+		return err
+	}
+	r.statusMutex.Lock()
+	r.status.TotalDocuments = docCount
+	r.statusMutex.Unlock()
+
+	dr, err := reader.DocIDReaderAll()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := dr.Close()
+		if err != nil {
+			r.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	batch := newIndex.NewBatch()
+	for {
+		if r.canceled() {
+			return errReindexCanceled
+		}
+
+		internalId, err := dr.Next()
+		if err != nil {
+			return err
+		}
+		if internalId == nil {
+			break
+		}
+
+		id, err := reader.ExternalID(internalId)
+		if err != nil {
+			return err
+		}
+
+		fieldsBytes, err := reader.GetInternal([]byte(id))
+		if err != nil {
+			return err
+		}
+
+		expireAt, err := readExpireAt(reader, id)
+		if err != nil {
+			return err
+		}
+
+		err = r.addToBatch(newIndex, batch, id, fieldsBytes, expireAt)
+		if err != nil {
+			return err
+		}
+
+		if batch.Size() >= reindexBatchSize*2 {
+			err = r.flush(newIndex, batch, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return r.flush(newIndex, batch, false)
+}
+
+// catchUp copies the current state of the given documents into the new index.
+// The caller must hold the read or write lock.
+func (r *Reindexer) catchUp(newIndex bleve.Index, ids []string) error {
+	i, _, err := r.index.index.Advanced()
+	if err != nil {
+		return err
+	}
+	reader, err := i.Reader()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := reader.Close()
+		if err != nil {
+			r.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	batch := newIndex.NewBatch()
+	for _, id := range ids {
+		fieldsBytes, err := reader.GetInternal([]byte(id))
+		if err != nil {
+			return err
+		}
+
+		expireAt, err := readExpireAt(reader, id)
+		if err != nil {
+			return err
+		}
+
+		err = r.addToBatch(newIndex, batch, id, fieldsBytes, expireAt)
+		if err != nil {
+			return err
+		}
+
+		if batch.Size() >= reindexBatchSize*2 {
+			err = r.flush(newIndex, batch, true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return r.flush(newIndex, batch, true)
+}
+
+func (r *Reindexer) addToBatch(newIndex bleve.Index, batch *bleve.Batch, id string, fieldsBytes []byte, expireAt int64) error {
+	if len(fieldsBytes) <= 0 {
+		// the document has been deleted
+		batch.Delete(id)
+		batch.DeleteInternal([]byte(id))
+		return nil
+	}
+
+	// bytes -> map[string]interface{}
+	var fieldsMap map[string]interface{}
+	err := json.Unmarshal(fieldsBytes, &fieldsMap)
+	if err != nil {
+		return err
+	}
+
+	doc, err := newDocument(newIndex.Mapping(), id, fieldsMap, expireAt)
+	if err != nil {
+		return err
+	}
+
+	err = batch.IndexAdvanced(doc)
+	if err != nil {
+		return err
+	}
+	batch.SetInternal([]byte(id), fieldsBytes)
+
+	return nil
+}
+
+func (r *Reindexer) flush(newIndex bleve.Index, batch *bleve.Batch, catchUp bool) error {
+	// every document adds an index operation and an internal operation
+	count := uint64(batch.Size() / 2)
+	if count == 0 {
+		return nil
+	}
+
+	err := newIndex.Batch(batch)
+	if err != nil {
+		return err
+	}
+	batch.Reset()
+
+	r.statusMutex.Lock()
+	if catchUp {
+		r.status.CaughtUpDocuments += count
+	} else {
+		r.status.IndexedDocuments += count
+	}
+	r.statusMutex.Unlock()
+
+	return nil
+}
+
+// recoverSwappedIndex cleans up after a reindex interrupted by a crash.
+// The mapping change is still in the Raft log, so the reindex runs again
+// when the log is replayed.
+func recoverSwappedIndex(dir string) error {
+	err := os.RemoveAll(dir + reindexDirSuffix)
+	if err != nil {
+		return err
+	}
+
+	oldDir := dir + oldIndexDirSuffix
+	_, err = os.Stat(oldDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	_, err = os.Stat(dir)
+	if os.IsNotExist(err) {
+		// crashed between moving the old index away and moving the new one in
+		return os.Rename(oldDir, dir)
+	}
+
+	return os.RemoveAll(oldDir)
+}
@@ -0,0 +1,239 @@
+package indexer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/mapping"
+	pbindex "github.com/mosuka/blast/protobuf/index"
+)
+
+func newTestLogger() *log.Logger {
+	return log.New(ioutil.Discard, "", 0)
+}
+
+func newTestIndex(t *testing.T, indexMapping *mapping.IndexMappingImpl) (*Index, string) {
+	dir, err := ioutil.TempDir("", "blast-indexer-test")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	index, err := NewIndex(filepath.Join(dir, "index"), indexMapping, bleve.Config.DefaultKVStore, newTestLogger())
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		t.Fatalf("%v", err)
+	}
+
+	return index, dir
+}
+
+// newKeywordTitleMapping returns a mapping indexing the title as a single term.
+func newKeywordTitleMapping() *mapping.IndexMappingImpl {
+	titleMapping := bleve.NewTextFieldMapping()
+	titleMapping.Analyzer = keyword.Name
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping.AddFieldMappingsAt("title", titleMapping)
+
+	return indexMapping
+}
+
+func searchTitleTerm(t *testing.T, index *Index, term string) uint64 {
+	q := bleve.NewTermQuery(term)
+	q.SetField("title")
+
+	result, err := index.Search(bleve.NewSearchRequest(q))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return result.Total
+}
+
+func TestReindexerCatchUp(t *testing.T) {
+	index, dir := newTestIndex(t, bleve.NewIndexMapping())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	defer func() {
+		_ = index.Close()
+	}()
+
+	for _, id := range []string{"1", "2", "3"} {
+		err := index.Index(id, map[string]interface{}{"title": "Title " + id}, 0)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+
+	newMapping := newKeywordTitleMapping()
+	reindexer, err := NewReindexer(index, newMapping, newTestLogger())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	newIndex, err := bleve.NewUsing(index.dir+reindexDirSuffix, newMapping, bleve.Config.DefaultIndexType, index.indexStorageType, nil)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer func() {
+		_ = newIndex.Close()
+	}()
+
+	err = reindexer.copyAll(newIndex)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// write while the reindexer is tracking the writes, as after the copy
+	index.reindexerMutex.Lock()
+	index.reindexer = reindexer
+	index.reindexerMutex.Unlock()
+
+	err = index.Index("1", map[string]interface{}{"title": "Updated 1"}, 0)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	err = index.Delete("2")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	err = index.Index("4", map[string]interface{}{"title": "Title 4"}, 0)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	index.reindexerMutex.Lock()
+	index.reindexer = nil
+	index.reindexerMutex.Unlock()
+
+	index.mutex.RLock()
+	err = reindexer.catchUp(newIndex, reindexer.takeDirty())
+	index.mutex.RUnlock()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	cases := []struct {
+		id       string
+		expected map[string]interface{}
+	}{
+		{"1", map[string]interface{}{"title": "Updated 1"}},
+		{"2", nil},
+		{"3", map[string]interface{}{"title": "Title 3"}},
+		{"4", map[string]interface{}{"title": "Title 4"}},
+	}
+
+	for _, c := range cases {
+		fieldsBytes, err := newIndex.GetInternal([]byte(c.id))
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		var actual map[string]interface{}
+		if len(fieldsBytes) > 0 {
+			err = json.Unmarshal(fieldsBytes, &actual)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+		}
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, actual)
+		}
+	}
+
+	docCount, err := newIndex.DocCount()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if docCount != 3 {
+		t.Errorf("expected content to see %v, saw %v", 3, docCount)
+	}
+
+	status := reindexer.Status()
+	if status.CaughtUpDocuments != 3 {
+		t.Errorf("expected content to see %v, saw %v", 3, status.CaughtUpDocuments)
+	}
+}
+
+func TestIndexReindex(t *testing.T) {
+	index, dir := newTestIndex(t, bleve.NewIndexMapping())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	defer func() {
+		_ = index.Close()
+	}()
+
+	for _, id := range []string{"1", "2"} {
+		err := index.Index(id, map[string]interface{}{"title": "Hello World " + id}, 0)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+
+	if total := searchTitleTerm(t, index, "hello"); total != 2 {
+		t.Errorf("expected content to see %v, saw %v", 2, total)
+	}
+
+	err := index.Reindex(newKeywordTitleMapping())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	timeout := time.After(10 * time.Second)
+	for index.ReindexStatus().State == pbindex.ReindexStatus_RUNNING {
+		select {
+		case <-timeout:
+			t.Fatalf("reindex did not complete")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	status := index.ReindexStatus()
+	if status.State != pbindex.ReindexStatus_COMPLETED {
+		t.Fatalf("expected content to see %v, saw %v (%s)", pbindex.ReindexStatus_COMPLETED, status.State, status.Error)
+	}
+	if status.IndexedDocuments != 2 {
+		t.Errorf("expected content to see %v, saw %v", 2, status.IndexedDocuments)
+	}
+
+	// the swapped index analyzes the title with the new mapping
+	cases := []struct {
+		term     string
+		expected uint64
+	}{
+		{"hello", 0},
+		{"Hello World 1", 1},
+		{"Hello World 2", 1},
+	}
+
+	for _, c := range cases {
+		if total := searchTitleTerm(t, index, c.term); total != c.expected {
+			t.Errorf("expected content to see %v for %s, saw %v", c.expected, c.term, total)
+		}
+	}
+
+	fields, err := index.Get("1")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if fields["title"] != "Hello World 1" {
+		t.Errorf("expected content to see %v, saw %v", "Hello World 1", fields["title"])
+	}
+
+	for _, suffix := range []string{reindexDirSuffix, oldIndexDirSuffix} {
+		_, err := os.Stat(index.dir + suffix)
+		if !os.IsNotExist(err) {
+			t.Errorf("expected content to see %s removed, saw %v", index.dir+suffix, err)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	bleveindex "github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	blasterrors "github.com/mosuka/blast/errors"
+)
+
+const (
+	DefaultScrollTTL = 1 * time.Minute
+
+	scrollReapInterval = 10 * time.Second
+)
+
+// SourceFilter selects the original document fields returned with search hits.
+type SourceFilter struct {
+	Includes []string
+	Excludes []string
+}
+
+// scrollContext keeps a point-in-time reader open so that later pages see the same documents.
+type scrollContext struct {
+	reader  bleveindex.IndexReader
+	mapping mapping.IndexMapping
+	name    string
+	request *bleve.SearchRequest
+	source  *SourceFilter
+
+	after   *search.DocumentMatch
+	ttl     time.Duration
+	expires time.Time
+
+	mutex sync.Mutex
+}
+
+func (c *scrollContext) next() (*bleve.SearchResult, error) {
+	result, err := searchReader(context.Background(), c.reader, c.mapping, c.name, c.request, c.after)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Hits) > 0 {
+		c.after = result.Hits[len(result.Hits)-1]
+	}
+
+	return result, nil
+}
+
+// OpenScroll searches the first page of a scroll and keeps the reader open for the following pages.
+func (b *Index) OpenScroll(request *bleve.SearchRequest, ttl time.Duration, source *SourceFilter) (*bleve.SearchResult, string, error) {
+	if ttl <= 0 {
+		ttl = DefaultScrollTTL
+	}
+
+	// use the document ID as a tie-breaker so that no hit is skipped between pages
+	if len(request.Sort) <= 0 {
+		request.Sort = search.SortOrder{&search.SortScore{Desc: true}}
+	}
+	if !hasDocIDSort(request.Sort) {
+		request.Sort = append(request.Sort.Copy(), &search.SortDocID{})
+	}
+
+	b.mutex.RLock()
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		b.mutex.RUnlock()
+		return nil, "", err
+	}
+	reader, err := i.Reader()
+	if err != nil {
+		b.mutex.RUnlock()
+		return nil, "", err
+	}
+	scroll := &scrollContext{
+		reader:  reader,
+		mapping: b.index.Mapping(),
+		name:    b.index.Name(),
+		request: request,
+		source:  source,
+		ttl:     ttl,
+		expires: time.Now().Add(ttl),
+	}
+	b.mutex.RUnlock()
+
+	result, err := scroll.next()
+	if err != nil {
+		_ = reader.Close()
+		return nil, "", err
+	}
+
+	id, err := newScrollID()
+	if err != nil {
+		_ = reader.Close()
+		return nil, "", err
+	}
+
+	b.scrollsMutex.Lock()
+	b.scrolls[id] = scroll
+	b.scrollsMutex.Unlock()
+
+	return result, id, nil
+}
+
+// Scroll returns the next page of a scroll and extends its lifetime.
+func (b *Index) Scroll(id string, ttl time.Duration) (*bleve.SearchResult, *SourceFilter, error) {
+	b.scrollsMutex.Lock()
+	scroll, ok := b.scrolls[id]
+	b.scrollsMutex.Unlock()
+	if !ok {
+		return nil, nil, blasterrors.ErrNotFound
+	}
+
+	scroll.mutex.Lock()
+	defer scroll.mutex.Unlock()
+
+	// the scroll may have expired while waiting
+	if scroll.reader == nil {
+		return nil, nil, blasterrors.ErrNotFound
+	}
+
+	if ttl > 0 {
+		scroll.ttl = ttl
+	}
+	scroll.expires = time.Now().Add(scroll.ttl)
+
+	result, err := scroll.next()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, scroll.source, nil
+}
+
+func (b *Index) ClearScroll(id string) error {
+	b.scrollsMutex.Lock()
+	scroll, ok := b.scrolls[id]
+	delete(b.scrolls, id)
+	b.scrollsMutex.Unlock()
+	if !ok {
+		return blasterrors.ErrNotFound
+	}
+
+	return scroll.close()
+}
+
+func (c *scrollContext) close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.reader == nil {
+		return nil
+	}
+
+	err := c.reader.Close()
+	c.reader = nil
+
+	return err
+}
+
+// closeScrolls closes all the open scrolls, which must happen before the index itself can be closed.
+func (b *Index) closeScrolls() {
+	b.scrollsMutex.Lock()
+	scrolls := b.scrolls
+	b.scrolls = make(map[string]*scrollContext)
+	b.scrollsMutex.Unlock()
+
+	for _, scroll := range scrolls {
+		err := scroll.close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}
+}
+
+func (b *Index) reapScrolls() {
+	ticker := time.NewTicker(scrollReapInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stopCh:
+			return
+		case now := <-ticker.C:
+			b.scrollsMutex.Lock()
+			expired := make([]*scrollContext, 0)
+			for id, scroll := range b.scrolls {
+				scroll.mutex.Lock()
+				if now.After(scroll.expires) {
+					expired = append(expired, scroll)
+					delete(b.scrolls, id)
+				}
+				scroll.mutex.Unlock()
+			}
+			b.scrollsMutex.Unlock()
+
+			for _, scroll := range expired {
+				err := scroll.close()
+				if err != nil {
+					b.logger.Printf("[ERR] %v", err)
+				}
+			}
+		}
+	}
+}
+
+func hasDocIDSort(sortOrder search.SortOrder) bool {
+	for _, s := range sortOrder {
+		if _, ok := s.(*search.SortDocID); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func newScrollID() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
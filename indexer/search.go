@@ -0,0 +1,264 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+)
+
+// SearchRequest is a bleve search request that can ask for the original documents of the hits.
+type SearchRequest struct {
+	*bleve.SearchRequest
+
+	IncludeSource  bool     `json:"include_source,omitempty"`
+	SourceIncludes []string `json:"source_includes,omitempty"`
+	SourceExcludes []string `json:"source_excludes,omitempty"`
+	SearchAfter    []string `json:"search_after,omitempty"`
+	Scroll         string   `json:"scroll,omitempty"`
+	Profile        bool     `json:"profile,omitempty"`
+}
+
+func NewSearchRequest(request *bleve.SearchRequest) *SearchRequest {
+	return &SearchRequest{
+		SearchRequest: request,
+	}
+}
+
+func (r *SearchRequest) UnmarshalJSON(input []byte) error {
+	request := bleve.NewSearchRequest(nil)
+	err := json.Unmarshal(input, request)
+	if err != nil {
+		return err
+	}
+
+	var temp struct {
+		IncludeSource  bool     `json:"include_source"`
+		SourceIncludes []string `json:"source_includes"`
+		SourceExcludes []string `json:"source_excludes"`
+		SearchAfter    []string `json:"search_after"`
+		Scroll         string   `json:"scroll"`
+		Profile        bool     `json:"profile"`
+	}
+	err = json.Unmarshal(input, &temp)
+	if err != nil {
+		return err
+	}
+
+	r.SearchRequest = request
+	r.IncludeSource = temp.IncludeSource
+	r.SourceIncludes = temp.SourceIncludes
+	r.SourceExcludes = temp.SourceExcludes
+	r.SearchAfter = temp.SearchAfter
+	r.Scroll = temp.Scroll
+	r.Profile = temp.Profile
+
+	return nil
+}
+
+// NewSearchRequestFromValues builds a query string search request from URL query parameters:
+// q (match all if empty), size, from, sort, fields, facet (field or field:size, repeatable),
+// highlight (true or a highlighter style), explain, profile, include_source, source_includes and source_excludes.
+// Lists are comma separated or repeated.
+func NewSearchRequestFromValues(values url.Values) (*SearchRequest, error) {
+	var q query.Query
+	if queryStr := values.Get("q"); queryStr != "" {
+		q = bleve.NewQueryStringQuery(queryStr)
+	} else {
+		q = bleve.NewMatchAllQuery()
+	}
+
+	request := bleve.NewSearchRequest(q)
+
+	var err error
+	if sizeStr := values.Get("size"); sizeStr != "" {
+		request.Size, err = strconv.Atoi(sizeStr)
+		if err != nil || request.Size < 0 {
+			return nil, fmt.Errorf("invalid size: %s", sizeStr)
+		}
+	}
+	if fromStr := values.Get("from"); fromStr != "" {
+		request.From, err = strconv.Atoi(fromStr)
+		if err != nil || request.From < 0 {
+			return nil, fmt.Errorf("invalid from: %s", fromStr)
+		}
+	}
+
+	if sort := splitValues(values["sort"]); len(sort) > 0 {
+		request.SortBy(sort)
+	}
+
+	request.Fields = splitValues(values["fields"])
+
+	for _, facet := range splitValues(values["facet"]) {
+		field := facet
+		size := 10
+		if pos := strings.LastIndex(facet, ":"); pos >= 0 {
+			field = facet[:pos]
+			size, err = strconv.Atoi(facet[pos+1:])
+			if err != nil || size <= 0 {
+				return nil, fmt.Errorf("invalid facet: %s", facet)
+			}
+		}
+		request.AddFacet(field, bleve.NewFacetRequest(field, size))
+	}
+
+	switch highlight := values.Get("highlight"); highlight {
+	case "", "false":
+	case "true":
+		request.Highlight = bleve.NewHighlight()
+	default:
+		request.Highlight = bleve.NewHighlightWithStyle(highlight)
+	}
+
+	if explain := values.Get("explain"); explain != "" {
+		request.Explain, err = strconv.ParseBool(explain)
+		if err != nil {
+			return nil, fmt.Errorf("invalid explain: %s", explain)
+		}
+	}
+
+	searchRequest := NewSearchRequest(request)
+	if profile := values.Get("profile"); profile != "" {
+		searchRequest.Profile, err = strconv.ParseBool(profile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid profile: %s", profile)
+		}
+	}
+	if includeSource := values.Get("include_source"); includeSource != "" {
+		searchRequest.IncludeSource, err = strconv.ParseBool(includeSource)
+		if err != nil {
+			return nil, fmt.Errorf("invalid include_source: %s", includeSource)
+		}
+	}
+	searchRequest.SourceIncludes = splitValues(values["source_includes"])
+	searchRequest.SourceExcludes = splitValues(values["source_excludes"])
+
+	return searchRequest, nil
+}
+
+func splitValues(values []string) []string {
+	var rv []string
+	for _, value := range values {
+		for _, v := range strings.Split(value, ",") {
+			v = strings.TrimSpace(v)
+			if v != "" {
+				rv = append(rv, v)
+			}
+		}
+	}
+
+	return rv
+}
+
+// SearchResult is a bleve search result whose hits can carry their original documents.
+type SearchResult struct {
+	*bleve.SearchResult
+
+	Hits     []*DocumentMatch `json:"hits"`
+	ScrollId string           `json:"scroll_id,omitempty"`
+	Profile  *SearchProfile   `json:"profile,omitempty"`
+}
+
+type DocumentMatch struct {
+	*search.DocumentMatch
+
+	Source map[string]interface{} `json:"_source,omitempty"`
+}
+
+func NewSearchResult(result *bleve.SearchResult, sources []map[string]interface{}) *SearchResult {
+	hits := make([]*DocumentMatch, 0, len(result.Hits))
+	for i, hit := range result.Hits {
+		documentMatch := &DocumentMatch{
+			DocumentMatch: hit,
+		}
+		if i < len(sources) {
+			documentMatch.Source = sources[i]
+		}
+		hits = append(hits, documentMatch)
+	}
+
+	return &SearchResult{
+		SearchResult: result,
+		Hits:         hits,
+	}
+}
+
+// filterSource returns the fields of the source matching the includes and not matching the excludes.
+// Patterns are matched against dotted field paths, e.g. "author.name" or "author.*".
+func filterSource(source map[string]interface{}, includes []string, excludes []string) map[string]interface{} {
+	if len(includes) <= 0 && len(excludes) <= 0 {
+		return source
+	}
+
+	return filterFields(source, "", includes, excludes)
+}
+
+func filterFields(fields map[string]interface{}, prefix string, includes []string, excludes []string) map[string]interface{} {
+	filtered := make(map[string]interface{}, len(fields))
+	for name, value := range fields {
+		fieldPath := name
+		if prefix != "" {
+			fieldPath = prefix + "." + name
+		}
+
+		if matchFieldPath(excludes, fieldPath) {
+			continue
+		}
+
+		childFields, isObject := value.(map[string]interface{})
+
+		if len(includes) <= 0 || matchFieldPath(includes, fieldPath) {
+			if isObject && len(excludes) > 0 {
+				// the whole object is included, but some of its fields may still be excluded
+				filtered[name] = filterFields(childFields, fieldPath, nil, excludes)
+			} else {
+				filtered[name] = value
+			}
+			continue
+		}
+
+		if isObject {
+			child := filterFields(childFields, fieldPath, includes, excludes)
+			if len(child) > 0 {
+				filtered[name] = child
+			}
+		}
+	}
+
+	return filtered
+}
+
+func matchFieldPath(patterns []string, fieldPath string) bool {
+	for _, pattern := range patterns {
+		if pattern == fieldPath || strings.HasPrefix(fieldPath, pattern+".") {
+			return true
+		}
+		matched, err := path.Match(pattern, fieldPath)
+		if err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
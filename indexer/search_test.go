@@ -0,0 +1,170 @@
+package indexer
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+func TestFilterSource(t *testing.T) {
+	source := map[string]interface{}{
+		"title": "Blast",
+		"author": map[string]interface{}{
+			"name":  "mosuka",
+			"email": "mosuka@example.com",
+		},
+		"tags": []interface{}{"search", "go"},
+	}
+
+	cases := []struct {
+		includes []string
+		excludes []string
+		expected map[string]interface{}
+	}{
+		{
+			nil,
+			nil,
+			source,
+		},
+		{
+			[]string{"title"},
+			nil,
+			map[string]interface{}{"title": "Blast"},
+		},
+		{
+			[]string{"author"},
+			nil,
+			map[string]interface{}{
+				"author": map[string]interface{}{"name": "mosuka", "email": "mosuka@example.com"},
+			},
+		},
+		{
+			[]string{"author.name"},
+			nil,
+			map[string]interface{}{
+				"author": map[string]interface{}{"name": "mosuka"},
+			},
+		},
+		{
+			[]string{"author.*"},
+			[]string{"author.email"},
+			map[string]interface{}{
+				"author": map[string]interface{}{"name": "mosuka"},
+			},
+		},
+		{
+			nil,
+			[]string{"author.email", "tags"},
+			map[string]interface{}{
+				"title":  "Blast",
+				"author": map[string]interface{}{"name": "mosuka"},
+			},
+		},
+		{
+			[]string{"t*"},
+			[]string{"tags"},
+			map[string]interface{}{"title": "Blast"},
+		},
+		{
+			[]string{"missing"},
+			nil,
+			map[string]interface{}{},
+		},
+	}
+
+	for _, c := range cases {
+		actual := filterSource(source, c.includes, c.excludes)
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("expected content to see %v, saw %v", c.expected, actual)
+		}
+	}
+}
+
+func TestNewSearchRequestFromValues(t *testing.T) {
+	request, err := NewSearchRequestFromValues(url.Values{})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if _, ok := request.Query.(*query.MatchAllQuery); !ok {
+		t.Errorf("expected content to see %T, saw %T", &query.MatchAllQuery{}, request.Query)
+	}
+	if request.Size != 10 || request.From != 0 {
+		t.Errorf("expected content to see %v and %v, saw %v and %v", 10, 0, request.Size, request.From)
+	}
+
+	values := url.Values{
+		"q":               []string{"title:blast"},
+		"size":            []string{"20"},
+		"from":            []string{"5"},
+		"sort":            []string{"-_score,title", "_id"},
+		"fields":          []string{"title, author"},
+		"facet":           []string{"tags", "category:3"},
+		"highlight":       []string{"html"},
+		"explain":         []string{"true"},
+		"profile":         []string{"true"},
+		"include_source":  []string{"true"},
+		"source_includes": []string{"title,author.*"},
+		"source_excludes": []string{"author.email"},
+	}
+	request, err = NewSearchRequestFromValues(values)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	queryStringQuery, ok := request.Query.(*query.QueryStringQuery)
+	if !ok {
+		t.Fatalf("expected content to see %T, saw %T", &query.QueryStringQuery{}, request.Query)
+	}
+	if queryStringQuery.Query != "title:blast" {
+		t.Errorf("expected content to see %v, saw %v", "title:blast", queryStringQuery.Query)
+	}
+	if request.Size != 20 || request.From != 5 {
+		t.Errorf("expected content to see %v and %v, saw %v and %v", 20, 5, request.Size, request.From)
+	}
+	if len(request.Sort) != 3 {
+		t.Errorf("expected content to see %v, saw %v", 3, len(request.Sort))
+	}
+	if expected := []string{"title", "author"}; !reflect.DeepEqual(expected, request.Fields) {
+		t.Errorf("expected content to see %v, saw %v", expected, request.Fields)
+	}
+	if request.Facets["tags"].Size != 10 || request.Facets["category"].Size != 3 {
+		t.Errorf("expected content to see %v and %v, saw %v and %v", 10, 3, request.Facets["tags"].Size, request.Facets["category"].Size)
+	}
+	if request.Highlight == nil || request.Highlight.Style == nil || *request.Highlight.Style != "html" {
+		t.Errorf("expected content to see %v, saw %v", "html", request.Highlight)
+	}
+	if !request.Explain || !request.Profile || !request.IncludeSource {
+		t.Errorf("expected content to see explain, profile and include_source set, saw %v, %v and %v", request.Explain, request.Profile, request.IncludeSource)
+	}
+	if expected := []string{"title", "author.*"}; !reflect.DeepEqual(expected, request.SourceIncludes) {
+		t.Errorf("expected content to see %v, saw %v", expected, request.SourceIncludes)
+	}
+	if expected := []string{"author.email"}; !reflect.DeepEqual(expected, request.SourceExcludes) {
+		t.Errorf("expected content to see %v, saw %v", expected, request.SourceExcludes)
+	}
+}
+
+func TestNewSearchRequestFromValuesInvalid(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+	}{
+		{"size", "ten"},
+		{"size", "-1"},
+		{"from", "-1"},
+		{"facet", "tags:0"},
+		{"facet", "tags:many"},
+		{"explain", "yes"},
+		{"profile", "yes"},
+		{"include_source", "yes"},
+	}
+
+	for _, c := range cases {
+		_, err := NewSearchRequestFromValues(url.Values{c.name: []string{c.value}})
+		if err == nil {
+			t.Errorf("expected content to see an error for %s=%s, saw nil", c.name, c.value)
+		}
+	}
+}
@@ -42,7 +42,7 @@ type Server struct {
 	httpLogger accesslog.Logger
 }
 
-func NewServer(nodeId string, bindAddr string, grpcAddr string, httpAddr string, dataDir string, joinAddr string, indexMappingPath string, indexStorageType string, logger *log.Logger, httpLogger accesslog.Logger) (*Server, error) {
+func NewServer(nodeId string, bindAddr string, grpcAddr string, httpAddr string, dataDir string, joinAddr string, indexMappingPath string, indexStorageType string, pipelinesPath string, managerAddr string, logger *log.Logger, httpLogger accesslog.Logger) (*Server, error) {
 	var err error
 
 	server := &Server{
@@ -94,8 +94,14 @@ func NewServer(nodeId string, bindAddr string, grpcAddr string, httpAddr string,
 		DataDir:  dataDir,
 	}
 
+	// load ingest pipelines
+	pipelines, err := NewPipelineStore(pipelinesPath, managerAddr, server.logger)
+	if err != nil {
+		return nil, err
+	}
+
 	// create raft server
-	server.raftServer, err = NewRaftServer(server.node, server.bootstrap, indexMapping, indexStorageType, server.logger)
+	server.raftServer, err = NewRaftServer(server.node, server.bootstrap, indexMapping, indexStorageType, pipelines, server.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,242 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search/query"
+)
+
+const (
+	DefaultMaxQueryTerms = 25
+	DefaultMinTermFreq   = 2
+	DefaultMinDocFreq    = 5
+)
+
+// MoreLikeThisOptions selects the terms of a document that a more like this query is made of.
+type MoreLikeThisOptions struct {
+	// Fields are the fields to take terms from, all the text fields of the document if empty.
+	Fields []string
+	// MaxQueryTerms is the max number of terms, the most significant ones are kept.
+	MaxQueryTerms int
+	// MinTermFreq is the min number of times a term occurs in the document.
+	MinTermFreq int
+	// MinDocFreq is the min number of documents of the index containing a term.
+	MinDocFreq int
+	// MaxDocFreq is the max number of documents of the index containing a term, unlimited if 0.
+	MaxDocFreq int
+}
+
+// NewMoreLikeThisOptions returns the options with the default limits.
+func NewMoreLikeThisOptions() *MoreLikeThisOptions {
+	return &MoreLikeThisOptions{
+		MaxQueryTerms: DefaultMaxQueryTerms,
+		MinTermFreq:   DefaultMinTermFreq,
+		MinDocFreq:    DefaultMinDocFreq,
+	}
+}
+
+type moreLikeThisTerm struct {
+	field string
+	term  string
+	score float64
+}
+
+// MoreLikeThis returns a query for the documents similar to the document with the id.
+// The terms of the original document are weighted by tf-idf, and the most significant ones are
+// put in a disjunction boosted by their weights. The document itself does not match the query.
+func (b *Index) MoreLikeThis(id string, options *MoreLikeThisOptions) (query.Query, error) {
+	start := time.Now()
+	defer func() {
+		b.logger.Printf("[DEBUG] more like this %s %f", id, float64(time.Since(start))/float64(time.Second))
+	}()
+
+	fieldsMap, err := b.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	indexMapping, ok := b.index.Mapping().(*mapping.IndexMappingImpl)
+	if !ok {
+		return nil, errors.New("unsupported index mapping")
+	}
+
+	texts := make(map[string][]string)
+	collectTexts("", fieldsMap, texts)
+	if len(options.Fields) > 0 {
+		selected := make(map[string][]string, len(options.Fields))
+		for _, field := range options.Fields {
+			if values, ok := texts[field]; ok {
+				selected[field] = values
+			}
+		}
+		texts = selected
+	}
+
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := i.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := r.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	docCount, err := r.DocCount()
+	if err != nil {
+		return nil, err
+	}
+
+	terms := make([]*moreLikeThisTerm, 0)
+	for field, values := range texts {
+		analyzerName := indexMapping.AnalyzerNameForPath(field)
+		analyzer := indexMapping.AnalyzerNamed(analyzerName)
+		if analyzer == nil {
+			return nil, fmt.Errorf("no analyzer named %s", analyzerName)
+		}
+
+		termFreqs := make(map[string]int)
+		for _, value := range values {
+			for _, token := range analyzer.Analyze([]byte(value)) {
+				termFreqs[string(token.Term)]++
+			}
+		}
+
+		for term, termFreq := range termFreqs {
+			if termFreq < options.MinTermFreq {
+				continue
+			}
+
+			tfr, err := r.TermFieldReader([]byte(term), field, false, false, false)
+			if err != nil {
+				return nil, err
+			}
+			docFreq := tfr.Count()
+			err = tfr.Close()
+			if err != nil {
+				return nil, err
+			}
+			// terms not in the field dictionary, e.g. of fields that are not text, cannot match
+			if docFreq == 0 || docFreq < uint64(options.MinDocFreq) {
+				continue
+			}
+			if options.MaxDocFreq > 0 && docFreq > uint64(options.MaxDocFreq) {
+				continue
+			}
+
+			// the same idf as the term scorer of bleve
+			idf := 1.0 + math.Log(float64(docCount)/float64(docFreq+1))
+			terms = append(terms, &moreLikeThisTerm{
+				field: field,
+				term:  term,
+				score: float64(termFreq) * idf,
+			})
+		}
+	}
+
+	if len(terms) <= 0 {
+		return query.NewMatchNoneQuery(), nil
+	}
+
+	sort.Slice(terms, func(i, j int) bool {
+		if terms[i].score != terms[j].score {
+			return terms[i].score > terms[j].score
+		}
+		if terms[i].field != terms[j].field {
+			return terms[i].field < terms[j].field
+		}
+		return terms[i].term < terms[j].term
+	})
+	if options.MaxQueryTerms > 0 && len(terms) > options.MaxQueryTerms {
+		terms = terms[:options.MaxQueryTerms]
+	}
+
+	disjuncts := make([]query.Query, 0, len(terms))
+	for _, t := range terms {
+		termQuery := query.NewTermQuery(t.term)
+		termQuery.SetField(t.field)
+		termQuery.SetBoost(t.score)
+		disjuncts = append(disjuncts, termQuery)
+	}
+
+	return query.NewBooleanQuery(
+		[]query.Query{query.NewDisjunctionQuery(disjuncts)},
+		nil,
+		[]query.Query{query.NewDocIDQuery([]string{id})},
+	), nil
+}
+
+// NewMoreLikeThisOptionsFromValues makes the options from the like_fields, max_query_terms,
+// min_term_freq, min_doc_freq and max_doc_freq URL query parameters.
+func NewMoreLikeThisOptionsFromValues(values url.Values) (*MoreLikeThisOptions, error) {
+	options := NewMoreLikeThisOptions()
+	options.Fields = splitValues(values["like_fields"])
+
+	for name, value := range map[string]*int{
+		"max_query_terms": &options.MaxQueryTerms,
+		"min_term_freq":   &options.MinTermFreq,
+		"min_doc_freq":    &options.MinDocFreq,
+		"max_doc_freq":    &options.MaxDocFreq,
+	} {
+		valueStr := values.Get(name)
+		if valueStr == "" {
+			continue
+		}
+		v, err := strconv.Atoi(valueStr)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("invalid %s: %s", name, valueStr)
+		}
+		*value = v
+	}
+
+	return options, nil
+}
+
+// collectTexts collects the string values of the document by their dotted field paths.
+func collectTexts(path string, value interface{}, texts map[string][]string) {
+	switch v := value.(type) {
+	case string:
+		texts[path] = append(texts[path], v)
+	case []interface{}:
+		for _, e := range v {
+			collectTexts(path, e, texts)
+		}
+	case map[string]interface{}:
+		for name, e := range v {
+			if path != "" {
+				name = path + "." + name
+			}
+			collectTexts(name, e, texts)
+		}
+	}
+}
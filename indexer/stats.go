@@ -0,0 +1,149 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"sort"
+	"time"
+)
+
+// FieldStats describes what an index holds for a field.
+type FieldStats struct {
+	Name string
+	// DocCount is the number of documents having terms in the field.
+	DocCount uint64
+	// TermCount is the number of distinct terms in the field dictionary.
+	TermCount uint64
+	// SumDocFreq is the sum of the document frequencies of the terms, i.e. the number of postings.
+	SumDocFreq uint64
+}
+
+// FieldStats returns the statistics of the indexed fields ordered by name, and the number of documents in the index.
+// Document counts take a pass over all the documents, so this is meant for inspection rather than frequent use.
+func (b *Index) FieldStats() ([]*FieldStats, uint64, error) {
+	start := time.Now()
+	defer func() {
+		b.logger.Printf("[DEBUG] field stats %f", float64(time.Since(start))/float64(time.Second))
+	}()
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r, err := i.Reader()
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		err := r.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	docCount, err := r.DocCount()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	allFields, err := r.Fields()
+	if err != nil {
+		return nil, 0, err
+	}
+	fields := make([]string, 0, len(allFields))
+	for _, field := range allFields {
+		// the expiry times are not part of the documents
+		if field != expireAtField {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	statsMap := make(map[string]*FieldStats, len(fields))
+	for _, field := range fields {
+		stats := &FieldStats{
+			Name: field,
+		}
+		statsMap[field] = stats
+
+		dict, err := r.FieldDict(field)
+		if err != nil {
+			return nil, 0, err
+		}
+		for {
+			entry, err := dict.Next()
+			if err != nil {
+				_ = dict.Close()
+				return nil, 0, err
+			}
+			if entry == nil {
+				break
+			}
+			stats.TermCount++
+			stats.SumDocFreq += entry.Count
+		}
+		err = dict.Close()
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// count the documents having each field in a single pass
+	dr, err := r.DocIDReaderAll()
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		err := dr.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	seen := make(map[string]bool, len(fields))
+	for {
+		internalId, err := dr.Next()
+		if err != nil {
+			return nil, 0, err
+		}
+		if internalId == nil {
+			break
+		}
+
+		for field := range seen {
+			delete(seen, field)
+		}
+		err = r.DocumentVisitFieldTerms(internalId, fields, func(field string, term []byte) {
+			if !seen[field] {
+				seen[field] = true
+				statsMap[field].DocCount++
+			}
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	fieldStats := make([]*FieldStats, 0, len(fields))
+	for _, field := range fields {
+		fieldStats = append(fieldStats, statsMap[field])
+	}
+
+	return fieldStats, docCount, nil
+}
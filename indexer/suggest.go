@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"sort"
+	"time"
+
+	bleveindex "github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/search"
+)
+
+const (
+	DefaultSuggestSize = 10
+
+	// DefaultSuggestFuzziness is the edit distance used when a fuzzy suggestion does not specify one.
+	DefaultSuggestFuzziness = 2
+)
+
+// Suggestion is a term of a field dictionary suggested for a prefix or a misspelled term.
+type Suggestion struct {
+	Term string
+	// Count is the number of documents containing the term.
+	Count uint64
+	// Distance is the edit distance from the requested term, always 0 for prefix suggestions.
+	Distance int
+}
+
+// Suggest returns the terms of the field starting with the prefix, most frequent first.
+// With a fuzziness greater than 0 it instead returns the terms within that edit distance
+// of the prefix, closest and then most frequent first, for did-you-mean corrections.
+func (b *Index) Suggest(field string, prefix string, size int, fuzziness int) ([]*Suggestion, error) {
+	start := time.Now()
+	defer func() {
+		b.logger.Printf("[DEBUG] suggest %s %s %f", field, prefix, float64(time.Since(start))/float64(time.Second))
+	}()
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if size <= 0 {
+		size = DefaultSuggestSize
+	}
+
+	var dict bleveindex.FieldDict
+	var err error
+	if fuzziness > 0 || prefix == "" {
+		// the prefix dictionary does not list any terms for an empty prefix
+		dict, err = b.index.FieldDict(field)
+	} else {
+		dict, err = b.index.FieldDictPrefix(field, []byte(prefix))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := dict.Close()
+		if err != nil {
+			b.logger.Printf("[ERR] %v", err)
+		}
+	}()
+
+	suggestions := make([]*Suggestion, 0)
+	for {
+		entry, err := dict.Next()
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			break
+		}
+
+		distance := 0
+		if fuzziness > 0 {
+			var exceeded bool
+			distance, exceeded = search.LevenshteinDistanceMax(prefix, entry.Term, fuzziness)
+			if exceeded || distance > fuzziness {
+				continue
+			}
+		}
+
+		suggestions = append(suggestions, &Suggestion{
+			Term:     entry.Term,
+			Count:    entry.Count,
+			Distance: distance,
+		})
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Distance != suggestions[j].Distance {
+			return suggestions[i].Distance < suggestions[j].Distance
+		}
+		return suggestions[i].Count > suggestions[j].Count
+	})
+
+	if len(suggestions) > size {
+		suggestions = suggestions[:size]
+	}
+
+	return suggestions, nil
+}
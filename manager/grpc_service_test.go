@@ -0,0 +1,58 @@
+package manager
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/mosuka/blast/protobuf/management"
+	blastraft "github.com/mosuka/blast/protobuf/raft"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCServiceGet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blast-manager-test")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	logger := log.New(ioutil.Discard, "", 0)
+
+	raftServer, err := NewRaftServer(&blastraft.Node{Id: "node1", DataDir: dir}, false, logger)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	ret := raftServer.fsm.applySet("pipelines", map[string]interface{}{"lowercase": map[string]interface{}{}})
+	if err, ok := ret.(error); ok {
+		t.Fatalf("%v", err)
+	}
+
+	service, err := NewGRPCService(raftServer, logger)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	cases := []struct {
+		key      string
+		expected codes.Code
+	}{
+		{"pipelines", codes.OK},
+		{"pipelines/lowercase", codes.OK},
+		{"pipelines/missing", codes.NotFound},
+		{"missing", codes.NotFound},
+	}
+
+	for _, c := range cases {
+		_, err := service.Get(context.Background(), &management.KeyValuePair{Key: c.key})
+		st, _ := status.FromError(err)
+		if st.Code() != c.expected {
+			t.Errorf("expected content to see %v for %s, saw %v", c.expected, c.key, st.Code())
+		}
+	}
+}
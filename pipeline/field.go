@@ -0,0 +1,149 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+)
+
+// getField returns the value of the field, where a dotted path refers to a field of a nested object.
+func getField(fields map[string]interface{}, path string) (interface{}, bool) {
+	names := strings.Split(path, ".")
+
+	current := fields
+	for i, name := range names {
+		value, exists := current[name]
+		if !exists {
+			return nil, false
+		}
+		if i == len(names)-1 {
+			return value, true
+		}
+
+		next, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	return nil, false
+}
+
+// setField sets the value of the field, creating the nested objects of a dotted path as needed.
+func setField(fields map[string]interface{}, path string, value interface{}) error {
+	names := strings.Split(path, ".")
+
+	current := fields
+	for _, name := range names[:len(names)-1] {
+		next, exists := current[name]
+		if !exists {
+			nextMap := make(map[string]interface{})
+			current[name] = nextMap
+			current = nextMap
+			continue
+		}
+
+		nextMap, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s is not an object in %s", name, path)
+		}
+		current = nextMap
+	}
+	current[names[len(names)-1]] = value
+
+	return nil
+}
+
+// removeField removes the field and reports whether it existed.
+func removeField(fields map[string]interface{}, path string) bool {
+	names := strings.Split(path, ".")
+
+	current := fields
+	for _, name := range names[:len(names)-1] {
+		next, ok := current[name].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		current = next
+	}
+
+	_, exists := current[names[len(names)-1]]
+	delete(current, names[len(names)-1])
+
+	return exists
+}
+
+func configString(config map[string]interface{}, key string, required bool) (string, error) {
+	value, exists := config[key]
+	if !exists {
+		if required {
+			return "", fmt.Errorf("%s must be set", key)
+		}
+		return "", nil
+	}
+
+	str, ok := value.(string)
+	if !ok || (required && str == "") {
+		return "", fmt.Errorf("%s must be a string", key)
+	}
+
+	return str, nil
+}
+
+func configStrings(config map[string]interface{}, key string, required bool) ([]string, error) {
+	value, exists := config[key]
+	if !exists {
+		if required {
+			return nil, fmt.Errorf("%s must be set", key)
+		}
+		return nil, nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		strs := make([]string, 0, len(v))
+		for _, e := range v {
+			str, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a string or an array of strings", key)
+			}
+			strs = append(strs, str)
+		}
+		if required && len(strs) <= 0 {
+			return nil, fmt.Errorf("%s must not be empty", key)
+		}
+		return strs, nil
+	default:
+		return nil, fmt.Errorf("%s must be a string or an array of strings", key)
+	}
+}
+
+func configBool(config map[string]interface{}, key string, defaultValue bool) (bool, error) {
+	value, exists := config[key]
+	if !exists {
+		return defaultValue, nil
+	}
+
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s must be a boolean", key)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// unknownLanguage is the code the detector returns for a text in no language it recognizes.
+const unknownLanguage = "un"
+
+// detectLanguage returns the ISO 639-1 code of the language of the text. It is set when Blast is built
+// with the cld2 tag.
+var detectLanguage func(text string) string
+
+func init() {
+	RegisterProcessor("detect_language", NewDetectLanguageProcessor)
+}
+
+// DetectLanguageProcessor detects the language of the text in fields and stores its code, wrapped in
+// prefix and suffix, in target_field. Setting target_field to the type field of the index mapping routes
+// the document to the analyzers of its language. The fields may be patterns, such as title_*, matched
+// against the top level fields.
+type DetectLanguageProcessor struct {
+	fields       []string
+	targetField  string
+	prefix       string
+	suffix       string
+	defaultValue string
+	override     bool
+}
+
+func NewDetectLanguageProcessor(config map[string]interface{}) (Processor, error) {
+	if detectLanguage == nil {
+		return nil, errors.New("language detection is not available, build with the cld2 tag to enable it")
+	}
+
+	fields, err := configStrings(config, "fields", true)
+	if err != nil {
+		return nil, err
+	}
+
+	targetField, err := configString(config, "target_field", false)
+	if err != nil {
+		return nil, err
+	}
+	if targetField == "" {
+		targetField = "language"
+	}
+
+	prefix, err := configString(config, "prefix", false)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix, err := configString(config, "suffix", false)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultValue, err := configString(config, "default", false)
+	if err != nil {
+		return nil, err
+	}
+
+	override, err := configBool(config, "override", true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DetectLanguageProcessor{
+		fields:       fields,
+		targetField:  targetField,
+		prefix:       prefix,
+		suffix:       suffix,
+		defaultValue: defaultValue,
+		override:     override,
+	}, nil
+}
+
+func (p *DetectLanguageProcessor) Process(fields map[string]interface{}) error {
+	if !p.override {
+		if _, exists := getField(fields, p.targetField); exists {
+			return nil
+		}
+	}
+
+	language := unknownLanguage
+	if text := p.text(fields); text != "" {
+		language = detectLanguage(text)
+	}
+
+	if language == "" || language == unknownLanguage {
+		if p.defaultValue == "" {
+			return nil
+		}
+		return setField(fields, p.targetField, p.defaultValue)
+	}
+
+	return setField(fields, p.targetField, fmt.Sprintf("%s%s%s", p.prefix, language, p.suffix))
+}
+
+// text joins the strings of the fields to detect the language of.
+func (p *DetectLanguageProcessor) text(fields map[string]interface{}) string {
+	texts := make([]string, 0)
+	for _, field := range p.matchFields(fields) {
+		value, exists := getField(fields, field)
+		if !exists {
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			texts = append(texts, v)
+		case []interface{}:
+			for _, e := range v {
+				if str, ok := e.(string); ok {
+					texts = append(texts, str)
+				}
+			}
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(texts, "\n"))
+}
+
+// matchFields expands the field patterns to the sorted top level fields they match.
+func (p *DetectLanguageProcessor) matchFields(fields map[string]interface{}) []string {
+	matched := make([]string, 0, len(p.fields))
+	for _, field := range p.fields {
+		if !strings.Contains(field, "*") {
+			matched = append(matched, field)
+			continue
+		}
+
+		names := make([]string, 0)
+		for name := range fields {
+			if ok, _ := path.Match(field, name); ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		matched = append(matched, names...)
+	}
+
+	return matched
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+)
+
+// Processor transforms the fields of a document in place.
+type Processor interface {
+	Process(fields map[string]interface{}) error
+}
+
+// ProcessorConstructor creates a processor from its configuration.
+type ProcessorConstructor func(config map[string]interface{}) (Processor, error)
+
+var processors = make(map[string]ProcessorConstructor, 0)
+
+// RegisterProcessor makes a processor type available to the pipelines.
+func RegisterProcessor(typ string, constructor ProcessorConstructor) {
+	if _, exists := processors[typ]; exists {
+		panic(fmt.Errorf("attempted to register duplicate processor: %s", typ))
+	}
+	processors[typ] = constructor
+}
+
+// ProcessorTypes returns the sorted names of the registered processor types.
+func ProcessorTypes() []string {
+	types := make([]string, 0, len(processors))
+	for typ := range processors {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+type processorEntry struct {
+	typ       string
+	processor Processor
+}
+
+// Pipeline applies its processors to the fields of a document in order.
+type Pipeline struct {
+	Description string
+	processors  []*processorEntry
+}
+
+// NewPipeline creates a pipeline from a configuration such as
+// {"description": "...", "processors": [{"rename": {"field": "a", "target_field": "b"}}]}.
+func NewPipeline(config map[string]interface{}) (*Pipeline, error) {
+	p := &Pipeline{}
+
+	if description, exists := config["description"]; exists {
+		descriptionStr, ok := description.(string)
+		if !ok {
+			return nil, errors.New("description must be a string")
+		}
+		p.Description = descriptionStr
+	}
+
+	processorConfigs, ok := config["processors"].([]interface{})
+	if !ok {
+		return nil, errors.New("processors must be an array")
+	}
+
+	for i, processorConfig := range processorConfigs {
+		processorMap, ok := processorConfig.(map[string]interface{})
+		if !ok || len(processorMap) != 1 {
+			return nil, fmt.Errorf("processor %d must be an object with one processor type", i)
+		}
+
+		for typ, c := range processorMap {
+			constructor, exists := processors[typ]
+			if !exists {
+				return nil, fmt.Errorf("processor %d: unknown processor type: %s", i, typ)
+			}
+
+			cMap, ok := c.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("processor %d (%s): configuration must be an object", i, typ)
+			}
+
+			processor, err := constructor(cMap)
+			if err != nil {
+				return nil, fmt.Errorf("processor %d (%s): %v", i, typ, err)
+			}
+
+			p.processors = append(p.processors, &processorEntry{
+				typ:       typ,
+				processor: processor,
+			})
+		}
+	}
+
+	return p, nil
+}
+
+// Process returns the fields transformed by the processors, leaving the given fields untouched.
+func (p *Pipeline) Process(fields map[string]interface{}) (map[string]interface{}, error) {
+	processed := copyFields(fields)
+
+	for i, entry := range p.processors {
+		err := entry.processor.Process(processed)
+		if err != nil {
+			return nil, fmt.Errorf("processor %d (%s): %v", i, entry.typ, err)
+		}
+	}
+
+	return processed, nil
+}
+
+// NewPipelines creates the pipelines from a configuration mapping the pipeline names to their configurations.
+func NewPipelines(config map[string]interface{}) (map[string]*Pipeline, error) {
+	pipelines := make(map[string]*Pipeline, len(config))
+	for name, c := range config {
+		cMap, ok := c.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("pipeline %s must be an object", name)
+		}
+
+		p, err := NewPipeline(cMap)
+		if err != nil {
+			return nil, fmt.Errorf("pipeline %s: %v", name, err)
+		}
+		pipelines[name] = p
+	}
+
+	return pipelines, nil
+}
+
+// LoadFile reads the pipelines from a JSON file mapping the pipeline names to their configurations.
+func LoadFile(path string) (map[string]*Pipeline, error) {
+	configBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config map[string]interface{}
+	err = json.Unmarshal(configBytes, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPipelines(config)
+}
+
+func copyFields(fields map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(fields))
+	for name, value := range fields {
+		copied[name] = copyValue(value)
+	}
+
+	return copied
+}
+
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return copyFields(v)
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, e := range v {
+			copied[i] = copyValue(e)
+		}
+		return copied
+	default:
+		return v
+	}
+}
@@ -0,0 +1,176 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestPipeline(t *testing.T, configStr string) *Pipeline {
+	var config map[string]interface{}
+	err := json.Unmarshal([]byte(configStr), &config)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	p, err := NewPipeline(config)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return p
+}
+
+func TestPipelineProcess(t *testing.T) {
+	p := newTestPipeline(t, `{
+		"description": "normalize logs",
+		"processors": [
+			{"rename": {"field": "msg", "target_field": "message"}},
+			{"remove": {"field": ["debug", "trace"], "ignore_missing": true}},
+			{"trim": {"field": "level"}},
+			{"lowercase": {"field": "level"}},
+			{"split": {"field": "tags", "separator": ","}},
+			{"trim": {"field": "tags"}},
+			{"date": {"field": "time", "formats": ["02/Jan/2006:15:04:05 -0700", "RFC3339"], "target_field": "timestamp"}},
+			{"set": {"field": "meta.source", "value": "app"}},
+			{"default": {"field": "host", "value": "unknown"}}
+		]
+	}`)
+	if p.Description != "normalize logs" {
+		t.Errorf("expected content to see %v, saw %v", "normalize logs", p.Description)
+	}
+
+	fields := map[string]interface{}{
+		"msg":   "hello",
+		"debug": true,
+		"level": " WARN ",
+		"tags":  "a, b,c",
+		"time":  "10/Oct/2019:13:55:36 +0900",
+		"host":  "",
+	}
+
+	actual, err := p.Process(fields)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	expected := map[string]interface{}{
+		"message":   "hello",
+		"level":     "warn",
+		"tags":      []interface{}{"a", "b", "c"},
+		"time":      "10/Oct/2019:13:55:36 +0900",
+		"timestamp": "2019-10-10T13:55:36+09:00",
+		"meta":      map[string]interface{}{"source": "app"},
+		"host":      "unknown",
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected content to see %v, saw %v", expected, actual)
+	}
+
+	// the given fields are left untouched
+	if fields["msg"] != "hello" || fields["level"] != " WARN " {
+		t.Errorf("expected the fields to be untouched, saw %v", fields)
+	}
+}
+
+func TestPipelineProcessMissingField(t *testing.T) {
+	p := newTestPipeline(t, `{"processors": [{"lowercase": {"field": "title"}}]}`)
+
+	_, err := p.Process(map[string]interface{}{})
+	if err == nil {
+		t.Fatalf("expected error, saw nil")
+	}
+
+	p = newTestPipeline(t, `{"processors": [{"lowercase": {"field": "title", "ignore_missing": true}}]}`)
+
+	actual, err := p.Process(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(actual) != 0 {
+		t.Errorf("expected content to see %v, saw %v", map[string]interface{}{}, actual)
+	}
+}
+
+func TestSetProcessorWithoutOverride(t *testing.T) {
+	p := newTestPipeline(t, `{"processors": [{"set": {"field": "lang", "value": "en", "override": false}}]}`)
+
+	actual, err := p.Process(map[string]interface{}{"lang": "ja"})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if actual["lang"] != "ja" {
+		t.Errorf("expected content to see %v, saw %v", "ja", actual["lang"])
+	}
+}
+
+func TestDateProcessorWithUnixTime(t *testing.T) {
+	p := newTestPipeline(t, `{"processors": [{"date": {"field": "time", "formats": ["UNIX"]}}]}`)
+
+	actual, err := p.Process(map[string]interface{}{"time": float64(1570000000)})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if actual["time"] != "2019-10-02T07:06:40Z" {
+		t.Errorf("expected content to see %v, saw %v", "2019-10-02T07:06:40Z", actual["time"])
+	}
+}
+
+func TestDetectLanguageProcessor(t *testing.T) {
+	orig := detectLanguage
+	detectLanguage = func(text string) string {
+		if strings.Contains(text, "moteur") {
+			return "fr"
+		}
+		return unknownLanguage
+	}
+	defer func() {
+		detectLanguage = orig
+	}()
+
+	p := newTestPipeline(t, `{"processors": [{"detect_language": {"fields": ["title_*", "text_*"], "target_field": "_type", "suffix": "wiki", "default": "unknown", "override": false}}]}`)
+
+	cases := []struct {
+		fields   map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"title_fr": "Moteur", "text_fr": "Un moteur de recherche"}, "frwiki"},
+		{map[string]interface{}{"title_xx": "?"}, "unknown"},
+		{map[string]interface{}{"title_fr": "Moteur de recherche", "_type": "enwiki"}, "enwiki"},
+	}
+
+	for _, c := range cases {
+		actual, err := p.Process(c.fields)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		if actual["_type"] != c.expected {
+			t.Errorf("expected content to see %v, saw %v", c.expected, actual["_type"])
+		}
+	}
+}
+
+func TestNewPipelineWithInvalidConfig(t *testing.T) {
+	configs := []string{
+		`{}`,
+		`{"processors": [{"unknown": {}}]}`,
+		`{"processors": [{"rename": {"field": "a"}}]}`,
+		`{"processors": [{"split": {"field": "a"}}]}`,
+		`{"processors": [{"date": {"field": "a", "formats": []}}]}`,
+		`{"processors": [{"set": {"field": "a"}, "remove": {"field": "b"}}]}`,
+	}
+
+	for _, configStr := range configs {
+		var config map[string]interface{}
+		err := json.Unmarshal([]byte(configStr), &config)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		_, err = NewPipeline(config)
+		if err == nil {
+			t.Errorf("expected error for %s, saw nil", configStr)
+		}
+	}
+}
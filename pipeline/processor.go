@@ -0,0 +1,414 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() {
+	RegisterProcessor("rename", NewRenameProcessor)
+	RegisterProcessor("remove", NewRemoveProcessor)
+	RegisterProcessor("set", NewSetProcessor)
+	RegisterProcessor("default", NewDefaultProcessor)
+	RegisterProcessor("date", NewDateProcessor)
+	RegisterProcessor("lowercase", NewLowercaseProcessor)
+	RegisterProcessor("trim", NewTrimProcessor)
+	RegisterProcessor("split", NewSplitProcessor)
+}
+
+// fieldProcessor holds the options common to the processors of a single field.
+type fieldProcessor struct {
+	field         string
+	targetField   string
+	ignoreMissing bool
+}
+
+func newFieldProcessor(config map[string]interface{}) (*fieldProcessor, error) {
+	field, err := configString(config, "field", true)
+	if err != nil {
+		return nil, err
+	}
+
+	targetField, err := configString(config, "target_field", false)
+	if err != nil {
+		return nil, err
+	}
+	if targetField == "" {
+		targetField = field
+	}
+
+	ignoreMissing, err := configBool(config, "ignore_missing", false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fieldProcessor{
+		field:         field,
+		targetField:   targetField,
+		ignoreMissing: ignoreMissing,
+	}, nil
+}
+
+// value returns the value of the field, or false if it is missing and allowed to be.
+func (p *fieldProcessor) value(fields map[string]interface{}) (interface{}, bool, error) {
+	value, exists := getField(fields, p.field)
+	if !exists || value == nil {
+		if p.ignoreMissing {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("field %s does not exist", p.field)
+	}
+
+	return value, true, nil
+}
+
+// mapStrings applies f to the string, or to each string of the array, of the field and stores the result.
+func (p *fieldProcessor) mapStrings(fields map[string]interface{}, f func(string) (interface{}, error)) error {
+	value, ok, err := p.value(fields)
+	if err != nil || !ok {
+		return err
+	}
+
+	switch v := value.(type) {
+	case string:
+		result, err := f(v)
+		if err != nil {
+			return err
+		}
+		return setField(fields, p.targetField, result)
+	case []interface{}:
+		results := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			str, ok := e.(string)
+			if !ok {
+				return fmt.Errorf("field %s must be a string or an array of strings", p.field)
+			}
+			result, err := f(str)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+		return setField(fields, p.targetField, results)
+	default:
+		return fmt.Errorf("field %s must be a string or an array of strings", p.field)
+	}
+}
+
+// RenameProcessor moves the value of field to target_field.
+type RenameProcessor struct {
+	*fieldProcessor
+}
+
+func NewRenameProcessor(config map[string]interface{}) (Processor, error) {
+	fp, err := newFieldProcessor(config)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := config["target_field"]; !exists {
+		return nil, errors.New("target_field must be set")
+	}
+
+	return &RenameProcessor{fieldProcessor: fp}, nil
+}
+
+func (p *RenameProcessor) Process(fields map[string]interface{}) error {
+	value, ok, err := p.value(fields)
+	if err != nil || !ok {
+		return err
+	}
+
+	removeField(fields, p.field)
+
+	return setField(fields, p.targetField, value)
+}
+
+// RemoveProcessor removes one or more fields.
+type RemoveProcessor struct {
+	fields        []string
+	ignoreMissing bool
+}
+
+func NewRemoveProcessor(config map[string]interface{}) (Processor, error) {
+	fields, err := configStrings(config, "field", true)
+	if err != nil {
+		return nil, err
+	}
+
+	ignoreMissing, err := configBool(config, "ignore_missing", false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoveProcessor{
+		fields:        fields,
+		ignoreMissing: ignoreMissing,
+	}, nil
+}
+
+func (p *RemoveProcessor) Process(fields map[string]interface{}) error {
+	for _, field := range p.fields {
+		if !removeField(fields, field) && !p.ignoreMissing {
+			return fmt.Errorf("field %s does not exist", field)
+		}
+	}
+
+	return nil
+}
+
+// SetProcessor sets a field to a value, unless override is false and the field already exists.
+type SetProcessor struct {
+	field    string
+	value    interface{}
+	override bool
+}
+
+func NewSetProcessor(config map[string]interface{}) (Processor, error) {
+	field, err := configString(config, "field", true)
+	if err != nil {
+		return nil, err
+	}
+
+	value, exists := config["value"]
+	if !exists {
+		return nil, errors.New("value must be set")
+	}
+
+	override, err := configBool(config, "override", true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetProcessor{
+		field:    field,
+		value:    value,
+		override: override,
+	}, nil
+}
+
+func (p *SetProcessor) Process(fields map[string]interface{}) error {
+	if !p.override {
+		if _, exists := getField(fields, p.field); exists {
+			return nil
+		}
+	}
+
+	return setField(fields, p.field, copyValue(p.value))
+}
+
+// DefaultProcessor sets a field to a value if it is missing, null or an empty string.
+type DefaultProcessor struct {
+	field string
+	value interface{}
+}
+
+func NewDefaultProcessor(config map[string]interface{}) (Processor, error) {
+	field, err := configString(config, "field", true)
+	if err != nil {
+		return nil, err
+	}
+
+	value, exists := config["value"]
+	if !exists {
+		return nil, errors.New("value must be set")
+	}
+
+	return &DefaultProcessor{
+		field: field,
+		value: value,
+	}, nil
+}
+
+func (p *DefaultProcessor) Process(fields map[string]interface{}) error {
+	value, exists := getField(fields, p.field)
+	if exists && value != nil && value != "" {
+		return nil
+	}
+
+	return setField(fields, p.field, copyValue(p.value))
+}
+
+// DateProcessor parses a date with the first matching of formats, and stores it in RFC 3339 so that
+// it is indexed as a date. The formats are Go layouts, or RFC3339, UNIX and UNIX_MS.
+type DateProcessor struct {
+	*fieldProcessor
+	formats  []string
+	location *time.Location
+}
+
+func NewDateProcessor(config map[string]interface{}) (Processor, error) {
+	fp, err := newFieldProcessor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	formats, err := configStrings(config, "formats", true)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone, err := configString(config, "timezone", false)
+	if err != nil {
+		return nil, err
+	}
+	location := time.UTC
+	if timezone != "" {
+		location, err = time.LoadLocation(timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &DateProcessor{
+		fieldProcessor: fp,
+		formats:        formats,
+		location:       location,
+	}, nil
+}
+
+func (p *DateProcessor) Process(fields map[string]interface{}) error {
+	value, ok, err := p.value(fields)
+	if err != nil || !ok {
+		return err
+	}
+
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case float64:
+		str = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("field %s must be a string or a number", p.field)
+	}
+
+	for _, format := range p.formats {
+		t, err := parseDate(str, format, p.location)
+		if err == nil {
+			return setField(fields, p.targetField, t.Format(time.RFC3339Nano))
+		}
+	}
+
+	return fmt.Errorf("field %s does not match any of the formats: %s", p.field, str)
+}
+
+func parseDate(str string, format string, location *time.Location) (time.Time, error) {
+	switch format {
+	case "RFC3339":
+		return time.Parse(time.RFC3339Nano, str)
+	case "UNIX":
+		seconds, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(0, int64(seconds*float64(time.Second))).UTC(), nil
+	case "UNIX_MS":
+		millis, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(0, millis*int64(time.Millisecond)).UTC(), nil
+	default:
+		return time.ParseInLocation(format, str, location)
+	}
+}
+
+// LowercaseProcessor converts a string, or the strings of an array, to lower case.
+type LowercaseProcessor struct {
+	*fieldProcessor
+}
+
+func NewLowercaseProcessor(config map[string]interface{}) (Processor, error) {
+	fp, err := newFieldProcessor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LowercaseProcessor{fieldProcessor: fp}, nil
+}
+
+func (p *LowercaseProcessor) Process(fields map[string]interface{}) error {
+	return p.mapStrings(fields, func(str string) (interface{}, error) {
+		return strings.ToLower(str), nil
+	})
+}
+
+// TrimProcessor removes the leading and trailing white space of a string, or of the strings of an array.
+type TrimProcessor struct {
+	*fieldProcessor
+}
+
+func NewTrimProcessor(config map[string]interface{}) (Processor, error) {
+	fp, err := newFieldProcessor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrimProcessor{fieldProcessor: fp}, nil
+}
+
+func (p *TrimProcessor) Process(fields map[string]interface{}) error {
+	return p.mapStrings(fields, func(str string) (interface{}, error) {
+		return strings.TrimSpace(str), nil
+	})
+}
+
+// SplitProcessor splits a string into an array by a separator.
+type SplitProcessor struct {
+	*fieldProcessor
+	separator string
+}
+
+func NewSplitProcessor(config map[string]interface{}) (Processor, error) {
+	fp, err := newFieldProcessor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	separator, err := configString(config, "separator", true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SplitProcessor{
+		fieldProcessor: fp,
+		separator:      separator,
+	}, nil
+}
+
+func (p *SplitProcessor) Process(fields map[string]interface{}) error {
+	value, ok, err := p.value(fields)
+	if err != nil || !ok {
+		return err
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("field %s must be a string", p.field)
+	}
+
+	parts := strings.Split(str, p.separator)
+	values := make([]interface{}, 0, len(parts))
+	for _, part := range parts {
+		values = append(values, part)
+	}
+
+	return setField(fields, p.targetField, values)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/mosuka/blast/registry"
+)
+
+// MessageToAny encodes a message carried in a raft command as a standard google.protobuf.Any.
+func MessageToAny(message proto.Message) (*any.Any, error) {
+	return ptypes.MarshalAny(message)
+}
+
+// MessageFromAny decodes a message carried in a raft command from a google.protobuf.Any.
+// Messages encoded with the legacy JSON encoding of the registry, as in existing raft logs and snapshots,
+// are decoded as well.
+func MessageFromAny(message *any.Any, pb proto.Message) error {
+	if message == nil {
+		return errors.New("nil")
+	}
+
+	if ptypes.Is(message, pb) {
+		return ptypes.UnmarshalAny(message, pb)
+	}
+
+	if registry.TypeByName(message.TypeUrl) == nil {
+		return fmt.Errorf("unsupported message type: %s", message.TypeUrl)
+	}
+
+	instance, err := MarshalAny(message)
+	if err != nil {
+		return err
+	}
+	legacy, ok := instance.(proto.Message)
+	if !ok || proto.MessageName(legacy) != proto.MessageName(pb) {
+		return fmt.Errorf("unsupported message type: %s", message.TypeUrl)
+	}
+
+	pb.Reset()
+	proto.Merge(pb, legacy)
+
+	return nil
+}
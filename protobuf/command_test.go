@@ -0,0 +1,71 @@
+package protobuf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/mosuka/blast/protobuf/index"
+)
+
+func TestMessageToAny(t *testing.T) {
+	alias := &index.Alias{
+		Name:    "wiki",
+		Indexes: []string{"wiki_1", "wiki_2"},
+	}
+
+	aliasAny, err := MessageToAny(alias)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	expectedType := "type.googleapis.com/index.Alias"
+	actualType := aliasAny.TypeUrl
+	if expectedType != actualType {
+		t.Errorf("expected content to see %s, saw %s", expectedType, actualType)
+	}
+
+	actualAlias := &index.Alias{}
+	err = MessageFromAny(aliasAny, actualAlias)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if actualAlias.Name != "wiki" {
+		t.Errorf("expected content to see %v, saw %v", "wiki", actualAlias.Name)
+	}
+	if !reflect.DeepEqual(alias.Indexes, actualAlias.Indexes) {
+		t.Errorf("expected content to see %v, saw %v", alias.Indexes, actualAlias.Indexes)
+	}
+}
+
+func TestMessageFromAnyWithLegacyEncoding(t *testing.T) {
+	legacyAny := &any.Any{}
+	err := UnmarshalAny(&index.IndexInfo{Name: "wiki", IndexStorageType: "boltdb", DefaultTtl: 60}, legacyAny)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	indexInfo := &index.IndexInfo{}
+	err = MessageFromAny(legacyAny, indexInfo)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if indexInfo.Name != "wiki" || indexInfo.IndexStorageType != "boltdb" || indexInfo.DefaultTtl != 60 {
+		t.Errorf("expected content to see %v, saw %v", "wiki boltdb 60", indexInfo)
+	}
+}
+
+func TestMessageFromAnyWithOtherType(t *testing.T) {
+	cases := []*any.Any{
+		nil,
+		{TypeUrl: "unknown", Value: []byte(`{}`)},
+		{TypeUrl: "index.Alias", Value: []byte(`{"name":"wiki"}`)},
+	}
+
+	for _, c := range cases {
+		err := MessageFromAny(c, &index.IndexInfo{})
+		if err == nil {
+			t.Errorf("expected error for %v, saw nil", c)
+		}
+	}
+}
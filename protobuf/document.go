@@ -0,0 +1,93 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/mosuka/blast/protobuf/index"
+	"github.com/mosuka/blast/registry"
+)
+
+// DocumentToAny encodes a document as a standard google.protobuf.Any.
+func DocumentToAny(doc *index.Document) (*any.Any, error) {
+	return ptypes.MarshalAny(doc)
+}
+
+// DocumentFromAny decodes a document from a google.protobuf.Any.
+// Documents encoded with the legacy JSON encoding are converted as well.
+func DocumentFromAny(message *any.Any) (*index.Document, error) {
+	if message == nil {
+		return nil, nil
+	}
+
+	doc := &index.Document{}
+	if ptypes.Is(message, doc) {
+		err := ptypes.UnmarshalAny(message, doc)
+		if err != nil {
+			return nil, err
+		}
+		return doc, nil
+	}
+
+	if registry.TypeByName(message.TypeUrl) == nil {
+		return nil, fmt.Errorf("unsupported document type: %s", message.TypeUrl)
+	}
+
+	instance, err := MarshalAny(message)
+	if err != nil {
+		return nil, err
+	}
+	legacyDoc, ok := instance.(*index.LegacyDocument)
+	if !ok {
+		return nil, fmt.Errorf("unsupported document type: %s", message.TypeUrl)
+	}
+
+	return DocumentFromLegacy(legacyDoc)
+}
+
+// DocumentFromLegacy converts a document whose fields are JSON encoded in an Any to a document.
+func DocumentFromLegacy(legacyDoc *index.LegacyDocument) (*index.Document, error) {
+	doc := &index.Document{
+		Id:    legacyDoc.Id,
+		Index: legacyDoc.Index,
+	}
+
+	if legacyDoc.Fields == nil || legacyDoc.Fields.TypeUrl == "" {
+		return doc, nil
+	}
+	if registry.TypeByName(legacyDoc.Fields.TypeUrl) == nil {
+		return nil, fmt.Errorf("unsupported fields type: %s", legacyDoc.Fields.TypeUrl)
+	}
+
+	fieldsInstance, err := MarshalAny(legacyDoc.Fields)
+	if err != nil {
+		return nil, err
+	}
+	fieldsMap, ok := fieldsInstance.(*map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unsupported fields type: %s", legacyDoc.Fields.TypeUrl)
+	}
+
+	fields, err := ToStruct(*fieldsMap)
+	if err != nil {
+		return nil, err
+	}
+	doc.Fields = fields
+
+	return doc, nil
+}
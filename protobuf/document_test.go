@@ -0,0 +1,84 @@
+package protobuf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/mosuka/blast/protobuf/index"
+)
+
+func TestDocumentToAny(t *testing.T) {
+	fields, err := ToStruct(map[string]interface{}{"f1": "aaa", "f2": 222, "f3": []interface{}{"c", "c", "c"}})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	doc := &index.Document{
+		Id:     "1",
+		Index:  "wiki",
+		Fields: fields,
+	}
+
+	docAny, err := DocumentToAny(doc)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	expectedType := "type.googleapis.com/index.Document"
+	actualType := docAny.TypeUrl
+	if expectedType != actualType {
+		t.Errorf("expected content to see %s, saw %s", expectedType, actualType)
+	}
+
+	actualDoc, err := DocumentFromAny(docAny)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if actualDoc.Id != "1" {
+		t.Errorf("expected content to see %v, saw %v", "1", actualDoc.Id)
+	}
+	if actualDoc.Index != "wiki" {
+		t.Errorf("expected content to see %v, saw %v", "wiki", actualDoc.Index)
+	}
+
+	expectedFields := map[string]interface{}{"f1": "aaa", "f2": float64(222), "f3": []interface{}{"c", "c", "c"}}
+	actualFields, err := FromStruct(actualDoc.Fields)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if !reflect.DeepEqual(expectedFields, actualFields) {
+		t.Errorf("expected content to see %v, saw %v", expectedFields, actualFields)
+	}
+}
+
+func TestDocumentFromAnyWithLegacyEncoding(t *testing.T) {
+	docAny := &any.Any{
+		TypeUrl: "index.Document",
+		Value:   []byte(`{"id":"1","fields":{"type_url":"map[string]interface {}","value":"eyJmMSI6ImFhYSIsImYyIjoyMjIsImYzIjoiY2NjIn0="}}`),
+	}
+
+	doc, err := DocumentFromAny(docAny)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if doc.Id != "1" {
+		t.Errorf("expected content to see %v, saw %v", "1", doc.Id)
+	}
+
+	expectedFields := map[string]interface{}{"f1": "aaa", "f2": float64(222), "f3": "ccc"}
+	actualFields, err := FromStruct(doc.Fields)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if !reflect.DeepEqual(expectedFields, actualFields) {
+		t.Errorf("expected content to see %v, saw %v", expectedFields, actualFields)
+	}
+}
+
+func TestDocumentFromAnyWithUnknownType(t *testing.T) {
+	_, err := DocumentFromAny(&any.Any{TypeUrl: "unknown", Value: []byte(`{}`)})
+	if err == nil {
+		t.Errorf("expected error, saw nil")
+	}
+}
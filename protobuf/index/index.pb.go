@@ -8,7 +8,11 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	any "github.com/golang/protobuf/ptypes/any"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	raft "github.com/mosuka/blast/protobuf/raft"
 	grpc "google.golang.org/grpc"
 	math "math"
@@ -25,30 +29,287 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Document_OpType int32
+
+const (
+	Document_UPSERT Document_OpType = 0
+	Document_CREATE Document_OpType = 1
+	Document_UPDATE Document_OpType = 2
+)
+
+var Document_OpType_name = map[int32]string{
+	0: "UPSERT",
+	1: "CREATE",
+	2: "UPDATE",
+}
+
+var Document_OpType_value = map[string]int32{
+	"UPSERT": 0,
+	"CREATE": 1,
+	"UPDATE": 2,
+}
+
+func (x Document_OpType) String() string {
+	return proto.EnumName(Document_OpType_name, int32(x))
+}
+
+func (Document_OpType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{0, 0}
+}
+
+type BulkItem_Action int32
+
+const (
+	BulkItem_INDEX  BulkItem_Action = 0
+	BulkItem_DELETE BulkItem_Action = 1
+	BulkItem_UPDATE BulkItem_Action = 2
+)
+
+var BulkItem_Action_name = map[int32]string{
+	0: "INDEX",
+	1: "DELETE",
+	2: "UPDATE",
+}
+
+var BulkItem_Action_value = map[string]int32{
+	"INDEX":  0,
+	"DELETE": 1,
+	"UPDATE": 2,
+}
+
+func (x BulkItem_Action) String() string {
+	return proto.EnumName(BulkItem_Action_name, int32(x))
+}
+
+func (BulkItem_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{5, 0}
+}
+
+type ChangeEvent_Type int32
+
+const (
+	ChangeEvent_INDEX  ChangeEvent_Type = 0
+	ChangeEvent_UPDATE ChangeEvent_Type = 1
+	ChangeEvent_DELETE ChangeEvent_Type = 2
+)
+
+var ChangeEvent_Type_name = map[int32]string{
+	0: "INDEX",
+	1: "UPDATE",
+	2: "DELETE",
+}
+
+var ChangeEvent_Type_value = map[string]int32{
+	"INDEX":  0,
+	"UPDATE": 1,
+	"DELETE": 2,
+}
+
+func (x ChangeEvent_Type) String() string {
+	return proto.EnumName(ChangeEvent_Type_name, int32(x))
+}
+
+func (ChangeEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{19, 0}
+}
+
+type MatchQuery_Operator int32
+
+const (
+	MatchQuery_OR  MatchQuery_Operator = 0
+	MatchQuery_AND MatchQuery_Operator = 1
+)
+
+var MatchQuery_Operator_name = map[int32]string{
+	0: "OR",
+	1: "AND",
+}
+
+var MatchQuery_Operator_value = map[string]int32{
+	"OR":  0,
+	"AND": 1,
+}
+
+func (x MatchQuery_Operator) String() string {
+	return proto.EnumName(MatchQuery_Operator_name, int32(x))
+}
+
+func (MatchQuery_Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{44, 0}
+}
+
+type SortField_Type int32
+
+const (
+	SortField_AUTO   SortField_Type = 0
+	SortField_STRING SortField_Type = 1
+	SortField_NUMBER SortField_Type = 2
+	SortField_DATE   SortField_Type = 3
+)
+
+var SortField_Type_name = map[int32]string{
+	0: "AUTO",
+	1: "STRING",
+	2: "NUMBER",
+	3: "DATE",
+}
+
+var SortField_Type_value = map[string]int32{
+	"AUTO":   0,
+	"STRING": 1,
+	"NUMBER": 2,
+	"DATE":   3,
+}
+
+func (x SortField_Type) String() string {
+	return proto.EnumName(SortField_Type_name, int32(x))
+}
+
+func (SortField_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{65, 0}
+}
+
+type SortField_Mode int32
+
+const (
+	SortField_DEFAULT SortField_Mode = 0
+	SortField_MIN     SortField_Mode = 1
+	SortField_MAX     SortField_Mode = 2
+)
+
+var SortField_Mode_name = map[int32]string{
+	0: "DEFAULT",
+	1: "MIN",
+	2: "MAX",
+}
+
+var SortField_Mode_value = map[string]int32{
+	"DEFAULT": 0,
+	"MIN":     1,
+	"MAX":     2,
+}
+
+func (x SortField_Mode) String() string {
+	return proto.EnumName(SortField_Mode_name, int32(x))
+}
+
+func (SortField_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{65, 1}
+}
+
+type SortField_Missing int32
+
+const (
+	SortField_LAST  SortField_Missing = 0
+	SortField_FIRST SortField_Missing = 1
+)
+
+var SortField_Missing_name = map[int32]string{
+	0: "LAST",
+	1: "FIRST",
+}
+
+var SortField_Missing_value = map[string]int32{
+	"LAST":  0,
+	"FIRST": 1,
+}
+
+func (x SortField_Missing) String() string {
+	return proto.EnumName(SortField_Missing_name, int32(x))
+}
+
+func (SortField_Missing) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{65, 2}
+}
+
+type ReindexStatus_State int32
+
+const (
+	ReindexStatus_IDLE      ReindexStatus_State = 0
+	ReindexStatus_RUNNING   ReindexStatus_State = 1
+	ReindexStatus_COMPLETED ReindexStatus_State = 2
+	ReindexStatus_FAILED    ReindexStatus_State = 3
+	ReindexStatus_CANCELED  ReindexStatus_State = 4
+)
+
+var ReindexStatus_State_name = map[int32]string{
+	0: "IDLE",
+	1: "RUNNING",
+	2: "COMPLETED",
+	3: "FAILED",
+	4: "CANCELED",
+}
+
+var ReindexStatus_State_value = map[string]int32{
+	"IDLE":      0,
+	"RUNNING":   1,
+	"COMPLETED": 2,
+	"FAILED":    3,
+	"CANCELED":  4,
+}
+
+func (x ReindexStatus_State) String() string {
+	return proto.EnumName(ReindexStatus_State_name, int32(x))
+}
+
+func (ReindexStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{78, 0}
+}
+
 type IndexCommand_Type int32
 
 const (
-	IndexCommand_UNKNOWN_COMMAND IndexCommand_Type = 0
-	IndexCommand_SET_METADATA    IndexCommand_Type = 1
-	IndexCommand_DELETE_METADATA IndexCommand_Type = 2
-	IndexCommand_INDEX_DOCUMENT  IndexCommand_Type = 3
-	IndexCommand_DELETE_DOCUMENT IndexCommand_Type = 4
+	IndexCommand_UNKNOWN_COMMAND         IndexCommand_Type = 0
+	IndexCommand_SET_METADATA            IndexCommand_Type = 1
+	IndexCommand_DELETE_METADATA         IndexCommand_Type = 2
+	IndexCommand_INDEX_DOCUMENT          IndexCommand_Type = 3
+	IndexCommand_DELETE_DOCUMENT         IndexCommand_Type = 4
+	IndexCommand_PUT_INDEX_MAPPING       IndexCommand_Type = 5
+	IndexCommand_CREATE_INDEX            IndexCommand_Type = 6
+	IndexCommand_DELETE_INDEX            IndexCommand_Type = 7
+	IndexCommand_PUT_ALIAS               IndexCommand_Type = 8
+	IndexCommand_DELETE_ALIAS            IndexCommand_Type = 9
+	IndexCommand_SWAP_ALIAS              IndexCommand_Type = 10
+	IndexCommand_UPDATE_DOCUMENT         IndexCommand_Type = 11
+	IndexCommand_PUT_PERCOLATOR_QUERY    IndexCommand_Type = 12
+	IndexCommand_DELETE_PERCOLATOR_QUERY IndexCommand_Type = 13
+	IndexCommand_SET_REJECTED_CHANGES    IndexCommand_Type = 14
 )
 
 var IndexCommand_Type_name = map[int32]string{
-	0: "UNKNOWN_COMMAND",
-	1: "SET_METADATA",
-	2: "DELETE_METADATA",
-	3: "INDEX_DOCUMENT",
-	4: "DELETE_DOCUMENT",
+	0:  "UNKNOWN_COMMAND",
+	1:  "SET_METADATA",
+	2:  "DELETE_METADATA",
+	3:  "INDEX_DOCUMENT",
+	4:  "DELETE_DOCUMENT",
+	5:  "PUT_INDEX_MAPPING",
+	6:  "CREATE_INDEX",
+	7:  "DELETE_INDEX",
+	8:  "PUT_ALIAS",
+	9:  "DELETE_ALIAS",
+	10: "SWAP_ALIAS",
+	11: "UPDATE_DOCUMENT",
+	12: "PUT_PERCOLATOR_QUERY",
+	13: "DELETE_PERCOLATOR_QUERY",
+	14: "SET_REJECTED_CHANGES",
 }
 
 var IndexCommand_Type_value = map[string]int32{
-	"UNKNOWN_COMMAND": 0,
-	"SET_METADATA":    1,
-	"DELETE_METADATA": 2,
-	"INDEX_DOCUMENT":  3,
-	"DELETE_DOCUMENT": 4,
+	"UNKNOWN_COMMAND":         0,
+	"SET_METADATA":            1,
+	"DELETE_METADATA":         2,
+	"INDEX_DOCUMENT":          3,
+	"DELETE_DOCUMENT":         4,
+	"PUT_INDEX_MAPPING":       5,
+	"CREATE_INDEX":            6,
+	"DELETE_INDEX":            7,
+	"PUT_ALIAS":               8,
+	"DELETE_ALIAS":            9,
+	"SWAP_ALIAS":              10,
+	"UPDATE_DOCUMENT":         11,
+	"PUT_PERCOLATOR_QUERY":    12,
+	"DELETE_PERCOLATOR_QUERY": 13,
+	"SET_REJECTED_CHANGES":    14,
 }
 
 func (x IndexCommand_Type) String() string {
@@ -56,15 +317,27 @@ func (x IndexCommand_Type) String() string {
 }
 
 func (IndexCommand_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7b2daf652facb3ae, []int{5, 0}
+	return fileDescriptor_7b2daf652facb3ae, []int{79, 0}
 }
 
 type Document struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Fields               *any.Any `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// generated by the leader if empty when the document is indexed
+	Id     string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index  string          `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	Fields *_struct.Struct `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	// publish the ids of the percolator queries matching the document when it is written
+	Percolate bool `protobuf:"varint,5,opt,name=percolate,proto3" json:"percolate,omitempty"`
+	// the time the document expires at in seconds since the epoch, never if 0, ignored on a delete
+	ExpireAt int64 `protobuf:"varint,6,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// the seconds the document expires in, resolved into expire_at by the leader
+	Ttl int64 `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// the ingest pipeline the leader transforms the fields with before the document is indexed
+	Pipeline string `protobuf:"bytes,8,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// create fails if the document exists and update if it does not, checked when the command is applied
+	OpType               Document_OpType `protobuf:"varint,9,opt,name=op_type,json=opType,proto3,enum=index.Document_OpType" json:"op_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Document) Reset()         { *m = Document{} }
@@ -99,650 +372,7589 @@ func (m *Document) GetId() string {
 	return ""
 }
 
-func (m *Document) GetFields() *any.Any {
+func (m *Document) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Document) GetFields() *_struct.Struct {
 	if m != nil {
 		return m.Fields
 	}
 	return nil
 }
 
-type UpdateResult struct {
-	Count                int32    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Document) GetPercolate() bool {
+	if m != nil {
+		return m.Percolate
+	}
+	return false
 }
 
-func (m *UpdateResult) Reset()         { *m = UpdateResult{} }
-func (m *UpdateResult) String() string { return proto.CompactTextString(m) }
-func (*UpdateResult) ProtoMessage()    {}
-func (*UpdateResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b2daf652facb3ae, []int{1}
+func (m *Document) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
 }
 
-func (m *UpdateResult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateResult.Unmarshal(m, b)
-}
-func (m *UpdateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateResult.Marshal(b, m, deterministic)
-}
-func (m *UpdateResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateResult.Merge(m, src)
-}
-func (m *UpdateResult) XXX_Size() int {
-	return xxx_messageInfo_UpdateResult.Size(m)
-}
-func (m *UpdateResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateResult.DiscardUnknown(m)
+func (m *Document) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
 }
 
-var xxx_messageInfo_UpdateResult proto.InternalMessageInfo
+func (m *Document) GetPipeline() string {
+	if m != nil {
+		return m.Pipeline
+	}
+	return ""
+}
 
-func (m *UpdateResult) GetCount() int32 {
+func (m *Document) GetOpType() Document_OpType {
 	if m != nil {
-		return m.Count
+		return m.OpType
 	}
-	return 0
+	return Document_UPSERT
 }
 
-type Stats struct {
-	Stats                *any.Any `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+// LegacyDocument is the document encoding used before fields were carried as a Struct.
+// It is only used to read existing raft logs and snapshots.
+type LegacyDocument struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields               *any.Any `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	Index                string   `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Stats) Reset()         { *m = Stats{} }
-func (m *Stats) String() string { return proto.CompactTextString(m) }
-func (*Stats) ProtoMessage()    {}
-func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b2daf652facb3ae, []int{2}
+func (m *LegacyDocument) Reset()         { *m = LegacyDocument{} }
+func (m *LegacyDocument) String() string { return proto.CompactTextString(m) }
+func (*LegacyDocument) ProtoMessage()    {}
+func (*LegacyDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{1}
 }
 
-func (m *Stats) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Stats.Unmarshal(m, b)
+func (m *LegacyDocument) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LegacyDocument.Unmarshal(m, b)
 }
-func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+func (m *LegacyDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LegacyDocument.Marshal(b, m, deterministic)
 }
-func (m *Stats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Stats.Merge(m, src)
+func (m *LegacyDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyDocument.Merge(m, src)
 }
-func (m *Stats) XXX_Size() int {
-	return xxx_messageInfo_Stats.Size(m)
+func (m *LegacyDocument) XXX_Size() int {
+	return xxx_messageInfo_LegacyDocument.Size(m)
 }
-func (m *Stats) XXX_DiscardUnknown() {
-	xxx_messageInfo_Stats.DiscardUnknown(m)
+func (m *LegacyDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyDocument.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Stats proto.InternalMessageInfo
+var xxx_messageInfo_LegacyDocument proto.InternalMessageInfo
 
-func (m *Stats) GetStats() *any.Any {
+func (m *LegacyDocument) GetId() string {
 	if m != nil {
-		return m.Stats
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LegacyDocument) GetFields() *any.Any {
+	if m != nil {
+		return m.Fields
 	}
 	return nil
 }
 
-type SearchRequest struct {
-	SearchRequest        *any.Any `protobuf:"bytes,1,opt,name=search_request,json=searchRequest,proto3" json:"search_request,omitempty"`
+func (m *LegacyDocument) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type MultiGetRequest struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Ids                  []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	SourceIncludes       []string `protobuf:"bytes,3,rep,name=source_includes,json=sourceIncludes,proto3" json:"source_includes,omitempty"`
+	SourceExcludes       []string `protobuf:"bytes,4,rep,name=source_excludes,json=sourceExcludes,proto3" json:"source_excludes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
-func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
-func (*SearchRequest) ProtoMessage()    {}
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b2daf652facb3ae, []int{3}
+func (m *MultiGetRequest) Reset()         { *m = MultiGetRequest{} }
+func (m *MultiGetRequest) String() string { return proto.CompactTextString(m) }
+func (*MultiGetRequest) ProtoMessage()    {}
+func (*MultiGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{2}
 }
 
-func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+func (m *MultiGetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiGetRequest.Unmarshal(m, b)
 }
-func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+func (m *MultiGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiGetRequest.Marshal(b, m, deterministic)
 }
-func (m *SearchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchRequest.Merge(m, src)
+func (m *MultiGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiGetRequest.Merge(m, src)
 }
-func (m *SearchRequest) XXX_Size() int {
-	return xxx_messageInfo_SearchRequest.Size(m)
+func (m *MultiGetRequest) XXX_Size() int {
+	return xxx_messageInfo_MultiGetRequest.Size(m)
 }
-func (m *SearchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+func (m *MultiGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiGetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+var xxx_messageInfo_MultiGetRequest proto.InternalMessageInfo
 
-func (m *SearchRequest) GetSearchRequest() *any.Any {
+func (m *MultiGetRequest) GetIndex() string {
 	if m != nil {
-		return m.SearchRequest
+		return m.Index
+	}
+	return ""
+}
+
+func (m *MultiGetRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
 	}
 	return nil
 }
 
-type SearchResponse struct {
-	SearchResult         *any.Any `protobuf:"bytes,1,opt,name=search_result,json=searchResult,proto3" json:"search_result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MultiGetRequest) GetSourceIncludes() []string {
+	if m != nil {
+		return m.SourceIncludes
+	}
+	return nil
 }
 
-func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
-func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
-func (*SearchResponse) ProtoMessage()    {}
-func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b2daf652facb3ae, []int{4}
+func (m *MultiGetRequest) GetSourceExcludes() []string {
+	if m != nil {
+		return m.SourceExcludes
+	}
+	return nil
 }
 
-func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchResponse.Unmarshal(m, b)
+type MultiGetResponse struct {
+	Documents            []*Document `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	MissingIds           []string    `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+
+func (m *MultiGetResponse) Reset()         { *m = MultiGetResponse{} }
+func (m *MultiGetResponse) String() string { return proto.CompactTextString(m) }
+func (*MultiGetResponse) ProtoMessage()    {}
+func (*MultiGetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{3}
 }
-func (m *SearchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResponse.Merge(m, src)
+
+func (m *MultiGetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiGetResponse.Unmarshal(m, b)
 }
-func (m *SearchResponse) XXX_Size() int {
-	return xxx_messageInfo_SearchResponse.Size(m)
+func (m *MultiGetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiGetResponse.Marshal(b, m, deterministic)
 }
-func (m *SearchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+func (m *MultiGetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiGetResponse.Merge(m, src)
+}
+func (m *MultiGetResponse) XXX_Size() int {
+	return xxx_messageInfo_MultiGetResponse.Size(m)
+}
+func (m *MultiGetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiGetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+var xxx_messageInfo_MultiGetResponse proto.InternalMessageInfo
 
-func (m *SearchResponse) GetSearchResult() *any.Any {
+func (m *MultiGetResponse) GetDocuments() []*Document {
 	if m != nil {
-		return m.SearchResult
+		return m.Documents
 	}
 	return nil
 }
 
-type IndexCommand struct {
-	Type                 IndexCommand_Type `protobuf:"varint,1,opt,name=type,proto3,enum=index.IndexCommand_Type" json:"type,omitempty"`
-	Data                 *any.Any          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (m *MultiGetResponse) GetMissingIds() []string {
+	if m != nil {
+		return m.MissingIds
+	}
+	return nil
+}
+
+type UpdateResult struct {
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// the result of every indexed document, which is not written if its op type conflicts
+	Results              []*BulkItemResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *IndexCommand) Reset()         { *m = IndexCommand{} }
-func (m *IndexCommand) String() string { return proto.CompactTextString(m) }
-func (*IndexCommand) ProtoMessage()    {}
-func (*IndexCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b2daf652facb3ae, []int{5}
+func (m *UpdateResult) Reset()         { *m = UpdateResult{} }
+func (m *UpdateResult) String() string { return proto.CompactTextString(m) }
+func (*UpdateResult) ProtoMessage()    {}
+func (*UpdateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{4}
 }
 
-func (m *IndexCommand) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexCommand.Unmarshal(m, b)
+func (m *UpdateResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateResult.Unmarshal(m, b)
 }
-func (m *IndexCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexCommand.Marshal(b, m, deterministic)
+func (m *UpdateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateResult.Marshal(b, m, deterministic)
 }
-func (m *IndexCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexCommand.Merge(m, src)
+func (m *UpdateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResult.Merge(m, src)
 }
-func (m *IndexCommand) XXX_Size() int {
-	return xxx_messageInfo_IndexCommand.Size(m)
+func (m *UpdateResult) XXX_Size() int {
+	return xxx_messageInfo_UpdateResult.Size(m)
 }
-func (m *IndexCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexCommand.DiscardUnknown(m)
+func (m *UpdateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexCommand proto.InternalMessageInfo
+var xxx_messageInfo_UpdateResult proto.InternalMessageInfo
 
-func (m *IndexCommand) GetType() IndexCommand_Type {
+func (m *UpdateResult) GetCount() int32 {
 	if m != nil {
-		return m.Type
+		return m.Count
 	}
-	return IndexCommand_UNKNOWN_COMMAND
+	return 0
 }
 
-func (m *IndexCommand) GetData() *any.Any {
+func (m *UpdateResult) GetResults() []*BulkItemResult {
 	if m != nil {
-		return m.Data
+		return m.Results
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("index.IndexCommand_Type", IndexCommand_Type_name, IndexCommand_Type_value)
-	proto.RegisterType((*Document)(nil), "index.Document")
-	proto.RegisterType((*UpdateResult)(nil), "index.UpdateResult")
-	proto.RegisterType((*Stats)(nil), "index.Stats")
-	proto.RegisterType((*SearchRequest)(nil), "index.SearchRequest")
-	proto.RegisterType((*SearchResponse)(nil), "index.SearchResponse")
-	proto.RegisterType((*IndexCommand)(nil), "index.IndexCommand")
+type BulkItem struct {
+	Action               BulkItem_Action `protobuf:"varint,1,opt,name=action,proto3,enum=index.BulkItem_Action" json:"action,omitempty"`
+	Document             *Document       `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func init() { proto.RegisterFile("protobuf/index/index.proto", fileDescriptor_7b2daf652facb3ae) }
+func (m *BulkItem) Reset()         { *m = BulkItem{} }
+func (m *BulkItem) String() string { return proto.CompactTextString(m) }
+func (*BulkItem) ProtoMessage()    {}
+func (*BulkItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{5}
+}
 
-var fileDescriptor_7b2daf652facb3ae = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x51, 0x6f, 0x12, 0x41,
-	0x10, 0xc7, 0x0f, 0xca, 0x61, 0x1d, 0x81, 0x92, 0x6d, 0x35, 0x78, 0xbe, 0x34, 0x1b, 0x63, 0xd0,
-	0xe8, 0x11, 0x69, 0x8c, 0x31, 0xfa, 0x82, 0xdc, 0x05, 0x6b, 0xcb, 0x35, 0x01, 0x1a, 0x8d, 0x2f,
-	0x64, 0xe1, 0xa6, 0xed, 0xa5, 0x77, 0xb7, 0x27, 0xbb, 0x67, 0xe4, 0x23, 0xf8, 0xf9, 0xfc, 0x42,
-	0xe6, 0x76, 0x39, 0xa4, 0x6d, 0x8e, 0xe8, 0xcb, 0x86, 0xfd, 0xcf, 0x6f, 0xfe, 0x0c, 0xb3, 0x33,
-	0x80, 0x95, 0x2c, 0xb8, 0xe4, 0xb3, 0xf4, 0xa2, 0x13, 0xc4, 0x3e, 0xfe, 0xd4, 0xa7, 0xad, 0x44,
-	0x62, 0xaa, 0x8b, 0xf5, 0xf8, 0x92, 0xf3, 0xcb, 0x10, 0x3b, 0x6b, 0x92, 0xc5, 0x4b, 0x4d, 0x58,
-	0x4f, 0x6e, 0x87, 0x30, 0x4a, 0x64, 0x1e, 0x6c, 0xad, 0xd5, 0x05, 0xbb, 0x90, 0xea, 0xd0, 0x11,
-	0xfa, 0x09, 0x76, 0x1d, 0x3e, 0x4f, 0x23, 0x8c, 0x25, 0x69, 0x40, 0x39, 0xf0, 0x5b, 0xa5, 0xc3,
-	0x52, 0xfb, 0xfe, 0xa8, 0x1c, 0xf8, 0xe4, 0x25, 0x54, 0x2f, 0x02, 0x0c, 0x7d, 0xd1, 0x2a, 0x1f,
-	0x96, 0xda, 0x0f, 0xba, 0x07, 0xb6, 0xfe, 0x0e, 0x3b, 0x77, 0xb3, 0x7b, 0xf1, 0x72, 0xb4, 0x62,
-	0xe8, 0x53, 0xa8, 0x9d, 0x27, 0x3e, 0x93, 0x38, 0x42, 0x91, 0x86, 0x92, 0x1c, 0x80, 0x39, 0xe7,
-	0x69, 0x2c, 0x95, 0xa1, 0x39, 0xd2, 0x17, 0x7a, 0x04, 0xe6, 0x58, 0x32, 0x29, 0xc8, 0x0b, 0x30,
-	0x45, 0xf6, 0x41, 0x85, 0x8b, 0xbc, 0x35, 0x42, 0x4f, 0xa1, 0x3e, 0x46, 0xb6, 0x98, 0x5f, 0x8d,
-	0xf0, 0x7b, 0x8a, 0x42, 0x92, 0xf7, 0xd0, 0x10, 0x4a, 0x98, 0x2e, 0xb4, 0xb2, 0xd5, 0xa5, 0x2e,
-	0x36, 0x93, 0xe9, 0x09, 0x34, 0x72, 0x37, 0x91, 0xf0, 0x58, 0x20, 0x79, 0x07, 0xf5, 0xb5, 0x5d,
-	0x56, 0xfb, 0x56, 0xb7, 0x5a, 0xee, 0x96, 0x91, 0xf4, 0x77, 0x09, 0x6a, 0xc7, 0xd9, 0xdb, 0xf4,
-	0x79, 0x14, 0xb1, 0x38, 0x6b, 0x5a, 0x45, 0x2e, 0x13, 0x54, 0x16, 0x8d, 0x6e, 0xcb, 0xd6, 0xaf,
-	0xb8, 0x89, 0xd8, 0x93, 0x65, 0x82, 0x23, 0x45, 0x91, 0x36, 0x54, 0x7c, 0x26, 0xd9, 0xd6, 0x06,
-	0x2b, 0x82, 0x5e, 0x43, 0x25, 0xcb, 0x23, 0xfb, 0xb0, 0x77, 0xee, 0x9d, 0x78, 0x67, 0x5f, 0xbc,
-	0x69, 0xff, 0x6c, 0x38, 0xec, 0x79, 0x4e, 0xd3, 0x20, 0x4d, 0xa8, 0x8d, 0xdd, 0xc9, 0x74, 0xe8,
-	0x4e, 0x7a, 0x4e, 0x6f, 0xd2, 0x6b, 0x96, 0x32, 0xcc, 0x71, 0x4f, 0xdd, 0x89, 0xfb, 0x57, 0x2c,
-	0x13, 0x02, 0x8d, 0x63, 0xcf, 0x71, 0xbf, 0x4e, 0x9d, 0xb3, 0xfe, 0xf9, 0xd0, 0xf5, 0x26, 0xcd,
-	0x9d, 0x0d, 0x70, 0x2d, 0x56, 0xba, 0xbf, 0x2a, 0x60, 0xaa, 0x92, 0xb3, 0x9f, 0xf3, 0x99, 0x07,
-	0x31, 0x01, 0x5b, 0x0d, 0x8d, 0xc7, 0x7d, 0xb4, 0x1e, 0xdd, 0x29, 0xd3, 0xcd, 0x66, 0x8d, 0x1a,
-	0xe4, 0x15, 0x98, 0xa7, 0xc8, 0x7e, 0xe0, 0x3f, 0xe2, 0x1d, 0xb8, 0x37, 0x40, 0x99, 0x41, 0xa4,
-	0x00, 0xb2, 0x36, 0x8c, 0xa8, 0x41, 0xde, 0x00, 0x0c, 0x50, 0xf6, 0xc3, 0x54, 0x48, 0x5c, 0x14,
-	0xe6, 0xd4, 0x75, 0xce, 0x0a, 0xa3, 0x06, 0xf9, 0x00, 0xbb, 0xe3, 0x98, 0x25, 0xe2, 0x8a, 0xcb,
-	0xc2, 0xa4, 0xe2, 0x2a, 0x9f, 0xc3, 0xce, 0x00, 0x25, 0xd9, 0x5b, 0x3d, 0x65, 0xbe, 0x2e, 0xd6,
-	0x6d, 0x81, 0x1a, 0xe4, 0x75, 0xde, 0xb6, 0x3b, 0xf0, 0xfe, 0x4a, 0xd8, 0x5c, 0x11, 0x6a, 0xb4,
-	0x4b, 0xa4, 0x0b, 0x55, 0x07, 0x43, 0x94, 0xf8, 0x1f, 0x39, 0x6f, 0xa1, 0xaa, 0x27, 0x98, 0x1c,
-	0xac, 0x90, 0x1b, 0xeb, 0x61, 0x3d, 0xbc, 0xa5, 0xea, 0x31, 0xa7, 0x06, 0xe9, 0xc2, 0xee, 0x00,
-	0xa5, 0x5e, 0xc0, 0xa2, 0x46, 0xd4, 0xf2, 0x64, 0xb5, 0x7a, 0xc6, 0xc7, 0xf6, 0xb7, 0x67, 0x97,
-	0x81, 0xbc, 0x4a, 0x67, 0xf6, 0x9c, 0x47, 0x9d, 0x88, 0x8b, 0xf4, 0x9a, 0x75, 0x66, 0x21, 0x13,
-	0xb2, 0x73, 0xf3, 0x0f, 0x6b, 0x56, 0x55, 0xf7, 0xa3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d,
-	0xc7, 0x8d, 0x5b, 0xc9, 0x04, 0x00, 0x00,
+func (m *BulkItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkItem.Unmarshal(m, b)
+}
+func (m *BulkItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkItem.Marshal(b, m, deterministic)
+}
+func (m *BulkItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkItem.Merge(m, src)
+}
+func (m *BulkItem) XXX_Size() int {
+	return xxx_messageInfo_BulkItem.Size(m)
+}
+func (m *BulkItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkItem.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_BulkItem proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *BulkItem) GetAction() BulkItem_Action {
+	if m != nil {
+		return m.Action
+	}
+	return BulkItem_INDEX
+}
 
-// IndexClient is the client API for Index service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type IndexClient interface {
-	Join(ctx context.Context, in *raft.Node, opts ...grpc.CallOption) (*empty.Empty, error)
-	Leave(ctx context.Context, in *raft.Node, opts ...grpc.CallOption) (*empty.Empty, error)
-	GetNode(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*raft.Node, error)
-	GetCluster(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*raft.Cluster, error)
-	Snapshot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
-	Get(ctx context.Context, in *Document, opts ...grpc.CallOption) (*Document, error)
-	Index(ctx context.Context, opts ...grpc.CallOption) (Index_IndexClient, error)
-	Delete(ctx context.Context, opts ...grpc.CallOption) (Index_DeleteClient, error)
-	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
-	GetStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Stats, error)
+func (m *BulkItem) GetDocument() *Document {
+	if m != nil {
+		return m.Document
+	}
+	return nil
 }
 
-type indexClient struct {
-	cc *grpc.ClientConn
+type BulkRequest struct {
+	Items                []*BulkItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func NewIndexClient(cc *grpc.ClientConn) IndexClient {
-	return &indexClient{cc}
+func (m *BulkRequest) Reset()         { *m = BulkRequest{} }
+func (m *BulkRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkRequest) ProtoMessage()    {}
+func (*BulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{6}
 }
 
-func (c *indexClient) Join(ctx context.Context, in *raft.Node, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/index.Index/Join", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BulkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkRequest.Unmarshal(m, b)
+}
+func (m *BulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkRequest.Marshal(b, m, deterministic)
+}
+func (m *BulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkRequest.Merge(m, src)
+}
+func (m *BulkRequest) XXX_Size() int {
+	return xxx_messageInfo_BulkRequest.Size(m)
+}
+func (m *BulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkRequest proto.InternalMessageInfo
+
+func (m *BulkRequest) GetItems() []*BulkItem {
+	if m != nil {
+		return m.Items
 	}
-	return out, nil
+	return nil
 }
 
-func (c *indexClient) Leave(ctx context.Context, in *raft.Node, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/index.Index/Leave", in, out, opts...)
-	if err != nil {
-		return nil, err
+type BulkItemResult struct {
+	Action BulkItem_Action `protobuf:"varint,1,opt,name=action,proto3,enum=index.BulkItem_Action" json:"action,omitempty"`
+	Id     string          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Index  string          `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	// gRPC status code of the item
+	Code                 int32    `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkItemResult) Reset()         { *m = BulkItemResult{} }
+func (m *BulkItemResult) String() string { return proto.CompactTextString(m) }
+func (*BulkItemResult) ProtoMessage()    {}
+func (*BulkItemResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{7}
+}
+
+func (m *BulkItemResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkItemResult.Unmarshal(m, b)
+}
+func (m *BulkItemResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkItemResult.Marshal(b, m, deterministic)
+}
+func (m *BulkItemResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkItemResult.Merge(m, src)
+}
+func (m *BulkItemResult) XXX_Size() int {
+	return xxx_messageInfo_BulkItemResult.Size(m)
+}
+func (m *BulkItemResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkItemResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkItemResult proto.InternalMessageInfo
+
+func (m *BulkItemResult) GetAction() BulkItem_Action {
+	if m != nil {
+		return m.Action
 	}
-	return out, nil
+	return BulkItem_INDEX
 }
 
-func (c *indexClient) GetNode(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*raft.Node, error) {
-	out := new(raft.Node)
-	err := c.cc.Invoke(ctx, "/index.Index/GetNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BulkItemResult) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *indexClient) GetCluster(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*raft.Cluster, error) {
-	out := new(raft.Cluster)
-	err := c.cc.Invoke(ctx, "/index.Index/GetCluster", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BulkItemResult) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return ""
 }
 
-func (c *indexClient) Snapshot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/index.Index/Snapshot", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BulkItemResult) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return out, nil
+	return 0
 }
 
-func (c *indexClient) Get(ctx context.Context, in *Document, opts ...grpc.CallOption) (*Document, error) {
-	out := new(Document)
-	err := c.cc.Invoke(ctx, "/index.Index/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BulkItemResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *indexClient) Index(ctx context.Context, opts ...grpc.CallOption) (Index_IndexClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Index_serviceDesc.Streams[0], "/index.Index/Index", opts...)
-	if err != nil {
-		return nil, err
+type BulkResponse struct {
+	Results              []*BulkItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BulkResponse) Reset()         { *m = BulkResponse{} }
+func (m *BulkResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkResponse) ProtoMessage()    {}
+func (*BulkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{8}
+}
+
+func (m *BulkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkResponse.Unmarshal(m, b)
+}
+func (m *BulkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkResponse.Marshal(b, m, deterministic)
+}
+func (m *BulkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkResponse.Merge(m, src)
+}
+func (m *BulkResponse) XXX_Size() int {
+	return xxx_messageInfo_BulkResponse.Size(m)
+}
+func (m *BulkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkResponse proto.InternalMessageInfo
+
+func (m *BulkResponse) GetResults() []*BulkItemResult {
+	if m != nil {
+		return m.Results
 	}
-	x := &indexIndexClient{stream}
-	return x, nil
+	return nil
 }
 
-type Index_IndexClient interface {
-	Send(*Document) error
-	CloseAndRecv() (*UpdateResult, error)
-	grpc.ClientStream
+type Stats struct {
+	Stats                *any.Any `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type indexIndexClient struct {
-	grpc.ClientStream
+func (m *Stats) Reset()         { *m = Stats{} }
+func (m *Stats) String() string { return proto.CompactTextString(m) }
+func (*Stats) ProtoMessage()    {}
+func (*Stats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{9}
 }
 
-func (x *indexIndexClient) Send(m *Document) error {
-	return x.ClientStream.SendMsg(m)
+func (m *Stats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Stats.Unmarshal(m, b)
+}
+func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+}
+func (m *Stats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats.Merge(m, src)
+}
+func (m *Stats) XXX_Size() int {
+	return xxx_messageInfo_Stats.Size(m)
+}
+func (m *Stats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stats proto.InternalMessageInfo
+
+func (m *Stats) GetStats() *any.Any {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type ExportRequest struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Query                *Query   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{10}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type CountRequest struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Query                *Query   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountRequest) Reset()         { *m = CountRequest{} }
+func (m *CountRequest) String() string { return proto.CompactTextString(m) }
+func (*CountRequest) ProtoMessage()    {}
+func (*CountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{11}
+}
+
+func (m *CountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountRequest.Unmarshal(m, b)
+}
+func (m *CountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountRequest.Marshal(b, m, deterministic)
+}
+func (m *CountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountRequest.Merge(m, src)
+}
+func (m *CountRequest) XXX_Size() int {
+	return xxx_messageInfo_CountRequest.Size(m)
+}
+func (m *CountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountRequest proto.InternalMessageInfo
+
+func (m *CountRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *CountRequest) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type CountResponse struct {
+	Count                uint64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	DocCount             uint64   `protobuf:"varint,2,opt,name=doc_count,json=docCount,proto3" json:"doc_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountResponse) Reset()         { *m = CountResponse{} }
+func (m *CountResponse) String() string { return proto.CompactTextString(m) }
+func (*CountResponse) ProtoMessage()    {}
+func (*CountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{12}
+}
+
+func (m *CountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountResponse.Unmarshal(m, b)
+}
+func (m *CountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountResponse.Marshal(b, m, deterministic)
+}
+func (m *CountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountResponse.Merge(m, src)
+}
+func (m *CountResponse) XXX_Size() int {
+	return xxx_messageInfo_CountResponse.Size(m)
+}
+func (m *CountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountResponse proto.InternalMessageInfo
+
+func (m *CountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CountResponse) GetDocCount() uint64 {
+	if m != nil {
+		return m.DocCount
+	}
+	return 0
+}
+
+type ExplainRequest struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Query                *Query   `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExplainRequest) Reset()         { *m = ExplainRequest{} }
+func (m *ExplainRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainRequest) ProtoMessage()    {}
+func (*ExplainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{13}
+}
+
+func (m *ExplainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainRequest.Unmarshal(m, b)
+}
+func (m *ExplainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainRequest.Marshal(b, m, deterministic)
+}
+func (m *ExplainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainRequest.Merge(m, src)
+}
+func (m *ExplainRequest) XXX_Size() int {
+	return xxx_messageInfo_ExplainRequest.Size(m)
+}
+func (m *ExplainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainRequest proto.InternalMessageInfo
+
+func (m *ExplainRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ExplainRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExplainRequest) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type ExplainResponse struct {
+	Index                string       `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id                   string       `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Matched              bool         `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	Score                float64      `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	Explanation          *Explanation `protobuf:"bytes,5,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ExplainResponse) Reset()         { *m = ExplainResponse{} }
+func (m *ExplainResponse) String() string { return proto.CompactTextString(m) }
+func (*ExplainResponse) ProtoMessage()    {}
+func (*ExplainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{14}
+}
+
+func (m *ExplainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainResponse.Unmarshal(m, b)
+}
+func (m *ExplainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainResponse.Marshal(b, m, deterministic)
+}
+func (m *ExplainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainResponse.Merge(m, src)
+}
+func (m *ExplainResponse) XXX_Size() int {
+	return xxx_messageInfo_ExplainResponse.Size(m)
+}
+func (m *ExplainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainResponse proto.InternalMessageInfo
+
+func (m *ExplainResponse) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ExplainResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExplainResponse) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
+}
+
+func (m *ExplainResponse) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ExplainResponse) GetExplanation() *Explanation {
+	if m != nil {
+		return m.Explanation
+	}
+	return nil
+}
+
+type AnalyzeRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Text  string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// analyzer name, or empty to use the analyzer of the field
+	Analyzer             string   `protobuf:"bytes,3,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Field                string   `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnalyzeRequest) Reset()         { *m = AnalyzeRequest{} }
+func (m *AnalyzeRequest) String() string { return proto.CompactTextString(m) }
+func (*AnalyzeRequest) ProtoMessage()    {}
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{15}
+}
+
+func (m *AnalyzeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzeRequest.Unmarshal(m, b)
+}
+func (m *AnalyzeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzeRequest.Marshal(b, m, deterministic)
+}
+func (m *AnalyzeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzeRequest.Merge(m, src)
+}
+func (m *AnalyzeRequest) XXX_Size() int {
+	return xxx_messageInfo_AnalyzeRequest.Size(m)
+}
+func (m *AnalyzeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyzeRequest proto.InternalMessageInfo
+
+func (m *AnalyzeRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *AnalyzeRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *AnalyzeRequest) GetAnalyzer() string {
+	if m != nil {
+		return m.Analyzer
+	}
+	return ""
+}
+
+func (m *AnalyzeRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+type Token struct {
+	Term                 string   `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Position             int32    `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Start                int32    `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int32    `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Type                 string   `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Token) Reset()         { *m = Token{} }
+func (m *Token) String() string { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()    {}
+func (*Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{16}
+}
+
+func (m *Token) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Token.Unmarshal(m, b)
+}
+func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+}
+func (m *Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Token.Merge(m, src)
+}
+func (m *Token) XXX_Size() int {
+	return xxx_messageInfo_Token.Size(m)
+}
+func (m *Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Token proto.InternalMessageInfo
+
+func (m *Token) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+func (m *Token) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *Token) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Token) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *Token) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type AnalyzeResponse struct {
+	Analyzer             string   `protobuf:"bytes,1,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Tokens               []*Token `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnalyzeResponse) Reset()         { *m = AnalyzeResponse{} }
+func (m *AnalyzeResponse) String() string { return proto.CompactTextString(m) }
+func (*AnalyzeResponse) ProtoMessage()    {}
+func (*AnalyzeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{17}
+}
+
+func (m *AnalyzeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzeResponse.Unmarshal(m, b)
+}
+func (m *AnalyzeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzeResponse.Marshal(b, m, deterministic)
+}
+func (m *AnalyzeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzeResponse.Merge(m, src)
+}
+func (m *AnalyzeResponse) XXX_Size() int {
+	return xxx_messageInfo_AnalyzeResponse.Size(m)
+}
+func (m *AnalyzeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyzeResponse proto.InternalMessageInfo
+
+func (m *AnalyzeResponse) GetAnalyzer() string {
+	if m != nil {
+		return m.Analyzer
+	}
+	return ""
+}
+
+func (m *AnalyzeResponse) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	// all the indexes if empty
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// the raft index of the first change to send, only the new changes if 0
+	FromRaftIndex        uint64   `protobuf:"varint,2,opt,name=from_raft_index,json=fromRaftIndex,proto3" json:"from_raft_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{18}
+}
+
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetFromRaftIndex() uint64 {
+	if m != nil {
+		return m.FromRaftIndex
+	}
+	return 0
+}
+
+type ChangeEvent struct {
+	Type      ChangeEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=index.ChangeEvent_Type" json:"type,omitempty"`
+	RaftIndex uint64           `protobuf:"varint,2,opt,name=raft_index,json=raftIndex,proto3" json:"raft_index,omitempty"`
+	Index     string           `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	Id        string           `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// the fields of the document, only the updated fields for an update
+	Fields               *_struct.Struct `protobuf:"bytes,5,opt,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ChangeEvent) Reset()         { *m = ChangeEvent{} }
+func (m *ChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*ChangeEvent) ProtoMessage()    {}
+func (*ChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{19}
+}
+
+func (m *ChangeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeEvent.Unmarshal(m, b)
+}
+func (m *ChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeEvent.Marshal(b, m, deterministic)
+}
+func (m *ChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeEvent.Merge(m, src)
+}
+func (m *ChangeEvent) XXX_Size() int {
+	return xxx_messageInfo_ChangeEvent.Size(m)
+}
+func (m *ChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeEvent proto.InternalMessageInfo
+
+func (m *ChangeEvent) GetType() ChangeEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ChangeEvent_INDEX
+}
+
+func (m *ChangeEvent) GetRaftIndex() uint64 {
+	if m != nil {
+		return m.RaftIndex
+	}
+	return 0
+}
+
+func (m *ChangeEvent) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ChangeEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ChangeEvent) GetFields() *_struct.Struct {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+// the raft indexes of the document commands that were not applied, carried by the snapshots
+// with the raft index they were recorded up to
+type RejectedChanges struct {
+	RaftIndexes          []uint64 `protobuf:"varint,1,rep,packed,name=raft_indexes,json=raftIndexes,proto3" json:"raft_indexes,omitempty"`
+	AppliedIndex         uint64   `protobuf:"varint,2,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RejectedChanges) Reset()         { *m = RejectedChanges{} }
+func (m *RejectedChanges) String() string { return proto.CompactTextString(m) }
+func (*RejectedChanges) ProtoMessage()    {}
+func (*RejectedChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{20}
+}
+
+func (m *RejectedChanges) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectedChanges.Unmarshal(m, b)
+}
+func (m *RejectedChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectedChanges.Marshal(b, m, deterministic)
+}
+func (m *RejectedChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedChanges.Merge(m, src)
+}
+func (m *RejectedChanges) XXX_Size() int {
+	return xxx_messageInfo_RejectedChanges.Size(m)
+}
+func (m *RejectedChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectedChanges proto.InternalMessageInfo
+
+func (m *RejectedChanges) GetRaftIndexes() []uint64 {
+	if m != nil {
+		return m.RaftIndexes
+	}
+	return nil
+}
+
+func (m *RejectedChanges) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
+	}
+	return 0
+}
+
+type SimulatePipelineRequest struct {
+	// the name of the pipeline to simulate, or empty to simulate the given pipeline
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pipeline             *_struct.Struct   `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Docs                 []*_struct.Struct `protobuf:"bytes,3,rep,name=docs,proto3" json:"docs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SimulatePipelineRequest) Reset()         { *m = SimulatePipelineRequest{} }
+func (m *SimulatePipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulatePipelineRequest) ProtoMessage()    {}
+func (*SimulatePipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{21}
+}
+
+func (m *SimulatePipelineRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulatePipelineRequest.Unmarshal(m, b)
+}
+func (m *SimulatePipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulatePipelineRequest.Marshal(b, m, deterministic)
+}
+func (m *SimulatePipelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatePipelineRequest.Merge(m, src)
+}
+func (m *SimulatePipelineRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulatePipelineRequest.Size(m)
+}
+func (m *SimulatePipelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatePipelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatePipelineRequest proto.InternalMessageInfo
+
+func (m *SimulatePipelineRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SimulatePipelineRequest) GetPipeline() *_struct.Struct {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *SimulatePipelineRequest) GetDocs() []*_struct.Struct {
+	if m != nil {
+		return m.Docs
+	}
+	return nil
+}
+
+type SimulatedDocument struct {
+	Fields               *_struct.Struct `protobuf:"bytes,1,opt,name=fields,proto3" json:"fields,omitempty"`
+	Error                string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SimulatedDocument) Reset()         { *m = SimulatedDocument{} }
+func (m *SimulatedDocument) String() string { return proto.CompactTextString(m) }
+func (*SimulatedDocument) ProtoMessage()    {}
+func (*SimulatedDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{22}
+}
+
+func (m *SimulatedDocument) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulatedDocument.Unmarshal(m, b)
+}
+func (m *SimulatedDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulatedDocument.Marshal(b, m, deterministic)
+}
+func (m *SimulatedDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedDocument.Merge(m, src)
+}
+func (m *SimulatedDocument) XXX_Size() int {
+	return xxx_messageInfo_SimulatedDocument.Size(m)
+}
+func (m *SimulatedDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedDocument proto.InternalMessageInfo
+
+func (m *SimulatedDocument) GetFields() *_struct.Struct {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *SimulatedDocument) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SimulatePipelineResponse struct {
+	Docs                 []*SimulatedDocument `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SimulatePipelineResponse) Reset()         { *m = SimulatePipelineResponse{} }
+func (m *SimulatePipelineResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulatePipelineResponse) ProtoMessage()    {}
+func (*SimulatePipelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{23}
+}
+
+func (m *SimulatePipelineResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulatePipelineResponse.Unmarshal(m, b)
+}
+func (m *SimulatePipelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulatePipelineResponse.Marshal(b, m, deterministic)
+}
+func (m *SimulatePipelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatePipelineResponse.Merge(m, src)
+}
+func (m *SimulatePipelineResponse) XXX_Size() int {
+	return xxx_messageInfo_SimulatePipelineResponse.Size(m)
+}
+func (m *SimulatePipelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatePipelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatePipelineResponse proto.InternalMessageInfo
+
+func (m *SimulatePipelineResponse) GetDocs() []*SimulatedDocument {
+	if m != nil {
+		return m.Docs
+	}
+	return nil
+}
+
+type PercolatorQuery struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Query                *Query   `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PercolatorQuery) Reset()         { *m = PercolatorQuery{} }
+func (m *PercolatorQuery) String() string { return proto.CompactTextString(m) }
+func (*PercolatorQuery) ProtoMessage()    {}
+func (*PercolatorQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{24}
+}
+
+func (m *PercolatorQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PercolatorQuery.Unmarshal(m, b)
+}
+func (m *PercolatorQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PercolatorQuery.Marshal(b, m, deterministic)
+}
+func (m *PercolatorQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercolatorQuery.Merge(m, src)
+}
+func (m *PercolatorQuery) XXX_Size() int {
+	return xxx_messageInfo_PercolatorQuery.Size(m)
+}
+func (m *PercolatorQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercolatorQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercolatorQuery proto.InternalMessageInfo
+
+func (m *PercolatorQuery) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *PercolatorQuery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PercolatorQuery) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type PercolatorQueryList struct {
+	Queries              []*PercolatorQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PercolatorQueryList) Reset()         { *m = PercolatorQueryList{} }
+func (m *PercolatorQueryList) String() string { return proto.CompactTextString(m) }
+func (*PercolatorQueryList) ProtoMessage()    {}
+func (*PercolatorQueryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{25}
+}
+
+func (m *PercolatorQueryList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PercolatorQueryList.Unmarshal(m, b)
+}
+func (m *PercolatorQueryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PercolatorQueryList.Marshal(b, m, deterministic)
+}
+func (m *PercolatorQueryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercolatorQueryList.Merge(m, src)
+}
+func (m *PercolatorQueryList) XXX_Size() int {
+	return xxx_messageInfo_PercolatorQueryList.Size(m)
+}
+func (m *PercolatorQueryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercolatorQueryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercolatorQueryList proto.InternalMessageInfo
+
+func (m *PercolatorQueryList) GetQueries() []*PercolatorQuery {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+type PercolateRequest struct {
+	Index                string          `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Fields               *_struct.Struct `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PercolateRequest) Reset()         { *m = PercolateRequest{} }
+func (m *PercolateRequest) String() string { return proto.CompactTextString(m) }
+func (*PercolateRequest) ProtoMessage()    {}
+func (*PercolateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{26}
+}
+
+func (m *PercolateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PercolateRequest.Unmarshal(m, b)
+}
+func (m *PercolateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PercolateRequest.Marshal(b, m, deterministic)
+}
+func (m *PercolateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercolateRequest.Merge(m, src)
+}
+func (m *PercolateRequest) XXX_Size() int {
+	return xxx_messageInfo_PercolateRequest.Size(m)
+}
+func (m *PercolateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercolateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercolateRequest proto.InternalMessageInfo
+
+func (m *PercolateRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *PercolateRequest) GetFields() *_struct.Struct {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type PercolateResponse struct {
+	QueryIds             []string `protobuf:"bytes,1,rep,name=query_ids,json=queryIds,proto3" json:"query_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PercolateResponse) Reset()         { *m = PercolateResponse{} }
+func (m *PercolateResponse) String() string { return proto.CompactTextString(m) }
+func (*PercolateResponse) ProtoMessage()    {}
+func (*PercolateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{27}
+}
+
+func (m *PercolateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PercolateResponse.Unmarshal(m, b)
+}
+func (m *PercolateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PercolateResponse.Marshal(b, m, deterministic)
+}
+func (m *PercolateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercolateResponse.Merge(m, src)
+}
+func (m *PercolateResponse) XXX_Size() int {
+	return xxx_messageInfo_PercolateResponse.Size(m)
+}
+func (m *PercolateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercolateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercolateResponse proto.InternalMessageInfo
+
+func (m *PercolateResponse) GetQueryIds() []string {
+	if m != nil {
+		return m.QueryIds
+	}
+	return nil
+}
+
+type SubscribePercolationsRequest struct {
+	// all the indexes if empty
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribePercolationsRequest) Reset()         { *m = SubscribePercolationsRequest{} }
+func (m *SubscribePercolationsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribePercolationsRequest) ProtoMessage()    {}
+func (*SubscribePercolationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{28}
+}
+
+func (m *SubscribePercolationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribePercolationsRequest.Unmarshal(m, b)
+}
+func (m *SubscribePercolationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribePercolationsRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribePercolationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribePercolationsRequest.Merge(m, src)
+}
+func (m *SubscribePercolationsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribePercolationsRequest.Size(m)
+}
+func (m *SubscribePercolationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribePercolationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribePercolationsRequest proto.InternalMessageInfo
+
+func (m *SubscribePercolationsRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type Percolation struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	QueryIds             []string `protobuf:"bytes,3,rep,name=query_ids,json=queryIds,proto3" json:"query_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Percolation) Reset()         { *m = Percolation{} }
+func (m *Percolation) String() string { return proto.CompactTextString(m) }
+func (*Percolation) ProtoMessage()    {}
+func (*Percolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{29}
+}
+
+func (m *Percolation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Percolation.Unmarshal(m, b)
+}
+func (m *Percolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Percolation.Marshal(b, m, deterministic)
+}
+func (m *Percolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Percolation.Merge(m, src)
+}
+func (m *Percolation) XXX_Size() int {
+	return xxx_messageInfo_Percolation.Size(m)
+}
+func (m *Percolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Percolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Percolation proto.InternalMessageInfo
+
+func (m *Percolation) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Percolation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Percolation) GetQueryIds() []string {
+	if m != nil {
+		return m.QueryIds
+	}
+	return nil
+}
+
+type SimilarRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// fields to take terms from, all the text fields of the document if empty
+	LikeFields    []string `protobuf:"bytes,3,rep,name=like_fields,json=likeFields,proto3" json:"like_fields,omitempty"`
+	MaxQueryTerms int32    `protobuf:"varint,4,opt,name=max_query_terms,json=maxQueryTerms,proto3" json:"max_query_terms,omitempty"`
+	MinTermFreq   int32    `protobuf:"varint,5,opt,name=min_term_freq,json=minTermFreq,proto3" json:"min_term_freq,omitempty"`
+	MinDocFreq    int32    `protobuf:"varint,6,opt,name=min_doc_freq,json=minDocFreq,proto3" json:"min_doc_freq,omitempty"`
+	MaxDocFreq    int32    `protobuf:"varint,7,opt,name=max_doc_freq,json=maxDocFreq,proto3" json:"max_doc_freq,omitempty"`
+	// the query of the search request is replaced with the more like this query
+	SearchRequest        *SearchRequest `protobuf:"bytes,8,opt,name=search_request,json=searchRequest,proto3" json:"search_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SimilarRequest) Reset()         { *m = SimilarRequest{} }
+func (m *SimilarRequest) String() string { return proto.CompactTextString(m) }
+func (*SimilarRequest) ProtoMessage()    {}
+func (*SimilarRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{30}
+}
+
+func (m *SimilarRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimilarRequest.Unmarshal(m, b)
+}
+func (m *SimilarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimilarRequest.Marshal(b, m, deterministic)
+}
+func (m *SimilarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimilarRequest.Merge(m, src)
+}
+func (m *SimilarRequest) XXX_Size() int {
+	return xxx_messageInfo_SimilarRequest.Size(m)
+}
+func (m *SimilarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimilarRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimilarRequest proto.InternalMessageInfo
+
+func (m *SimilarRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *SimilarRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SimilarRequest) GetLikeFields() []string {
+	if m != nil {
+		return m.LikeFields
+	}
+	return nil
+}
+
+func (m *SimilarRequest) GetMaxQueryTerms() int32 {
+	if m != nil {
+		return m.MaxQueryTerms
+	}
+	return 0
+}
+
+func (m *SimilarRequest) GetMinTermFreq() int32 {
+	if m != nil {
+		return m.MinTermFreq
+	}
+	return 0
+}
+
+func (m *SimilarRequest) GetMinDocFreq() int32 {
+	if m != nil {
+		return m.MinDocFreq
+	}
+	return 0
+}
+
+func (m *SimilarRequest) GetMaxDocFreq() int32 {
+	if m != nil {
+		return m.MaxDocFreq
+	}
+	return 0
+}
+
+func (m *SimilarRequest) GetSearchRequest() *SearchRequest {
+	if m != nil {
+		return m.SearchRequest
+	}
+	return nil
+}
+
+type FieldStatsRequest struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldStatsRequest) Reset()         { *m = FieldStatsRequest{} }
+func (m *FieldStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*FieldStatsRequest) ProtoMessage()    {}
+func (*FieldStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{31}
+}
+
+func (m *FieldStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldStatsRequest.Unmarshal(m, b)
+}
+func (m *FieldStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *FieldStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldStatsRequest.Merge(m, src)
+}
+func (m *FieldStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_FieldStatsRequest.Size(m)
+}
+func (m *FieldStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldStatsRequest proto.InternalMessageInfo
+
+func (m *FieldStatsRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type FieldStats struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DocCount             uint64   `protobuf:"varint,2,opt,name=doc_count,json=docCount,proto3" json:"doc_count,omitempty"`
+	TermCount            uint64   `protobuf:"varint,3,opt,name=term_count,json=termCount,proto3" json:"term_count,omitempty"`
+	SumDocFreq           uint64   `protobuf:"varint,4,opt,name=sum_doc_freq,json=sumDocFreq,proto3" json:"sum_doc_freq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldStats) Reset()         { *m = FieldStats{} }
+func (m *FieldStats) String() string { return proto.CompactTextString(m) }
+func (*FieldStats) ProtoMessage()    {}
+func (*FieldStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{32}
+}
+
+func (m *FieldStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldStats.Unmarshal(m, b)
+}
+func (m *FieldStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldStats.Marshal(b, m, deterministic)
+}
+func (m *FieldStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldStats.Merge(m, src)
+}
+func (m *FieldStats) XXX_Size() int {
+	return xxx_messageInfo_FieldStats.Size(m)
+}
+func (m *FieldStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldStats proto.InternalMessageInfo
+
+func (m *FieldStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FieldStats) GetDocCount() uint64 {
+	if m != nil {
+		return m.DocCount
+	}
+	return 0
+}
+
+func (m *FieldStats) GetTermCount() uint64 {
+	if m != nil {
+		return m.TermCount
+	}
+	return 0
+}
+
+func (m *FieldStats) GetSumDocFreq() uint64 {
+	if m != nil {
+		return m.SumDocFreq
+	}
+	return 0
+}
+
+type FieldStatsResponse struct {
+	DocCount             uint64        `protobuf:"varint,1,opt,name=doc_count,json=docCount,proto3" json:"doc_count,omitempty"`
+	Fields               []*FieldStats `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *FieldStatsResponse) Reset()         { *m = FieldStatsResponse{} }
+func (m *FieldStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*FieldStatsResponse) ProtoMessage()    {}
+func (*FieldStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{33}
+}
+
+func (m *FieldStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldStatsResponse.Unmarshal(m, b)
+}
+func (m *FieldStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *FieldStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldStatsResponse.Merge(m, src)
+}
+func (m *FieldStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_FieldStatsResponse.Size(m)
+}
+func (m *FieldStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldStatsResponse proto.InternalMessageInfo
+
+func (m *FieldStatsResponse) GetDocCount() uint64 {
+	if m != nil {
+		return m.DocCount
+	}
+	return 0
+}
+
+func (m *FieldStatsResponse) GetFields() []*FieldStats {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type SuggestRequest struct {
+	Index  string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Field  string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Size   int32  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// suggest the terms within the edit distance of the prefix instead of the terms starting with it
+	Fuzzy                bool     `protobuf:"varint,5,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	Fuzziness            int32    `protobuf:"varint,6,opt,name=fuzziness,proto3" json:"fuzziness,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuggestRequest) Reset()         { *m = SuggestRequest{} }
+func (m *SuggestRequest) String() string { return proto.CompactTextString(m) }
+func (*SuggestRequest) ProtoMessage()    {}
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{34}
+}
+
+func (m *SuggestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestRequest.Unmarshal(m, b)
+}
+func (m *SuggestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestRequest.Marshal(b, m, deterministic)
+}
+func (m *SuggestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestRequest.Merge(m, src)
+}
+func (m *SuggestRequest) XXX_Size() int {
+	return xxx_messageInfo_SuggestRequest.Size(m)
+}
+func (m *SuggestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestRequest proto.InternalMessageInfo
+
+func (m *SuggestRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *SuggestRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *SuggestRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *SuggestRequest) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *SuggestRequest) GetFuzzy() bool {
+	if m != nil {
+		return m.Fuzzy
+	}
+	return false
+}
+
+func (m *SuggestRequest) GetFuzziness() int32 {
+	if m != nil {
+		return m.Fuzziness
+	}
+	return 0
+}
+
+type Suggestion struct {
+	Term                 string   `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Distance             int32    `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Suggestion) Reset()         { *m = Suggestion{} }
+func (m *Suggestion) String() string { return proto.CompactTextString(m) }
+func (*Suggestion) ProtoMessage()    {}
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{35}
+}
+
+func (m *Suggestion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Suggestion.Unmarshal(m, b)
+}
+func (m *Suggestion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Suggestion.Marshal(b, m, deterministic)
+}
+func (m *Suggestion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Suggestion.Merge(m, src)
+}
+func (m *Suggestion) XXX_Size() int {
+	return xxx_messageInfo_Suggestion.Size(m)
+}
+func (m *Suggestion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Suggestion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Suggestion proto.InternalMessageInfo
+
+func (m *Suggestion) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+func (m *Suggestion) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Suggestion) GetDistance() int32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+type SuggestResponse struct {
+	Suggestions          []*Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SuggestResponse) Reset()         { *m = SuggestResponse{} }
+func (m *SuggestResponse) String() string { return proto.CompactTextString(m) }
+func (*SuggestResponse) ProtoMessage()    {}
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{36}
+}
+
+func (m *SuggestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestResponse.Unmarshal(m, b)
+}
+func (m *SuggestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestResponse.Marshal(b, m, deterministic)
+}
+func (m *SuggestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestResponse.Merge(m, src)
+}
+func (m *SuggestResponse) XXX_Size() int {
+	return xxx_messageInfo_SuggestResponse.Size(m)
+}
+func (m *SuggestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestResponse proto.InternalMessageInfo
+
+func (m *SuggestResponse) GetSuggestions() []*Suggestion {
+	if m != nil {
+		return m.Suggestions
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	Index                string                   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Query                *Query                   `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Size                 *wrappers.Int32Value     `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	From                 int32                    `protobuf:"varint,5,opt,name=from,proto3" json:"from,omitempty"`
+	Highlight            *HighlightRequest        `protobuf:"bytes,6,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	Fields               []string                 `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+	Facets               map[string]*FacetRequest `protobuf:"bytes,8,rep,name=facets,proto3" json:"facets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Explain              bool                     `protobuf:"varint,9,opt,name=explain,proto3" json:"explain,omitempty"`
+	Sort                 []*SortField             `protobuf:"bytes,10,rep,name=sort,proto3" json:"sort,omitempty"`
+	IncludeLocations     bool                     `protobuf:"varint,11,opt,name=include_locations,json=includeLocations,proto3" json:"include_locations,omitempty"`
+	IncludeSource        bool                     `protobuf:"varint,12,opt,name=include_source,json=includeSource,proto3" json:"include_source,omitempty"`
+	SourceIncludes       []string                 `protobuf:"bytes,13,rep,name=source_includes,json=sourceIncludes,proto3" json:"source_includes,omitempty"`
+	SourceExcludes       []string                 `protobuf:"bytes,14,rep,name=source_excludes,json=sourceExcludes,proto3" json:"source_excludes,omitempty"`
+	SearchAfter          []string                 `protobuf:"bytes,15,rep,name=search_after,json=searchAfter,proto3" json:"search_after,omitempty"`
+	Scroll               *duration.Duration       `protobuf:"bytes,16,opt,name=scroll,proto3" json:"scroll,omitempty"`
+	Profile              bool                     `protobuf:"varint,17,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{37}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetSize() *wrappers.Int32Value {
+	if m != nil {
+		return m.Size
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetFrom() int32 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetHighlight() *HighlightRequest {
+	if m != nil {
+		return m.Highlight
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetFacets() map[string]*FacetRequest {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
+func (m *SearchRequest) GetSort() []*SortField {
+	if m != nil {
+		return m.Sort
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetIncludeLocations() bool {
+	if m != nil {
+		return m.IncludeLocations
+	}
+	return false
+}
+
+func (m *SearchRequest) GetIncludeSource() bool {
+	if m != nil {
+		return m.IncludeSource
+	}
+	return false
+}
+
+func (m *SearchRequest) GetSourceIncludes() []string {
+	if m != nil {
+		return m.SourceIncludes
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetSourceExcludes() []string {
+	if m != nil {
+		return m.SourceExcludes
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetSearchAfter() []string {
+	if m != nil {
+		return m.SearchAfter
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetScroll() *duration.Duration {
+	if m != nil {
+		return m.Scroll
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetProfile() bool {
+	if m != nil {
+		return m.Profile
+	}
+	return false
+}
+
+type SearchResponse struct {
+	Status               *SearchStatus           `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Request              *SearchRequest          `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	Hits                 []*DocumentMatch        `protobuf:"bytes,4,rep,name=hits,proto3" json:"hits,omitempty"`
+	TotalHits            uint64                  `protobuf:"varint,5,opt,name=total_hits,json=totalHits,proto3" json:"total_hits,omitempty"`
+	MaxScore             float64                 `protobuf:"fixed64,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Took                 *duration.Duration      `protobuf:"bytes,7,opt,name=took,proto3" json:"took,omitempty"`
+	Facets               map[string]*FacetResult `protobuf:"bytes,8,rep,name=facets,proto3" json:"facets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ScrollId             string                  `protobuf:"bytes,9,opt,name=scroll_id,json=scrollId,proto3" json:"scroll_id,omitempty"`
+	Profile              *SearchProfile          `protobuf:"bytes,10,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
+func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()    {}
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{38}
+}
+
+func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResponse.Unmarshal(m, b)
+}
+func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse.Merge(m, src)
+}
+func (m *SearchResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchResponse.Size(m)
+}
+func (m *SearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+
+func (m *SearchResponse) GetStatus() *SearchStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetRequest() *SearchRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetHits() []*DocumentMatch {
+	if m != nil {
+		return m.Hits
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetTotalHits() uint64 {
+	if m != nil {
+		return m.TotalHits
+	}
+	return 0
+}
+
+func (m *SearchResponse) GetMaxScore() float64 {
+	if m != nil {
+		return m.MaxScore
+	}
+	return 0
+}
+
+func (m *SearchResponse) GetTook() *duration.Duration {
+	if m != nil {
+		return m.Took
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetFacets() map[string]*FacetResult {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetScrollId() string {
+	if m != nil {
+		return m.ScrollId
+	}
+	return ""
+}
+
+func (m *SearchResponse) GetProfile() *SearchProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// SearchProfile holds the timings of the phases of a search on the node that served it.
+type SearchProfile struct {
+	NodeId               string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Phases               []*SearchProfile_Phase `protobuf:"bytes,2,rep,name=phases,proto3" json:"phases,omitempty"`
+	Took                 *duration.Duration     `protobuf:"bytes,3,opt,name=took,proto3" json:"took,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SearchProfile) Reset()         { *m = SearchProfile{} }
+func (m *SearchProfile) String() string { return proto.CompactTextString(m) }
+func (*SearchProfile) ProtoMessage()    {}
+func (*SearchProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{39}
+}
+
+func (m *SearchProfile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchProfile.Unmarshal(m, b)
+}
+func (m *SearchProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchProfile.Marshal(b, m, deterministic)
+}
+func (m *SearchProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchProfile.Merge(m, src)
+}
+func (m *SearchProfile) XXX_Size() int {
+	return xxx_messageInfo_SearchProfile.Size(m)
+}
+func (m *SearchProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchProfile proto.InternalMessageInfo
+
+func (m *SearchProfile) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *SearchProfile) GetPhases() []*SearchProfile_Phase {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *SearchProfile) GetTook() *duration.Duration {
+	if m != nil {
+		return m.Took
+	}
+	return nil
+}
+
+type SearchProfile_Phase struct {
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Took                 *duration.Duration `protobuf:"bytes,2,opt,name=took,proto3" json:"took,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SearchProfile_Phase) Reset()         { *m = SearchProfile_Phase{} }
+func (m *SearchProfile_Phase) String() string { return proto.CompactTextString(m) }
+func (*SearchProfile_Phase) ProtoMessage()    {}
+func (*SearchProfile_Phase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{39, 0}
+}
+
+func (m *SearchProfile_Phase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchProfile_Phase.Unmarshal(m, b)
+}
+func (m *SearchProfile_Phase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchProfile_Phase.Marshal(b, m, deterministic)
+}
+func (m *SearchProfile_Phase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchProfile_Phase.Merge(m, src)
+}
+func (m *SearchProfile_Phase) XXX_Size() int {
+	return xxx_messageInfo_SearchProfile_Phase.Size(m)
+}
+func (m *SearchProfile_Phase) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchProfile_Phase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchProfile_Phase proto.InternalMessageInfo
+
+func (m *SearchProfile_Phase) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SearchProfile_Phase) GetTook() *duration.Duration {
+	if m != nil {
+		return m.Took
+	}
+	return nil
+}
+
+type ScrollRequest struct {
+	ScrollId             string             `protobuf:"bytes,1,opt,name=scroll_id,json=scrollId,proto3" json:"scroll_id,omitempty"`
+	Scroll               *duration.Duration `protobuf:"bytes,2,opt,name=scroll,proto3" json:"scroll,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ScrollRequest) Reset()         { *m = ScrollRequest{} }
+func (m *ScrollRequest) String() string { return proto.CompactTextString(m) }
+func (*ScrollRequest) ProtoMessage()    {}
+func (*ScrollRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{40}
+}
+
+func (m *ScrollRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScrollRequest.Unmarshal(m, b)
+}
+func (m *ScrollRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScrollRequest.Marshal(b, m, deterministic)
+}
+func (m *ScrollRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScrollRequest.Merge(m, src)
+}
+func (m *ScrollRequest) XXX_Size() int {
+	return xxx_messageInfo_ScrollRequest.Size(m)
+}
+func (m *ScrollRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScrollRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScrollRequest proto.InternalMessageInfo
+
+func (m *ScrollRequest) GetScrollId() string {
+	if m != nil {
+		return m.ScrollId
+	}
+	return ""
+}
+
+func (m *ScrollRequest) GetScroll() *duration.Duration {
+	if m != nil {
+		return m.Scroll
+	}
+	return nil
+}
+
+type Query struct {
+	// Types that are valid to be assigned to Query:
+	//	*Query_MatchAll
+	//	*Query_MatchNone
+	//	*Query_Match
+	//	*Query_MatchPhrase
+	//	*Query_Term
+	//	*Query_Phrase
+	//	*Query_MultiPhrase
+	//	*Query_Prefix
+	//	*Query_Wildcard
+	//	*Query_Regexp
+	//	*Query_Fuzzy
+	//	*Query_NumericRange
+	//	*Query_DateRange
+	//	*Query_TermRange
+	//	*Query_QueryString
+	//	*Query_Boolean
+	//	*Query_Conjunction
+	//	*Query_Disjunction
+	//	*Query_DocId
+	//	*Query_BoolField
+	//	*Query_GeoDistance
+	//	*Query_GeoBoundingBox
+	Query                isQuery_Query `protobuf_oneof:"query"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Query) Reset()         { *m = Query{} }
+func (m *Query) String() string { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()    {}
+func (*Query) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{41}
+}
+
+func (m *Query) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Query.Unmarshal(m, b)
+}
+func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Query.Marshal(b, m, deterministic)
+}
+func (m *Query) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Query.Merge(m, src)
+}
+func (m *Query) XXX_Size() int {
+	return xxx_messageInfo_Query.Size(m)
+}
+func (m *Query) XXX_DiscardUnknown() {
+	xxx_messageInfo_Query.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Query proto.InternalMessageInfo
+
+type isQuery_Query interface {
+	isQuery_Query()
+}
+
+type Query_MatchAll struct {
+	MatchAll *MatchAllQuery `protobuf:"bytes,1,opt,name=match_all,json=matchAll,proto3,oneof"`
+}
+
+type Query_MatchNone struct {
+	MatchNone *MatchNoneQuery `protobuf:"bytes,2,opt,name=match_none,json=matchNone,proto3,oneof"`
+}
+
+type Query_Match struct {
+	Match *MatchQuery `protobuf:"bytes,3,opt,name=match,proto3,oneof"`
+}
+
+type Query_MatchPhrase struct {
+	MatchPhrase *MatchPhraseQuery `protobuf:"bytes,4,opt,name=match_phrase,json=matchPhrase,proto3,oneof"`
+}
+
+type Query_Term struct {
+	Term *TermQuery `protobuf:"bytes,5,opt,name=term,proto3,oneof"`
+}
+
+type Query_Phrase struct {
+	Phrase *PhraseQuery `protobuf:"bytes,6,opt,name=phrase,proto3,oneof"`
+}
+
+type Query_MultiPhrase struct {
+	MultiPhrase *MultiPhraseQuery `protobuf:"bytes,7,opt,name=multi_phrase,json=multiPhrase,proto3,oneof"`
+}
+
+type Query_Prefix struct {
+	Prefix *PrefixQuery `protobuf:"bytes,8,opt,name=prefix,proto3,oneof"`
+}
+
+type Query_Wildcard struct {
+	Wildcard *WildcardQuery `protobuf:"bytes,9,opt,name=wildcard,proto3,oneof"`
+}
+
+type Query_Regexp struct {
+	Regexp *RegexpQuery `protobuf:"bytes,10,opt,name=regexp,proto3,oneof"`
+}
+
+type Query_Fuzzy struct {
+	Fuzzy *FuzzyQuery `protobuf:"bytes,11,opt,name=fuzzy,proto3,oneof"`
+}
+
+type Query_NumericRange struct {
+	NumericRange *NumericRangeQuery `protobuf:"bytes,12,opt,name=numeric_range,json=numericRange,proto3,oneof"`
+}
+
+type Query_DateRange struct {
+	DateRange *DateRangeQuery `protobuf:"bytes,13,opt,name=date_range,json=dateRange,proto3,oneof"`
+}
+
+type Query_TermRange struct {
+	TermRange *TermRangeQuery `protobuf:"bytes,14,opt,name=term_range,json=termRange,proto3,oneof"`
+}
+
+type Query_QueryString struct {
+	QueryString *QueryStringQuery `protobuf:"bytes,15,opt,name=query_string,json=queryString,proto3,oneof"`
+}
+
+type Query_Boolean struct {
+	Boolean *BooleanQuery `protobuf:"bytes,16,opt,name=boolean,proto3,oneof"`
+}
+
+type Query_Conjunction struct {
+	Conjunction *ConjunctionQuery `protobuf:"bytes,17,opt,name=conjunction,proto3,oneof"`
+}
+
+type Query_Disjunction struct {
+	Disjunction *DisjunctionQuery `protobuf:"bytes,18,opt,name=disjunction,proto3,oneof"`
+}
+
+type Query_DocId struct {
+	DocId *DocIDQuery `protobuf:"bytes,19,opt,name=doc_id,json=docId,proto3,oneof"`
+}
+
+type Query_BoolField struct {
+	BoolField *BoolFieldQuery `protobuf:"bytes,20,opt,name=bool_field,json=boolField,proto3,oneof"`
+}
+
+type Query_GeoDistance struct {
+	GeoDistance *GeoDistanceQuery `protobuf:"bytes,21,opt,name=geo_distance,json=geoDistance,proto3,oneof"`
+}
+
+type Query_GeoBoundingBox struct {
+	GeoBoundingBox *GeoBoundingBoxQuery `protobuf:"bytes,22,opt,name=geo_bounding_box,json=geoBoundingBox,proto3,oneof"`
+}
+
+func (*Query_MatchAll) isQuery_Query() {}
+
+func (*Query_MatchNone) isQuery_Query() {}
+
+func (*Query_Match) isQuery_Query() {}
+
+func (*Query_MatchPhrase) isQuery_Query() {}
+
+func (*Query_Term) isQuery_Query() {}
+
+func (*Query_Phrase) isQuery_Query() {}
+
+func (*Query_MultiPhrase) isQuery_Query() {}
+
+func (*Query_Prefix) isQuery_Query() {}
+
+func (*Query_Wildcard) isQuery_Query() {}
+
+func (*Query_Regexp) isQuery_Query() {}
+
+func (*Query_Fuzzy) isQuery_Query() {}
+
+func (*Query_NumericRange) isQuery_Query() {}
+
+func (*Query_DateRange) isQuery_Query() {}
+
+func (*Query_TermRange) isQuery_Query() {}
+
+func (*Query_QueryString) isQuery_Query() {}
+
+func (*Query_Boolean) isQuery_Query() {}
+
+func (*Query_Conjunction) isQuery_Query() {}
+
+func (*Query_Disjunction) isQuery_Query() {}
+
+func (*Query_DocId) isQuery_Query() {}
+
+func (*Query_BoolField) isQuery_Query() {}
+
+func (*Query_GeoDistance) isQuery_Query() {}
+
+func (*Query_GeoBoundingBox) isQuery_Query() {}
+
+func (m *Query) GetQuery() isQuery_Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *Query) GetMatchAll() *MatchAllQuery {
+	if x, ok := m.GetQuery().(*Query_MatchAll); ok {
+		return x.MatchAll
+	}
+	return nil
+}
+
+func (m *Query) GetMatchNone() *MatchNoneQuery {
+	if x, ok := m.GetQuery().(*Query_MatchNone); ok {
+		return x.MatchNone
+	}
+	return nil
+}
+
+func (m *Query) GetMatch() *MatchQuery {
+	if x, ok := m.GetQuery().(*Query_Match); ok {
+		return x.Match
+	}
+	return nil
+}
+
+func (m *Query) GetMatchPhrase() *MatchPhraseQuery {
+	if x, ok := m.GetQuery().(*Query_MatchPhrase); ok {
+		return x.MatchPhrase
+	}
+	return nil
+}
+
+func (m *Query) GetTerm() *TermQuery {
+	if x, ok := m.GetQuery().(*Query_Term); ok {
+		return x.Term
+	}
+	return nil
+}
+
+func (m *Query) GetPhrase() *PhraseQuery {
+	if x, ok := m.GetQuery().(*Query_Phrase); ok {
+		return x.Phrase
+	}
+	return nil
+}
+
+func (m *Query) GetMultiPhrase() *MultiPhraseQuery {
+	if x, ok := m.GetQuery().(*Query_MultiPhrase); ok {
+		return x.MultiPhrase
+	}
+	return nil
+}
+
+func (m *Query) GetPrefix() *PrefixQuery {
+	if x, ok := m.GetQuery().(*Query_Prefix); ok {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (m *Query) GetWildcard() *WildcardQuery {
+	if x, ok := m.GetQuery().(*Query_Wildcard); ok {
+		return x.Wildcard
+	}
+	return nil
+}
+
+func (m *Query) GetRegexp() *RegexpQuery {
+	if x, ok := m.GetQuery().(*Query_Regexp); ok {
+		return x.Regexp
+	}
+	return nil
+}
+
+func (m *Query) GetFuzzy() *FuzzyQuery {
+	if x, ok := m.GetQuery().(*Query_Fuzzy); ok {
+		return x.Fuzzy
+	}
+	return nil
+}
+
+func (m *Query) GetNumericRange() *NumericRangeQuery {
+	if x, ok := m.GetQuery().(*Query_NumericRange); ok {
+		return x.NumericRange
+	}
+	return nil
+}
+
+func (m *Query) GetDateRange() *DateRangeQuery {
+	if x, ok := m.GetQuery().(*Query_DateRange); ok {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (m *Query) GetTermRange() *TermRangeQuery {
+	if x, ok := m.GetQuery().(*Query_TermRange); ok {
+		return x.TermRange
+	}
+	return nil
+}
+
+func (m *Query) GetQueryString() *QueryStringQuery {
+	if x, ok := m.GetQuery().(*Query_QueryString); ok {
+		return x.QueryString
+	}
+	return nil
+}
+
+func (m *Query) GetBoolean() *BooleanQuery {
+	if x, ok := m.GetQuery().(*Query_Boolean); ok {
+		return x.Boolean
+	}
+	return nil
+}
+
+func (m *Query) GetConjunction() *ConjunctionQuery {
+	if x, ok := m.GetQuery().(*Query_Conjunction); ok {
+		return x.Conjunction
+	}
+	return nil
+}
+
+func (m *Query) GetDisjunction() *DisjunctionQuery {
+	if x, ok := m.GetQuery().(*Query_Disjunction); ok {
+		return x.Disjunction
+	}
+	return nil
+}
+
+func (m *Query) GetDocId() *DocIDQuery {
+	if x, ok := m.GetQuery().(*Query_DocId); ok {
+		return x.DocId
+	}
+	return nil
+}
+
+func (m *Query) GetBoolField() *BoolFieldQuery {
+	if x, ok := m.GetQuery().(*Query_BoolField); ok {
+		return x.BoolField
+	}
+	return nil
+}
+
+func (m *Query) GetGeoDistance() *GeoDistanceQuery {
+	if x, ok := m.GetQuery().(*Query_GeoDistance); ok {
+		return x.GeoDistance
+	}
+	return nil
+}
+
+func (m *Query) GetGeoBoundingBox() *GeoBoundingBoxQuery {
+	if x, ok := m.GetQuery().(*Query_GeoBoundingBox); ok {
+		return x.GeoBoundingBox
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Query) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Query_MatchAll)(nil),
+		(*Query_MatchNone)(nil),
+		(*Query_Match)(nil),
+		(*Query_MatchPhrase)(nil),
+		(*Query_Term)(nil),
+		(*Query_Phrase)(nil),
+		(*Query_MultiPhrase)(nil),
+		(*Query_Prefix)(nil),
+		(*Query_Wildcard)(nil),
+		(*Query_Regexp)(nil),
+		(*Query_Fuzzy)(nil),
+		(*Query_NumericRange)(nil),
+		(*Query_DateRange)(nil),
+		(*Query_TermRange)(nil),
+		(*Query_QueryString)(nil),
+		(*Query_Boolean)(nil),
+		(*Query_Conjunction)(nil),
+		(*Query_Disjunction)(nil),
+		(*Query_DocId)(nil),
+		(*Query_BoolField)(nil),
+		(*Query_GeoDistance)(nil),
+		(*Query_GeoBoundingBox)(nil),
+	}
+}
+
+type MatchAllQuery struct {
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,1,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MatchAllQuery) Reset()         { *m = MatchAllQuery{} }
+func (m *MatchAllQuery) String() string { return proto.CompactTextString(m) }
+func (*MatchAllQuery) ProtoMessage()    {}
+func (*MatchAllQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{42}
+}
+
+func (m *MatchAllQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchAllQuery.Unmarshal(m, b)
+}
+func (m *MatchAllQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchAllQuery.Marshal(b, m, deterministic)
+}
+func (m *MatchAllQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchAllQuery.Merge(m, src)
+}
+func (m *MatchAllQuery) XXX_Size() int {
+	return xxx_messageInfo_MatchAllQuery.Size(m)
+}
+func (m *MatchAllQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchAllQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchAllQuery proto.InternalMessageInfo
+
+func (m *MatchAllQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type MatchNoneQuery struct {
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,1,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MatchNoneQuery) Reset()         { *m = MatchNoneQuery{} }
+func (m *MatchNoneQuery) String() string { return proto.CompactTextString(m) }
+func (*MatchNoneQuery) ProtoMessage()    {}
+func (*MatchNoneQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{43}
+}
+
+func (m *MatchNoneQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchNoneQuery.Unmarshal(m, b)
+}
+func (m *MatchNoneQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchNoneQuery.Marshal(b, m, deterministic)
+}
+func (m *MatchNoneQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchNoneQuery.Merge(m, src)
+}
+func (m *MatchNoneQuery) XXX_Size() int {
+	return xxx_messageInfo_MatchNoneQuery.Size(m)
+}
+func (m *MatchNoneQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchNoneQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchNoneQuery proto.InternalMessageInfo
+
+func (m *MatchNoneQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type MatchQuery struct {
+	Match                string                `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	Field                string                `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Analyzer             string                `protobuf:"bytes,3,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,4,opt,name=boost,proto3" json:"boost,omitempty"`
+	PrefixLength         int32                 `protobuf:"varint,5,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	Fuzziness            int32                 `protobuf:"varint,6,opt,name=fuzziness,proto3" json:"fuzziness,omitempty"`
+	Operator             MatchQuery_Operator   `protobuf:"varint,7,opt,name=operator,proto3,enum=index.MatchQuery_Operator" json:"operator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MatchQuery) Reset()         { *m = MatchQuery{} }
+func (m *MatchQuery) String() string { return proto.CompactTextString(m) }
+func (*MatchQuery) ProtoMessage()    {}
+func (*MatchQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{44}
+}
+
+func (m *MatchQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchQuery.Unmarshal(m, b)
+}
+func (m *MatchQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchQuery.Marshal(b, m, deterministic)
+}
+func (m *MatchQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchQuery.Merge(m, src)
+}
+func (m *MatchQuery) XXX_Size() int {
+	return xxx_messageInfo_MatchQuery.Size(m)
+}
+func (m *MatchQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchQuery proto.InternalMessageInfo
+
+func (m *MatchQuery) GetMatch() string {
+	if m != nil {
+		return m.Match
+	}
+	return ""
+}
+
+func (m *MatchQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *MatchQuery) GetAnalyzer() string {
+	if m != nil {
+		return m.Analyzer
+	}
+	return ""
+}
+
+func (m *MatchQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+func (m *MatchQuery) GetPrefixLength() int32 {
+	if m != nil {
+		return m.PrefixLength
+	}
+	return 0
+}
+
+func (m *MatchQuery) GetFuzziness() int32 {
+	if m != nil {
+		return m.Fuzziness
+	}
+	return 0
+}
+
+func (m *MatchQuery) GetOperator() MatchQuery_Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return MatchQuery_OR
+}
+
+type MatchPhraseQuery struct {
+	MatchPhrase          string                `protobuf:"bytes,1,opt,name=match_phrase,json=matchPhrase,proto3" json:"match_phrase,omitempty"`
+	Field                string                `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Analyzer             string                `protobuf:"bytes,3,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,4,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MatchPhraseQuery) Reset()         { *m = MatchPhraseQuery{} }
+func (m *MatchPhraseQuery) String() string { return proto.CompactTextString(m) }
+func (*MatchPhraseQuery) ProtoMessage()    {}
+func (*MatchPhraseQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{45}
+}
+
+func (m *MatchPhraseQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchPhraseQuery.Unmarshal(m, b)
+}
+func (m *MatchPhraseQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchPhraseQuery.Marshal(b, m, deterministic)
+}
+func (m *MatchPhraseQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchPhraseQuery.Merge(m, src)
+}
+func (m *MatchPhraseQuery) XXX_Size() int {
+	return xxx_messageInfo_MatchPhraseQuery.Size(m)
+}
+func (m *MatchPhraseQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchPhraseQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchPhraseQuery proto.InternalMessageInfo
+
+func (m *MatchPhraseQuery) GetMatchPhrase() string {
+	if m != nil {
+		return m.MatchPhrase
+	}
+	return ""
+}
+
+func (m *MatchPhraseQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *MatchPhraseQuery) GetAnalyzer() string {
+	if m != nil {
+		return m.Analyzer
+	}
+	return ""
+}
+
+func (m *MatchPhraseQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type TermQuery struct {
+	Term                 string                `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Field                string                `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TermQuery) Reset()         { *m = TermQuery{} }
+func (m *TermQuery) String() string { return proto.CompactTextString(m) }
+func (*TermQuery) ProtoMessage()    {}
+func (*TermQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{46}
+}
+
+func (m *TermQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TermQuery.Unmarshal(m, b)
+}
+func (m *TermQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TermQuery.Marshal(b, m, deterministic)
+}
+func (m *TermQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TermQuery.Merge(m, src)
+}
+func (m *TermQuery) XXX_Size() int {
+	return xxx_messageInfo_TermQuery.Size(m)
+}
+func (m *TermQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_TermQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TermQuery proto.InternalMessageInfo
+
+func (m *TermQuery) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+func (m *TermQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *TermQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type PhraseQuery struct {
+	Terms                []string              `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	Field                string                `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PhraseQuery) Reset()         { *m = PhraseQuery{} }
+func (m *PhraseQuery) String() string { return proto.CompactTextString(m) }
+func (*PhraseQuery) ProtoMessage()    {}
+func (*PhraseQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{47}
+}
+
+func (m *PhraseQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PhraseQuery.Unmarshal(m, b)
+}
+func (m *PhraseQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PhraseQuery.Marshal(b, m, deterministic)
+}
+func (m *PhraseQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhraseQuery.Merge(m, src)
+}
+func (m *PhraseQuery) XXX_Size() int {
+	return xxx_messageInfo_PhraseQuery.Size(m)
+}
+func (m *PhraseQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhraseQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PhraseQuery proto.InternalMessageInfo
+
+func (m *PhraseQuery) GetTerms() []string {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+func (m *PhraseQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *PhraseQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type MultiPhraseQuery struct {
+	Terms                []*MultiPhraseQuery_Terms `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	Field                string                    `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue     `protobuf:"bytes,3,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *MultiPhraseQuery) Reset()         { *m = MultiPhraseQuery{} }
+func (m *MultiPhraseQuery) String() string { return proto.CompactTextString(m) }
+func (*MultiPhraseQuery) ProtoMessage()    {}
+func (*MultiPhraseQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{48}
+}
+
+func (m *MultiPhraseQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiPhraseQuery.Unmarshal(m, b)
+}
+func (m *MultiPhraseQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiPhraseQuery.Marshal(b, m, deterministic)
+}
+func (m *MultiPhraseQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiPhraseQuery.Merge(m, src)
+}
+func (m *MultiPhraseQuery) XXX_Size() int {
+	return xxx_messageInfo_MultiPhraseQuery.Size(m)
+}
+func (m *MultiPhraseQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiPhraseQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiPhraseQuery proto.InternalMessageInfo
+
+func (m *MultiPhraseQuery) GetTerms() []*MultiPhraseQuery_Terms {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+func (m *MultiPhraseQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *MultiPhraseQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type MultiPhraseQuery_Terms struct {
+	Terms                []string `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiPhraseQuery_Terms) Reset()         { *m = MultiPhraseQuery_Terms{} }
+func (m *MultiPhraseQuery_Terms) String() string { return proto.CompactTextString(m) }
+func (*MultiPhraseQuery_Terms) ProtoMessage()    {}
+func (*MultiPhraseQuery_Terms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{48, 0}
+}
+
+func (m *MultiPhraseQuery_Terms) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiPhraseQuery_Terms.Unmarshal(m, b)
+}
+func (m *MultiPhraseQuery_Terms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiPhraseQuery_Terms.Marshal(b, m, deterministic)
+}
+func (m *MultiPhraseQuery_Terms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiPhraseQuery_Terms.Merge(m, src)
+}
+func (m *MultiPhraseQuery_Terms) XXX_Size() int {
+	return xxx_messageInfo_MultiPhraseQuery_Terms.Size(m)
+}
+func (m *MultiPhraseQuery_Terms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiPhraseQuery_Terms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiPhraseQuery_Terms proto.InternalMessageInfo
+
+func (m *MultiPhraseQuery_Terms) GetTerms() []string {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+type PrefixQuery struct {
+	Prefix               string                `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Field                string                `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PrefixQuery) Reset()         { *m = PrefixQuery{} }
+func (m *PrefixQuery) String() string { return proto.CompactTextString(m) }
+func (*PrefixQuery) ProtoMessage()    {}
+func (*PrefixQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{49}
+}
+
+func (m *PrefixQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrefixQuery.Unmarshal(m, b)
+}
+func (m *PrefixQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrefixQuery.Marshal(b, m, deterministic)
+}
+func (m *PrefixQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefixQuery.Merge(m, src)
+}
+func (m *PrefixQuery) XXX_Size() int {
+	return xxx_messageInfo_PrefixQuery.Size(m)
+}
+func (m *PrefixQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefixQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefixQuery proto.InternalMessageInfo
+
+func (m *PrefixQuery) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *PrefixQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *PrefixQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type WildcardQuery struct {
+	Wildcard             string                `protobuf:"bytes,1,opt,name=wildcard,proto3" json:"wildcard,omitempty"`
+	Field                string                `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WildcardQuery) Reset()         { *m = WildcardQuery{} }
+func (m *WildcardQuery) String() string { return proto.CompactTextString(m) }
+func (*WildcardQuery) ProtoMessage()    {}
+func (*WildcardQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{50}
+}
+
+func (m *WildcardQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WildcardQuery.Unmarshal(m, b)
+}
+func (m *WildcardQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WildcardQuery.Marshal(b, m, deterministic)
+}
+func (m *WildcardQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WildcardQuery.Merge(m, src)
+}
+func (m *WildcardQuery) XXX_Size() int {
+	return xxx_messageInfo_WildcardQuery.Size(m)
+}
+func (m *WildcardQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_WildcardQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WildcardQuery proto.InternalMessageInfo
+
+func (m *WildcardQuery) GetWildcard() string {
+	if m != nil {
+		return m.Wildcard
+	}
+	return ""
+}
+
+func (m *WildcardQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *WildcardQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type RegexpQuery struct {
+	Regexp               string                `protobuf:"bytes,1,opt,name=regexp,proto3" json:"regexp,omitempty"`
+	Field                string                `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RegexpQuery) Reset()         { *m = RegexpQuery{} }
+func (m *RegexpQuery) String() string { return proto.CompactTextString(m) }
+func (*RegexpQuery) ProtoMessage()    {}
+func (*RegexpQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{51}
+}
+
+func (m *RegexpQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegexpQuery.Unmarshal(m, b)
+}
+func (m *RegexpQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegexpQuery.Marshal(b, m, deterministic)
+}
+func (m *RegexpQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegexpQuery.Merge(m, src)
+}
+func (m *RegexpQuery) XXX_Size() int {
+	return xxx_messageInfo_RegexpQuery.Size(m)
+}
+func (m *RegexpQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegexpQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegexpQuery proto.InternalMessageInfo
+
+func (m *RegexpQuery) GetRegexp() string {
+	if m != nil {
+		return m.Regexp
+	}
+	return ""
+}
+
+func (m *RegexpQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *RegexpQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type FuzzyQuery struct {
+	Term                 string                `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	PrefixLength         int32                 `protobuf:"varint,2,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	Fuzziness            int32                 `protobuf:"varint,3,opt,name=fuzziness,proto3" json:"fuzziness,omitempty"`
+	Field                string                `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,5,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FuzzyQuery) Reset()         { *m = FuzzyQuery{} }
+func (m *FuzzyQuery) String() string { return proto.CompactTextString(m) }
+func (*FuzzyQuery) ProtoMessage()    {}
+func (*FuzzyQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{52}
+}
+
+func (m *FuzzyQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FuzzyQuery.Unmarshal(m, b)
+}
+func (m *FuzzyQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FuzzyQuery.Marshal(b, m, deterministic)
+}
+func (m *FuzzyQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FuzzyQuery.Merge(m, src)
+}
+func (m *FuzzyQuery) XXX_Size() int {
+	return xxx_messageInfo_FuzzyQuery.Size(m)
+}
+func (m *FuzzyQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_FuzzyQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FuzzyQuery proto.InternalMessageInfo
+
+func (m *FuzzyQuery) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+func (m *FuzzyQuery) GetPrefixLength() int32 {
+	if m != nil {
+		return m.PrefixLength
+	}
+	return 0
+}
+
+func (m *FuzzyQuery) GetFuzziness() int32 {
+	if m != nil {
+		return m.Fuzziness
+	}
+	return 0
+}
+
+func (m *FuzzyQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FuzzyQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type NumericRangeQuery struct {
+	Min                  *wrappers.DoubleValue `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	InclusiveMin         *wrappers.BoolValue   `protobuf:"bytes,3,opt,name=inclusive_min,json=inclusiveMin,proto3" json:"inclusive_min,omitempty"`
+	InclusiveMax         *wrappers.BoolValue   `protobuf:"bytes,4,opt,name=inclusive_max,json=inclusiveMax,proto3" json:"inclusive_max,omitempty"`
+	Field                string                `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,6,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *NumericRangeQuery) Reset()         { *m = NumericRangeQuery{} }
+func (m *NumericRangeQuery) String() string { return proto.CompactTextString(m) }
+func (*NumericRangeQuery) ProtoMessage()    {}
+func (*NumericRangeQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{53}
+}
+
+func (m *NumericRangeQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NumericRangeQuery.Unmarshal(m, b)
+}
+func (m *NumericRangeQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NumericRangeQuery.Marshal(b, m, deterministic)
+}
+func (m *NumericRangeQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NumericRangeQuery.Merge(m, src)
+}
+func (m *NumericRangeQuery) XXX_Size() int {
+	return xxx_messageInfo_NumericRangeQuery.Size(m)
+}
+func (m *NumericRangeQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_NumericRangeQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NumericRangeQuery proto.InternalMessageInfo
+
+func (m *NumericRangeQuery) GetMin() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *NumericRangeQuery) GetMax() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+func (m *NumericRangeQuery) GetInclusiveMin() *wrappers.BoolValue {
+	if m != nil {
+		return m.InclusiveMin
+	}
+	return nil
+}
+
+func (m *NumericRangeQuery) GetInclusiveMax() *wrappers.BoolValue {
+	if m != nil {
+		return m.InclusiveMax
+	}
+	return nil
+}
+
+func (m *NumericRangeQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *NumericRangeQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type DateRangeQuery struct {
+	Start                *timestamp.Timestamp  `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *timestamp.Timestamp  `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	InclusiveStart       *wrappers.BoolValue   `protobuf:"bytes,3,opt,name=inclusive_start,json=inclusiveStart,proto3" json:"inclusive_start,omitempty"`
+	InclusiveEnd         *wrappers.BoolValue   `protobuf:"bytes,4,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`
+	Field                string                `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,6,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DateRangeQuery) Reset()         { *m = DateRangeQuery{} }
+func (m *DateRangeQuery) String() string { return proto.CompactTextString(m) }
+func (*DateRangeQuery) ProtoMessage()    {}
+func (*DateRangeQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{54}
+}
+
+func (m *DateRangeQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DateRangeQuery.Unmarshal(m, b)
+}
+func (m *DateRangeQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DateRangeQuery.Marshal(b, m, deterministic)
+}
+func (m *DateRangeQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DateRangeQuery.Merge(m, src)
+}
+func (m *DateRangeQuery) XXX_Size() int {
+	return xxx_messageInfo_DateRangeQuery.Size(m)
+}
+func (m *DateRangeQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_DateRangeQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DateRangeQuery proto.InternalMessageInfo
+
+func (m *DateRangeQuery) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *DateRangeQuery) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *DateRangeQuery) GetInclusiveStart() *wrappers.BoolValue {
+	if m != nil {
+		return m.InclusiveStart
+	}
+	return nil
+}
+
+func (m *DateRangeQuery) GetInclusiveEnd() *wrappers.BoolValue {
+	if m != nil {
+		return m.InclusiveEnd
+	}
+	return nil
+}
+
+func (m *DateRangeQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *DateRangeQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type TermRangeQuery struct {
+	Min                  string                `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  string                `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	InclusiveMin         *wrappers.BoolValue   `protobuf:"bytes,3,opt,name=inclusive_min,json=inclusiveMin,proto3" json:"inclusive_min,omitempty"`
+	InclusiveMax         *wrappers.BoolValue   `protobuf:"bytes,4,opt,name=inclusive_max,json=inclusiveMax,proto3" json:"inclusive_max,omitempty"`
+	Field                string                `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,6,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TermRangeQuery) Reset()         { *m = TermRangeQuery{} }
+func (m *TermRangeQuery) String() string { return proto.CompactTextString(m) }
+func (*TermRangeQuery) ProtoMessage()    {}
+func (*TermRangeQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{55}
+}
+
+func (m *TermRangeQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TermRangeQuery.Unmarshal(m, b)
+}
+func (m *TermRangeQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TermRangeQuery.Marshal(b, m, deterministic)
+}
+func (m *TermRangeQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TermRangeQuery.Merge(m, src)
+}
+func (m *TermRangeQuery) XXX_Size() int {
+	return xxx_messageInfo_TermRangeQuery.Size(m)
+}
+func (m *TermRangeQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_TermRangeQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TermRangeQuery proto.InternalMessageInfo
+
+func (m *TermRangeQuery) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *TermRangeQuery) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func (m *TermRangeQuery) GetInclusiveMin() *wrappers.BoolValue {
+	if m != nil {
+		return m.InclusiveMin
+	}
+	return nil
+}
+
+func (m *TermRangeQuery) GetInclusiveMax() *wrappers.BoolValue {
+	if m != nil {
+		return m.InclusiveMax
+	}
+	return nil
+}
+
+func (m *TermRangeQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *TermRangeQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type QueryStringQuery struct {
+	Query                string                `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QueryStringQuery) Reset()         { *m = QueryStringQuery{} }
+func (m *QueryStringQuery) String() string { return proto.CompactTextString(m) }
+func (*QueryStringQuery) ProtoMessage()    {}
+func (*QueryStringQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{56}
+}
+
+func (m *QueryStringQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryStringQuery.Unmarshal(m, b)
+}
+func (m *QueryStringQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryStringQuery.Marshal(b, m, deterministic)
+}
+func (m *QueryStringQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStringQuery.Merge(m, src)
+}
+func (m *QueryStringQuery) XXX_Size() int {
+	return xxx_messageInfo_QueryStringQuery.Size(m)
+}
+func (m *QueryStringQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStringQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStringQuery proto.InternalMessageInfo
+
+func (m *QueryStringQuery) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QueryStringQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type BooleanQuery struct {
+	Must                 []*Query              `protobuf:"bytes,1,rep,name=must,proto3" json:"must,omitempty"`
+	Should               []*Query              `protobuf:"bytes,2,rep,name=should,proto3" json:"should,omitempty"`
+	MustNot              []*Query              `protobuf:"bytes,3,rep,name=must_not,json=mustNot,proto3" json:"must_not,omitempty"`
+	MinShould            float64               `protobuf:"fixed64,4,opt,name=min_should,json=minShould,proto3" json:"min_should,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,5,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BooleanQuery) Reset()         { *m = BooleanQuery{} }
+func (m *BooleanQuery) String() string { return proto.CompactTextString(m) }
+func (*BooleanQuery) ProtoMessage()    {}
+func (*BooleanQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{57}
+}
+
+func (m *BooleanQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BooleanQuery.Unmarshal(m, b)
+}
+func (m *BooleanQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BooleanQuery.Marshal(b, m, deterministic)
+}
+func (m *BooleanQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BooleanQuery.Merge(m, src)
+}
+func (m *BooleanQuery) XXX_Size() int {
+	return xxx_messageInfo_BooleanQuery.Size(m)
+}
+func (m *BooleanQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_BooleanQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BooleanQuery proto.InternalMessageInfo
+
+func (m *BooleanQuery) GetMust() []*Query {
+	if m != nil {
+		return m.Must
+	}
+	return nil
+}
+
+func (m *BooleanQuery) GetShould() []*Query {
+	if m != nil {
+		return m.Should
+	}
+	return nil
+}
+
+func (m *BooleanQuery) GetMustNot() []*Query {
+	if m != nil {
+		return m.MustNot
+	}
+	return nil
+}
+
+func (m *BooleanQuery) GetMinShould() float64 {
+	if m != nil {
+		return m.MinShould
+	}
+	return 0
+}
+
+func (m *BooleanQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type ConjunctionQuery struct {
+	Conjuncts            []*Query              `protobuf:"bytes,1,rep,name=conjuncts,proto3" json:"conjuncts,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ConjunctionQuery) Reset()         { *m = ConjunctionQuery{} }
+func (m *ConjunctionQuery) String() string { return proto.CompactTextString(m) }
+func (*ConjunctionQuery) ProtoMessage()    {}
+func (*ConjunctionQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{58}
+}
+
+func (m *ConjunctionQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConjunctionQuery.Unmarshal(m, b)
+}
+func (m *ConjunctionQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConjunctionQuery.Marshal(b, m, deterministic)
+}
+func (m *ConjunctionQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConjunctionQuery.Merge(m, src)
+}
+func (m *ConjunctionQuery) XXX_Size() int {
+	return xxx_messageInfo_ConjunctionQuery.Size(m)
+}
+func (m *ConjunctionQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConjunctionQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConjunctionQuery proto.InternalMessageInfo
+
+func (m *ConjunctionQuery) GetConjuncts() []*Query {
+	if m != nil {
+		return m.Conjuncts
+	}
+	return nil
+}
+
+func (m *ConjunctionQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type DisjunctionQuery struct {
+	Disjuncts            []*Query              `protobuf:"bytes,1,rep,name=disjuncts,proto3" json:"disjuncts,omitempty"`
+	Min                  float64               `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DisjunctionQuery) Reset()         { *m = DisjunctionQuery{} }
+func (m *DisjunctionQuery) String() string { return proto.CompactTextString(m) }
+func (*DisjunctionQuery) ProtoMessage()    {}
+func (*DisjunctionQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{59}
+}
+
+func (m *DisjunctionQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisjunctionQuery.Unmarshal(m, b)
+}
+func (m *DisjunctionQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisjunctionQuery.Marshal(b, m, deterministic)
+}
+func (m *DisjunctionQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisjunctionQuery.Merge(m, src)
+}
+func (m *DisjunctionQuery) XXX_Size() int {
+	return xxx_messageInfo_DisjunctionQuery.Size(m)
+}
+func (m *DisjunctionQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisjunctionQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisjunctionQuery proto.InternalMessageInfo
+
+func (m *DisjunctionQuery) GetDisjuncts() []*Query {
+	if m != nil {
+		return m.Disjuncts
+	}
+	return nil
+}
+
+func (m *DisjunctionQuery) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *DisjunctionQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type DocIDQuery struct {
+	Ids                  []string              `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DocIDQuery) Reset()         { *m = DocIDQuery{} }
+func (m *DocIDQuery) String() string { return proto.CompactTextString(m) }
+func (*DocIDQuery) ProtoMessage()    {}
+func (*DocIDQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{60}
+}
+
+func (m *DocIDQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocIDQuery.Unmarshal(m, b)
+}
+func (m *DocIDQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocIDQuery.Marshal(b, m, deterministic)
+}
+func (m *DocIDQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocIDQuery.Merge(m, src)
+}
+func (m *DocIDQuery) XXX_Size() int {
+	return xxx_messageInfo_DocIDQuery.Size(m)
+}
+func (m *DocIDQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocIDQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocIDQuery proto.InternalMessageInfo
+
+func (m *DocIDQuery) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *DocIDQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type BoolFieldQuery struct {
+	Value                bool                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Field                string                `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BoolFieldQuery) Reset()         { *m = BoolFieldQuery{} }
+func (m *BoolFieldQuery) String() string { return proto.CompactTextString(m) }
+func (*BoolFieldQuery) ProtoMessage()    {}
+func (*BoolFieldQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{61}
+}
+
+func (m *BoolFieldQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoolFieldQuery.Unmarshal(m, b)
+}
+func (m *BoolFieldQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoolFieldQuery.Marshal(b, m, deterministic)
+}
+func (m *BoolFieldQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoolFieldQuery.Merge(m, src)
+}
+func (m *BoolFieldQuery) XXX_Size() int {
+	return xxx_messageInfo_BoolFieldQuery.Size(m)
+}
+func (m *BoolFieldQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoolFieldQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoolFieldQuery proto.InternalMessageInfo
+
+func (m *BoolFieldQuery) GetValue() bool {
+	if m != nil {
+		return m.Value
+	}
+	return false
+}
+
+func (m *BoolFieldQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *BoolFieldQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type GeoPoint struct {
+	Lon                  float64  `protobuf:"fixed64,1,opt,name=lon,proto3" json:"lon,omitempty"`
+	Lat                  float64  `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeoPoint) Reset()         { *m = GeoPoint{} }
+func (m *GeoPoint) String() string { return proto.CompactTextString(m) }
+func (*GeoPoint) ProtoMessage()    {}
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{62}
+}
+
+func (m *GeoPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeoPoint.Unmarshal(m, b)
+}
+func (m *GeoPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeoPoint.Marshal(b, m, deterministic)
+}
+func (m *GeoPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoPoint.Merge(m, src)
+}
+func (m *GeoPoint) XXX_Size() int {
+	return xxx_messageInfo_GeoPoint.Size(m)
+}
+func (m *GeoPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoPoint proto.InternalMessageInfo
+
+func (m *GeoPoint) GetLon() float64 {
+	if m != nil {
+		return m.Lon
+	}
+	return 0
+}
+
+func (m *GeoPoint) GetLat() float64 {
+	if m != nil {
+		return m.Lat
+	}
+	return 0
+}
+
+type GeoDistanceQuery struct {
+	Location             *GeoPoint             `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Distance             string                `protobuf:"bytes,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Field                string                `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,4,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GeoDistanceQuery) Reset()         { *m = GeoDistanceQuery{} }
+func (m *GeoDistanceQuery) String() string { return proto.CompactTextString(m) }
+func (*GeoDistanceQuery) ProtoMessage()    {}
+func (*GeoDistanceQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{63}
+}
+
+func (m *GeoDistanceQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeoDistanceQuery.Unmarshal(m, b)
+}
+func (m *GeoDistanceQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeoDistanceQuery.Marshal(b, m, deterministic)
+}
+func (m *GeoDistanceQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoDistanceQuery.Merge(m, src)
+}
+func (m *GeoDistanceQuery) XXX_Size() int {
+	return xxx_messageInfo_GeoDistanceQuery.Size(m)
+}
+func (m *GeoDistanceQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoDistanceQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoDistanceQuery proto.InternalMessageInfo
+
+func (m *GeoDistanceQuery) GetLocation() *GeoPoint {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (m *GeoDistanceQuery) GetDistance() string {
+	if m != nil {
+		return m.Distance
+	}
+	return ""
+}
+
+func (m *GeoDistanceQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *GeoDistanceQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type GeoBoundingBoxQuery struct {
+	TopLeft              *GeoPoint             `protobuf:"bytes,1,opt,name=top_left,json=topLeft,proto3" json:"top_left,omitempty"`
+	BottomRight          *GeoPoint             `protobuf:"bytes,2,opt,name=bottom_right,json=bottomRight,proto3" json:"bottom_right,omitempty"`
+	Field                string                `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Boost                *wrappers.DoubleValue `protobuf:"bytes,4,opt,name=boost,proto3" json:"boost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GeoBoundingBoxQuery) Reset()         { *m = GeoBoundingBoxQuery{} }
+func (m *GeoBoundingBoxQuery) String() string { return proto.CompactTextString(m) }
+func (*GeoBoundingBoxQuery) ProtoMessage()    {}
+func (*GeoBoundingBoxQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{64}
+}
+
+func (m *GeoBoundingBoxQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeoBoundingBoxQuery.Unmarshal(m, b)
+}
+func (m *GeoBoundingBoxQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeoBoundingBoxQuery.Marshal(b, m, deterministic)
+}
+func (m *GeoBoundingBoxQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoBoundingBoxQuery.Merge(m, src)
+}
+func (m *GeoBoundingBoxQuery) XXX_Size() int {
+	return xxx_messageInfo_GeoBoundingBoxQuery.Size(m)
+}
+func (m *GeoBoundingBoxQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoBoundingBoxQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoBoundingBoxQuery proto.InternalMessageInfo
+
+func (m *GeoBoundingBoxQuery) GetTopLeft() *GeoPoint {
+	if m != nil {
+		return m.TopLeft
+	}
+	return nil
+}
+
+func (m *GeoBoundingBoxQuery) GetBottomRight() *GeoPoint {
+	if m != nil {
+		return m.BottomRight
+	}
+	return nil
+}
+
+func (m *GeoBoundingBoxQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *GeoBoundingBoxQuery) GetBoost() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Boost
+	}
+	return nil
+}
+
+type SortField struct {
+	// Types that are valid to be assigned to By:
+	//	*SortField_Score_
+	//	*SortField_Id
+	//	*SortField_Field_
+	//	*SortField_GeoDistance_
+	By                   isSortField_By `protobuf_oneof:"by"`
+	Desc                 bool           `protobuf:"varint,5,opt,name=desc,proto3" json:"desc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SortField) Reset()         { *m = SortField{} }
+func (m *SortField) String() string { return proto.CompactTextString(m) }
+func (*SortField) ProtoMessage()    {}
+func (*SortField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{65}
+}
+
+func (m *SortField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortField.Unmarshal(m, b)
+}
+func (m *SortField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortField.Marshal(b, m, deterministic)
+}
+func (m *SortField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortField.Merge(m, src)
+}
+func (m *SortField) XXX_Size() int {
+	return xxx_messageInfo_SortField.Size(m)
+}
+func (m *SortField) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortField proto.InternalMessageInfo
+
+type isSortField_By interface {
+	isSortField_By()
+}
+
+type SortField_Score_ struct {
+	Score *SortField_Score `protobuf:"bytes,1,opt,name=score,proto3,oneof"`
+}
+
+type SortField_Id struct {
+	Id *SortField_ID `protobuf:"bytes,2,opt,name=id,proto3,oneof"`
+}
+
+type SortField_Field_ struct {
+	Field *SortField_Field `protobuf:"bytes,3,opt,name=field,proto3,oneof"`
+}
+
+type SortField_GeoDistance_ struct {
+	GeoDistance *SortField_GeoDistance `protobuf:"bytes,4,opt,name=geo_distance,json=geoDistance,proto3,oneof"`
+}
+
+func (*SortField_Score_) isSortField_By() {}
+
+func (*SortField_Id) isSortField_By() {}
+
+func (*SortField_Field_) isSortField_By() {}
+
+func (*SortField_GeoDistance_) isSortField_By() {}
+
+func (m *SortField) GetBy() isSortField_By {
+	if m != nil {
+		return m.By
+	}
+	return nil
+}
+
+func (m *SortField) GetScore() *SortField_Score {
+	if x, ok := m.GetBy().(*SortField_Score_); ok {
+		return x.Score
+	}
+	return nil
+}
+
+func (m *SortField) GetId() *SortField_ID {
+	if x, ok := m.GetBy().(*SortField_Id); ok {
+		return x.Id
+	}
+	return nil
+}
+
+func (m *SortField) GetField() *SortField_Field {
+	if x, ok := m.GetBy().(*SortField_Field_); ok {
+		return x.Field
+	}
+	return nil
+}
+
+func (m *SortField) GetGeoDistance() *SortField_GeoDistance {
+	if x, ok := m.GetBy().(*SortField_GeoDistance_); ok {
+		return x.GeoDistance
+	}
+	return nil
+}
+
+func (m *SortField) GetDesc() bool {
+	if m != nil {
+		return m.Desc
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SortField) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SortField_Score_)(nil),
+		(*SortField_Id)(nil),
+		(*SortField_Field_)(nil),
+		(*SortField_GeoDistance_)(nil),
+	}
+}
+
+type SortField_Score struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SortField_Score) Reset()         { *m = SortField_Score{} }
+func (m *SortField_Score) String() string { return proto.CompactTextString(m) }
+func (*SortField_Score) ProtoMessage()    {}
+func (*SortField_Score) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{65, 0}
+}
+
+func (m *SortField_Score) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortField_Score.Unmarshal(m, b)
+}
+func (m *SortField_Score) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortField_Score.Marshal(b, m, deterministic)
+}
+func (m *SortField_Score) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortField_Score.Merge(m, src)
+}
+func (m *SortField_Score) XXX_Size() int {
+	return xxx_messageInfo_SortField_Score.Size(m)
+}
+func (m *SortField_Score) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortField_Score.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortField_Score proto.InternalMessageInfo
+
+type SortField_ID struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SortField_ID) Reset()         { *m = SortField_ID{} }
+func (m *SortField_ID) String() string { return proto.CompactTextString(m) }
+func (*SortField_ID) ProtoMessage()    {}
+func (*SortField_ID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{65, 1}
+}
+
+func (m *SortField_ID) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortField_ID.Unmarshal(m, b)
+}
+func (m *SortField_ID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortField_ID.Marshal(b, m, deterministic)
+}
+func (m *SortField_ID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortField_ID.Merge(m, src)
+}
+func (m *SortField_ID) XXX_Size() int {
+	return xxx_messageInfo_SortField_ID.Size(m)
+}
+func (m *SortField_ID) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortField_ID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortField_ID proto.InternalMessageInfo
+
+type SortField_Field struct {
+	Field                string            `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Type                 SortField_Type    `protobuf:"varint,2,opt,name=type,proto3,enum=index.SortField_Type" json:"type,omitempty"`
+	Mode                 SortField_Mode    `protobuf:"varint,3,opt,name=mode,proto3,enum=index.SortField_Mode" json:"mode,omitempty"`
+	Missing              SortField_Missing `protobuf:"varint,4,opt,name=missing,proto3,enum=index.SortField_Missing" json:"missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SortField_Field) Reset()         { *m = SortField_Field{} }
+func (m *SortField_Field) String() string { return proto.CompactTextString(m) }
+func (*SortField_Field) ProtoMessage()    {}
+func (*SortField_Field) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{65, 2}
+}
+
+func (m *SortField_Field) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortField_Field.Unmarshal(m, b)
+}
+func (m *SortField_Field) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortField_Field.Marshal(b, m, deterministic)
+}
+func (m *SortField_Field) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortField_Field.Merge(m, src)
+}
+func (m *SortField_Field) XXX_Size() int {
+	return xxx_messageInfo_SortField_Field.Size(m)
+}
+func (m *SortField_Field) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortField_Field.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortField_Field proto.InternalMessageInfo
+
+func (m *SortField_Field) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *SortField_Field) GetType() SortField_Type {
+	if m != nil {
+		return m.Type
+	}
+	return SortField_AUTO
+}
+
+func (m *SortField_Field) GetMode() SortField_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return SortField_DEFAULT
+}
+
+func (m *SortField_Field) GetMissing() SortField_Missing {
+	if m != nil {
+		return m.Missing
+	}
+	return SortField_LAST
+}
+
+type SortField_GeoDistance struct {
+	Field                string    `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Location             *GeoPoint `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Unit                 string    `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SortField_GeoDistance) Reset()         { *m = SortField_GeoDistance{} }
+func (m *SortField_GeoDistance) String() string { return proto.CompactTextString(m) }
+func (*SortField_GeoDistance) ProtoMessage()    {}
+func (*SortField_GeoDistance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{65, 3}
+}
+
+func (m *SortField_GeoDistance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortField_GeoDistance.Unmarshal(m, b)
+}
+func (m *SortField_GeoDistance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortField_GeoDistance.Marshal(b, m, deterministic)
+}
+func (m *SortField_GeoDistance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortField_GeoDistance.Merge(m, src)
+}
+func (m *SortField_GeoDistance) XXX_Size() int {
+	return xxx_messageInfo_SortField_GeoDistance.Size(m)
+}
+func (m *SortField_GeoDistance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortField_GeoDistance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortField_GeoDistance proto.InternalMessageInfo
+
+func (m *SortField_GeoDistance) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *SortField_GeoDistance) GetLocation() *GeoPoint {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (m *SortField_GeoDistance) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+type HighlightRequest struct {
+	Style                string   `protobuf:"bytes,1,opt,name=style,proto3" json:"style,omitempty"`
+	Fields               []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HighlightRequest) Reset()         { *m = HighlightRequest{} }
+func (m *HighlightRequest) String() string { return proto.CompactTextString(m) }
+func (*HighlightRequest) ProtoMessage()    {}
+func (*HighlightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{66}
+}
+
+func (m *HighlightRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HighlightRequest.Unmarshal(m, b)
+}
+func (m *HighlightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HighlightRequest.Marshal(b, m, deterministic)
+}
+func (m *HighlightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighlightRequest.Merge(m, src)
+}
+func (m *HighlightRequest) XXX_Size() int {
+	return xxx_messageInfo_HighlightRequest.Size(m)
+}
+func (m *HighlightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighlightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HighlightRequest proto.InternalMessageInfo
+
+func (m *HighlightRequest) GetStyle() string {
+	if m != nil {
+		return m.Style
+	}
+	return ""
+}
+
+func (m *HighlightRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type FacetRequest struct {
+	Field                string                       `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Size                 int32                        `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	NumericRanges        []*FacetRequest_NumericRange `protobuf:"bytes,3,rep,name=numeric_ranges,json=numericRanges,proto3" json:"numeric_ranges,omitempty"`
+	DateRanges           []*FacetRequest_DateRange    `protobuf:"bytes,4,rep,name=date_ranges,json=dateRanges,proto3" json:"date_ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *FacetRequest) Reset()         { *m = FacetRequest{} }
+func (m *FacetRequest) String() string { return proto.CompactTextString(m) }
+func (*FacetRequest) ProtoMessage()    {}
+func (*FacetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{67}
+}
+
+func (m *FacetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FacetRequest.Unmarshal(m, b)
+}
+func (m *FacetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FacetRequest.Marshal(b, m, deterministic)
+}
+func (m *FacetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetRequest.Merge(m, src)
+}
+func (m *FacetRequest) XXX_Size() int {
+	return xxx_messageInfo_FacetRequest.Size(m)
+}
+func (m *FacetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FacetRequest proto.InternalMessageInfo
+
+func (m *FacetRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FacetRequest) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *FacetRequest) GetNumericRanges() []*FacetRequest_NumericRange {
+	if m != nil {
+		return m.NumericRanges
+	}
+	return nil
+}
+
+func (m *FacetRequest) GetDateRanges() []*FacetRequest_DateRange {
+	if m != nil {
+		return m.DateRanges
+	}
+	return nil
+}
+
+type FacetRequest_NumericRange struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Min                  *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FacetRequest_NumericRange) Reset()         { *m = FacetRequest_NumericRange{} }
+func (m *FacetRequest_NumericRange) String() string { return proto.CompactTextString(m) }
+func (*FacetRequest_NumericRange) ProtoMessage()    {}
+func (*FacetRequest_NumericRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{67, 0}
+}
+
+func (m *FacetRequest_NumericRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FacetRequest_NumericRange.Unmarshal(m, b)
+}
+func (m *FacetRequest_NumericRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FacetRequest_NumericRange.Marshal(b, m, deterministic)
+}
+func (m *FacetRequest_NumericRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetRequest_NumericRange.Merge(m, src)
+}
+func (m *FacetRequest_NumericRange) XXX_Size() int {
+	return xxx_messageInfo_FacetRequest_NumericRange.Size(m)
+}
+func (m *FacetRequest_NumericRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetRequest_NumericRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FacetRequest_NumericRange proto.InternalMessageInfo
+
+func (m *FacetRequest_NumericRange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FacetRequest_NumericRange) GetMin() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *FacetRequest_NumericRange) GetMax() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+type FacetRequest_DateRange struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Start                *wrappers.StringValue `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *wrappers.StringValue `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FacetRequest_DateRange) Reset()         { *m = FacetRequest_DateRange{} }
+func (m *FacetRequest_DateRange) String() string { return proto.CompactTextString(m) }
+func (*FacetRequest_DateRange) ProtoMessage()    {}
+func (*FacetRequest_DateRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{67, 1}
+}
+
+func (m *FacetRequest_DateRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FacetRequest_DateRange.Unmarshal(m, b)
+}
+func (m *FacetRequest_DateRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FacetRequest_DateRange.Marshal(b, m, deterministic)
+}
+func (m *FacetRequest_DateRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetRequest_DateRange.Merge(m, src)
+}
+func (m *FacetRequest_DateRange) XXX_Size() int {
+	return xxx_messageInfo_FacetRequest_DateRange.Size(m)
+}
+func (m *FacetRequest_DateRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetRequest_DateRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FacetRequest_DateRange proto.InternalMessageInfo
+
+func (m *FacetRequest_DateRange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FacetRequest_DateRange) GetStart() *wrappers.StringValue {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *FacetRequest_DateRange) GetEnd() *wrappers.StringValue {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+type SearchStatus struct {
+	Total                int32             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Failed               int32             `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Successful           int32             `protobuf:"varint,3,opt,name=successful,proto3" json:"successful,omitempty"`
+	Errors               map[string]string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SearchStatus) Reset()         { *m = SearchStatus{} }
+func (m *SearchStatus) String() string { return proto.CompactTextString(m) }
+func (*SearchStatus) ProtoMessage()    {}
+func (*SearchStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{68}
+}
+
+func (m *SearchStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchStatus.Unmarshal(m, b)
+}
+func (m *SearchStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchStatus.Marshal(b, m, deterministic)
+}
+func (m *SearchStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchStatus.Merge(m, src)
+}
+func (m *SearchStatus) XXX_Size() int {
+	return xxx_messageInfo_SearchStatus.Size(m)
+}
+func (m *SearchStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchStatus proto.InternalMessageInfo
+
+func (m *SearchStatus) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *SearchStatus) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *SearchStatus) GetSuccessful() int32 {
+	if m != nil {
+		return m.Successful
+	}
+	return 0
+}
+
+func (m *SearchStatus) GetErrors() map[string]string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type DocumentMatch struct {
+	Index                string                                  `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id                   string                                  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Score                float64                                 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Explanation          *Explanation                            `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Locations            map[string]*DocumentMatch_TermLocations `protobuf:"bytes,5,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Fragments            map[string]*DocumentMatch_Fragments     `protobuf:"bytes,6,rep,name=fragments,proto3" json:"fragments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Sort                 []string                                `protobuf:"bytes,7,rep,name=sort,proto3" json:"sort,omitempty"`
+	Fields               *_struct.Struct                         `protobuf:"bytes,8,opt,name=fields,proto3" json:"fields,omitempty"`
+	Source               *_struct.Struct                         `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *DocumentMatch) Reset()         { *m = DocumentMatch{} }
+func (m *DocumentMatch) String() string { return proto.CompactTextString(m) }
+func (*DocumentMatch) ProtoMessage()    {}
+func (*DocumentMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{69}
+}
+
+func (m *DocumentMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentMatch.Unmarshal(m, b)
+}
+func (m *DocumentMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentMatch.Marshal(b, m, deterministic)
+}
+func (m *DocumentMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentMatch.Merge(m, src)
+}
+func (m *DocumentMatch) XXX_Size() int {
+	return xxx_messageInfo_DocumentMatch.Size(m)
+}
+func (m *DocumentMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentMatch proto.InternalMessageInfo
+
+func (m *DocumentMatch) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *DocumentMatch) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DocumentMatch) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *DocumentMatch) GetExplanation() *Explanation {
+	if m != nil {
+		return m.Explanation
+	}
+	return nil
+}
+
+func (m *DocumentMatch) GetLocations() map[string]*DocumentMatch_TermLocations {
+	if m != nil {
+		return m.Locations
+	}
+	return nil
+}
+
+func (m *DocumentMatch) GetFragments() map[string]*DocumentMatch_Fragments {
+	if m != nil {
+		return m.Fragments
+	}
+	return nil
+}
+
+func (m *DocumentMatch) GetSort() []string {
+	if m != nil {
+		return m.Sort
+	}
+	return nil
+}
+
+func (m *DocumentMatch) GetFields() *_struct.Struct {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *DocumentMatch) GetSource() *_struct.Struct {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+type DocumentMatch_Location struct {
+	Pos                  uint64   `protobuf:"varint,1,opt,name=pos,proto3" json:"pos,omitempty"`
+	Start                uint64   `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  uint64   `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	ArrayPositions       []uint64 `protobuf:"varint,4,rep,packed,name=array_positions,json=arrayPositions,proto3" json:"array_positions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentMatch_Location) Reset()         { *m = DocumentMatch_Location{} }
+func (m *DocumentMatch_Location) String() string { return proto.CompactTextString(m) }
+func (*DocumentMatch_Location) ProtoMessage()    {}
+func (*DocumentMatch_Location) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{69, 0}
+}
+
+func (m *DocumentMatch_Location) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentMatch_Location.Unmarshal(m, b)
+}
+func (m *DocumentMatch_Location) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentMatch_Location.Marshal(b, m, deterministic)
+}
+func (m *DocumentMatch_Location) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentMatch_Location.Merge(m, src)
+}
+func (m *DocumentMatch_Location) XXX_Size() int {
+	return xxx_messageInfo_DocumentMatch_Location.Size(m)
+}
+func (m *DocumentMatch_Location) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentMatch_Location.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentMatch_Location proto.InternalMessageInfo
+
+func (m *DocumentMatch_Location) GetPos() uint64 {
+	if m != nil {
+		return m.Pos
+	}
+	return 0
+}
+
+func (m *DocumentMatch_Location) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *DocumentMatch_Location) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *DocumentMatch_Location) GetArrayPositions() []uint64 {
+	if m != nil {
+		return m.ArrayPositions
+	}
+	return nil
+}
+
+type DocumentMatch_Locations struct {
+	Locations            []*DocumentMatch_Location `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *DocumentMatch_Locations) Reset()         { *m = DocumentMatch_Locations{} }
+func (m *DocumentMatch_Locations) String() string { return proto.CompactTextString(m) }
+func (*DocumentMatch_Locations) ProtoMessage()    {}
+func (*DocumentMatch_Locations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{69, 1}
+}
+
+func (m *DocumentMatch_Locations) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentMatch_Locations.Unmarshal(m, b)
+}
+func (m *DocumentMatch_Locations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentMatch_Locations.Marshal(b, m, deterministic)
+}
+func (m *DocumentMatch_Locations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentMatch_Locations.Merge(m, src)
+}
+func (m *DocumentMatch_Locations) XXX_Size() int {
+	return xxx_messageInfo_DocumentMatch_Locations.Size(m)
+}
+func (m *DocumentMatch_Locations) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentMatch_Locations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentMatch_Locations proto.InternalMessageInfo
+
+func (m *DocumentMatch_Locations) GetLocations() []*DocumentMatch_Location {
+	if m != nil {
+		return m.Locations
+	}
+	return nil
+}
+
+type DocumentMatch_TermLocations struct {
+	Terms                map[string]*DocumentMatch_Locations `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *DocumentMatch_TermLocations) Reset()         { *m = DocumentMatch_TermLocations{} }
+func (m *DocumentMatch_TermLocations) String() string { return proto.CompactTextString(m) }
+func (*DocumentMatch_TermLocations) ProtoMessage()    {}
+func (*DocumentMatch_TermLocations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{69, 2}
+}
+
+func (m *DocumentMatch_TermLocations) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentMatch_TermLocations.Unmarshal(m, b)
+}
+func (m *DocumentMatch_TermLocations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentMatch_TermLocations.Marshal(b, m, deterministic)
+}
+func (m *DocumentMatch_TermLocations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentMatch_TermLocations.Merge(m, src)
+}
+func (m *DocumentMatch_TermLocations) XXX_Size() int {
+	return xxx_messageInfo_DocumentMatch_TermLocations.Size(m)
+}
+func (m *DocumentMatch_TermLocations) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentMatch_TermLocations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentMatch_TermLocations proto.InternalMessageInfo
+
+func (m *DocumentMatch_TermLocations) GetTerms() map[string]*DocumentMatch_Locations {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+type DocumentMatch_Fragments struct {
+	Fragments            []string `protobuf:"bytes,1,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentMatch_Fragments) Reset()         { *m = DocumentMatch_Fragments{} }
+func (m *DocumentMatch_Fragments) String() string { return proto.CompactTextString(m) }
+func (*DocumentMatch_Fragments) ProtoMessage()    {}
+func (*DocumentMatch_Fragments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{69, 3}
+}
+
+func (m *DocumentMatch_Fragments) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentMatch_Fragments.Unmarshal(m, b)
+}
+func (m *DocumentMatch_Fragments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentMatch_Fragments.Marshal(b, m, deterministic)
+}
+func (m *DocumentMatch_Fragments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentMatch_Fragments.Merge(m, src)
+}
+func (m *DocumentMatch_Fragments) XXX_Size() int {
+	return xxx_messageInfo_DocumentMatch_Fragments.Size(m)
+}
+func (m *DocumentMatch_Fragments) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentMatch_Fragments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentMatch_Fragments proto.InternalMessageInfo
+
+func (m *DocumentMatch_Fragments) GetFragments() []string {
+	if m != nil {
+		return m.Fragments
+	}
+	return nil
+}
+
+type Explanation struct {
+	Value                float64        `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Message              string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Children             []*Explanation `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Explanation) Reset()         { *m = Explanation{} }
+func (m *Explanation) String() string { return proto.CompactTextString(m) }
+func (*Explanation) ProtoMessage()    {}
+func (*Explanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{70}
+}
+
+func (m *Explanation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Explanation.Unmarshal(m, b)
+}
+func (m *Explanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Explanation.Marshal(b, m, deterministic)
+}
+func (m *Explanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Explanation.Merge(m, src)
+}
+func (m *Explanation) XXX_Size() int {
+	return xxx_messageInfo_Explanation.Size(m)
+}
+func (m *Explanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Explanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Explanation proto.InternalMessageInfo
+
+func (m *Explanation) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Explanation) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Explanation) GetChildren() []*Explanation {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type FacetResult struct {
+	Field                string                           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Total                int32                            `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Missing              int32                            `protobuf:"varint,3,opt,name=missing,proto3" json:"missing,omitempty"`
+	Other                int32                            `protobuf:"varint,4,opt,name=other,proto3" json:"other,omitempty"`
+	Terms                []*FacetResult_TermFacet         `protobuf:"bytes,5,rep,name=terms,proto3" json:"terms,omitempty"`
+	NumericRanges        []*FacetResult_NumericRangeFacet `protobuf:"bytes,6,rep,name=numeric_ranges,json=numericRanges,proto3" json:"numeric_ranges,omitempty"`
+	DateRanges           []*FacetResult_DateRangeFacet    `protobuf:"bytes,7,rep,name=date_ranges,json=dateRanges,proto3" json:"date_ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *FacetResult) Reset()         { *m = FacetResult{} }
+func (m *FacetResult) String() string { return proto.CompactTextString(m) }
+func (*FacetResult) ProtoMessage()    {}
+func (*FacetResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{71}
+}
+
+func (m *FacetResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FacetResult.Unmarshal(m, b)
+}
+func (m *FacetResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FacetResult.Marshal(b, m, deterministic)
+}
+func (m *FacetResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetResult.Merge(m, src)
+}
+func (m *FacetResult) XXX_Size() int {
+	return xxx_messageInfo_FacetResult.Size(m)
+}
+func (m *FacetResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FacetResult proto.InternalMessageInfo
+
+func (m *FacetResult) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FacetResult) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *FacetResult) GetMissing() int32 {
+	if m != nil {
+		return m.Missing
+	}
+	return 0
+}
+
+func (m *FacetResult) GetOther() int32 {
+	if m != nil {
+		return m.Other
+	}
+	return 0
+}
+
+func (m *FacetResult) GetTerms() []*FacetResult_TermFacet {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+func (m *FacetResult) GetNumericRanges() []*FacetResult_NumericRangeFacet {
+	if m != nil {
+		return m.NumericRanges
+	}
+	return nil
+}
+
+func (m *FacetResult) GetDateRanges() []*FacetResult_DateRangeFacet {
+	if m != nil {
+		return m.DateRanges
+	}
+	return nil
+}
+
+type FacetResult_TermFacet struct {
+	Term                 string   `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FacetResult_TermFacet) Reset()         { *m = FacetResult_TermFacet{} }
+func (m *FacetResult_TermFacet) String() string { return proto.CompactTextString(m) }
+func (*FacetResult_TermFacet) ProtoMessage()    {}
+func (*FacetResult_TermFacet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{71, 0}
+}
+
+func (m *FacetResult_TermFacet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FacetResult_TermFacet.Unmarshal(m, b)
+}
+func (m *FacetResult_TermFacet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FacetResult_TermFacet.Marshal(b, m, deterministic)
+}
+func (m *FacetResult_TermFacet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetResult_TermFacet.Merge(m, src)
+}
+func (m *FacetResult_TermFacet) XXX_Size() int {
+	return xxx_messageInfo_FacetResult_TermFacet.Size(m)
+}
+func (m *FacetResult_TermFacet) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetResult_TermFacet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FacetResult_TermFacet proto.InternalMessageInfo
+
+func (m *FacetResult_TermFacet) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+func (m *FacetResult_TermFacet) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type FacetResult_NumericRangeFacet struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Min                  *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	Count                int32                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FacetResult_NumericRangeFacet) Reset()         { *m = FacetResult_NumericRangeFacet{} }
+func (m *FacetResult_NumericRangeFacet) String() string { return proto.CompactTextString(m) }
+func (*FacetResult_NumericRangeFacet) ProtoMessage()    {}
+func (*FacetResult_NumericRangeFacet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{71, 1}
+}
+
+func (m *FacetResult_NumericRangeFacet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FacetResult_NumericRangeFacet.Unmarshal(m, b)
+}
+func (m *FacetResult_NumericRangeFacet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FacetResult_NumericRangeFacet.Marshal(b, m, deterministic)
+}
+func (m *FacetResult_NumericRangeFacet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetResult_NumericRangeFacet.Merge(m, src)
+}
+func (m *FacetResult_NumericRangeFacet) XXX_Size() int {
+	return xxx_messageInfo_FacetResult_NumericRangeFacet.Size(m)
+}
+func (m *FacetResult_NumericRangeFacet) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetResult_NumericRangeFacet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FacetResult_NumericRangeFacet proto.InternalMessageInfo
+
+func (m *FacetResult_NumericRangeFacet) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FacetResult_NumericRangeFacet) GetMin() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *FacetResult_NumericRangeFacet) GetMax() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+func (m *FacetResult_NumericRangeFacet) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type FacetResult_DateRangeFacet struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Start                *wrappers.StringValue `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *wrappers.StringValue `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Count                int32                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FacetResult_DateRangeFacet) Reset()         { *m = FacetResult_DateRangeFacet{} }
+func (m *FacetResult_DateRangeFacet) String() string { return proto.CompactTextString(m) }
+func (*FacetResult_DateRangeFacet) ProtoMessage()    {}
+func (*FacetResult_DateRangeFacet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{71, 2}
+}
+
+func (m *FacetResult_DateRangeFacet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FacetResult_DateRangeFacet.Unmarshal(m, b)
+}
+func (m *FacetResult_DateRangeFacet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FacetResult_DateRangeFacet.Marshal(b, m, deterministic)
+}
+func (m *FacetResult_DateRangeFacet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetResult_DateRangeFacet.Merge(m, src)
+}
+func (m *FacetResult_DateRangeFacet) XXX_Size() int {
+	return xxx_messageInfo_FacetResult_DateRangeFacet.Size(m)
+}
+func (m *FacetResult_DateRangeFacet) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetResult_DateRangeFacet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FacetResult_DateRangeFacet proto.InternalMessageInfo
+
+func (m *FacetResult_DateRangeFacet) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FacetResult_DateRangeFacet) GetStart() *wrappers.StringValue {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *FacetResult_DateRangeFacet) GetEnd() *wrappers.StringValue {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *FacetResult_DateRangeFacet) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type IndexMapping struct {
+	IndexMapping         *any.Any `protobuf:"bytes,1,opt,name=index_mapping,json=indexMapping,proto3" json:"index_mapping,omitempty"`
+	Index                string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexMapping) Reset()         { *m = IndexMapping{} }
+func (m *IndexMapping) String() string { return proto.CompactTextString(m) }
+func (*IndexMapping) ProtoMessage()    {}
+func (*IndexMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{72}
+}
+
+func (m *IndexMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexMapping.Unmarshal(m, b)
+}
+func (m *IndexMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexMapping.Marshal(b, m, deterministic)
+}
+func (m *IndexMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexMapping.Merge(m, src)
+}
+func (m *IndexMapping) XXX_Size() int {
+	return xxx_messageInfo_IndexMapping.Size(m)
+}
+func (m *IndexMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexMapping proto.InternalMessageInfo
+
+func (m *IndexMapping) GetIndexMapping() *any.Any {
+	if m != nil {
+		return m.IndexMapping
+	}
+	return nil
+}
+
+func (m *IndexMapping) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type IndexInfo struct {
+	Name             string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IndexMapping     *any.Any `protobuf:"bytes,2,opt,name=index_mapping,json=indexMapping,proto3" json:"index_mapping,omitempty"`
+	IndexStorageType string   `protobuf:"bytes,3,opt,name=index_storage_type,json=indexStorageType,proto3" json:"index_storage_type,omitempty"`
+	// the seconds the documents written without a ttl or expire_at expire in, never if 0
+	DefaultTtl           int64    `protobuf:"varint,4,opt,name=default_ttl,json=defaultTtl,proto3" json:"default_ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
+func (m *IndexInfo) String() string { return proto.CompactTextString(m) }
+func (*IndexInfo) ProtoMessage()    {}
+func (*IndexInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{73}
+}
+
+func (m *IndexInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexInfo.Unmarshal(m, b)
+}
+func (m *IndexInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexInfo.Marshal(b, m, deterministic)
+}
+func (m *IndexInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexInfo.Merge(m, src)
+}
+func (m *IndexInfo) XXX_Size() int {
+	return xxx_messageInfo_IndexInfo.Size(m)
+}
+func (m *IndexInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexInfo proto.InternalMessageInfo
+
+func (m *IndexInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IndexInfo) GetIndexMapping() *any.Any {
+	if m != nil {
+		return m.IndexMapping
+	}
+	return nil
+}
+
+func (m *IndexInfo) GetIndexStorageType() string {
+	if m != nil {
+		return m.IndexStorageType
+	}
+	return ""
+}
+
+func (m *IndexInfo) GetDefaultTtl() int64 {
+	if m != nil {
+		return m.DefaultTtl
+	}
+	return 0
+}
+
+type IndexList struct {
+	Indexes              []*IndexInfo `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *IndexList) Reset()         { *m = IndexList{} }
+func (m *IndexList) String() string { return proto.CompactTextString(m) }
+func (*IndexList) ProtoMessage()    {}
+func (*IndexList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{74}
+}
+
+func (m *IndexList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexList.Unmarshal(m, b)
+}
+func (m *IndexList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexList.Marshal(b, m, deterministic)
+}
+func (m *IndexList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexList.Merge(m, src)
+}
+func (m *IndexList) XXX_Size() int {
+	return xxx_messageInfo_IndexList.Size(m)
+}
+func (m *IndexList) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexList proto.InternalMessageInfo
+
+func (m *IndexList) GetIndexes() []*IndexInfo {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type Alias struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Indexes              []string `protobuf:"bytes,2,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Alias) Reset()         { *m = Alias{} }
+func (m *Alias) String() string { return proto.CompactTextString(m) }
+func (*Alias) ProtoMessage()    {}
+func (*Alias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{75}
+}
+
+func (m *Alias) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Alias.Unmarshal(m, b)
+}
+func (m *Alias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Alias.Marshal(b, m, deterministic)
+}
+func (m *Alias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Alias.Merge(m, src)
+}
+func (m *Alias) XXX_Size() int {
+	return xxx_messageInfo_Alias.Size(m)
+}
+func (m *Alias) XXX_DiscardUnknown() {
+	xxx_messageInfo_Alias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Alias proto.InternalMessageInfo
+
+func (m *Alias) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Alias) GetIndexes() []string {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type AliasList struct {
+	Aliases              []*Alias `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AliasList) Reset()         { *m = AliasList{} }
+func (m *AliasList) String() string { return proto.CompactTextString(m) }
+func (*AliasList) ProtoMessage()    {}
+func (*AliasList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{76}
+}
+
+func (m *AliasList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AliasList.Unmarshal(m, b)
+}
+func (m *AliasList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AliasList.Marshal(b, m, deterministic)
+}
+func (m *AliasList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AliasList.Merge(m, src)
+}
+func (m *AliasList) XXX_Size() int {
+	return xxx_messageInfo_AliasList.Size(m)
+}
+func (m *AliasList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AliasList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AliasList proto.InternalMessageInfo
+
+func (m *AliasList) GetAliases() []*Alias {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+type SwapAliasRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FromIndexes          []string `protobuf:"bytes,2,rep,name=from_indexes,json=fromIndexes,proto3" json:"from_indexes,omitempty"`
+	ToIndexes            []string `protobuf:"bytes,3,rep,name=to_indexes,json=toIndexes,proto3" json:"to_indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SwapAliasRequest) Reset()         { *m = SwapAliasRequest{} }
+func (m *SwapAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*SwapAliasRequest) ProtoMessage()    {}
+func (*SwapAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{77}
+}
+
+func (m *SwapAliasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwapAliasRequest.Unmarshal(m, b)
+}
+func (m *SwapAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwapAliasRequest.Marshal(b, m, deterministic)
+}
+func (m *SwapAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAliasRequest.Merge(m, src)
+}
+func (m *SwapAliasRequest) XXX_Size() int {
+	return xxx_messageInfo_SwapAliasRequest.Size(m)
+}
+func (m *SwapAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAliasRequest proto.InternalMessageInfo
+
+func (m *SwapAliasRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SwapAliasRequest) GetFromIndexes() []string {
+	if m != nil {
+		return m.FromIndexes
+	}
+	return nil
+}
+
+func (m *SwapAliasRequest) GetToIndexes() []string {
+	if m != nil {
+		return m.ToIndexes
+	}
+	return nil
+}
+
+type ReindexStatus struct {
+	State                ReindexStatus_State  `protobuf:"varint,1,opt,name=state,proto3,enum=index.ReindexStatus_State" json:"state,omitempty"`
+	TotalDocuments       uint64               `protobuf:"varint,2,opt,name=total_documents,json=totalDocuments,proto3" json:"total_documents,omitempty"`
+	IndexedDocuments     uint64               `protobuf:"varint,3,opt,name=indexed_documents,json=indexedDocuments,proto3" json:"indexed_documents,omitempty"`
+	CaughtUpDocuments    uint64               `protobuf:"varint,4,opt,name=caught_up_documents,json=caughtUpDocuments,proto3" json:"caught_up_documents,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Error                string               `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReindexStatus) Reset()         { *m = ReindexStatus{} }
+func (m *ReindexStatus) String() string { return proto.CompactTextString(m) }
+func (*ReindexStatus) ProtoMessage()    {}
+func (*ReindexStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{78}
+}
+
+func (m *ReindexStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReindexStatus.Unmarshal(m, b)
+}
+func (m *ReindexStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReindexStatus.Marshal(b, m, deterministic)
+}
+func (m *ReindexStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReindexStatus.Merge(m, src)
+}
+func (m *ReindexStatus) XXX_Size() int {
+	return xxx_messageInfo_ReindexStatus.Size(m)
+}
+func (m *ReindexStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReindexStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReindexStatus proto.InternalMessageInfo
+
+func (m *ReindexStatus) GetState() ReindexStatus_State {
+	if m != nil {
+		return m.State
+	}
+	return ReindexStatus_IDLE
+}
+
+func (m *ReindexStatus) GetTotalDocuments() uint64 {
+	if m != nil {
+		return m.TotalDocuments
+	}
+	return 0
+}
+
+func (m *ReindexStatus) GetIndexedDocuments() uint64 {
+	if m != nil {
+		return m.IndexedDocuments
+	}
+	return 0
+}
+
+func (m *ReindexStatus) GetCaughtUpDocuments() uint64 {
+	if m != nil {
+		return m.CaughtUpDocuments
+	}
+	return 0
+}
+
+func (m *ReindexStatus) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ReindexStatus) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *ReindexStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type IndexCommand struct {
+	Type                 IndexCommand_Type `protobuf:"varint,1,opt,name=type,proto3,enum=index.IndexCommand_Type" json:"type,omitempty"`
+	Data                 *any.Any          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *IndexCommand) Reset()         { *m = IndexCommand{} }
+func (m *IndexCommand) String() string { return proto.CompactTextString(m) }
+func (*IndexCommand) ProtoMessage()    {}
+func (*IndexCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2daf652facb3ae, []int{79}
+}
+
+func (m *IndexCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexCommand.Unmarshal(m, b)
+}
+func (m *IndexCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexCommand.Marshal(b, m, deterministic)
+}
+func (m *IndexCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexCommand.Merge(m, src)
+}
+func (m *IndexCommand) XXX_Size() int {
+	return xxx_messageInfo_IndexCommand.Size(m)
+}
+func (m *IndexCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexCommand proto.InternalMessageInfo
+
+func (m *IndexCommand) GetType() IndexCommand_Type {
+	if m != nil {
+		return m.Type
+	}
+	return IndexCommand_UNKNOWN_COMMAND
+}
+
+func (m *IndexCommand) GetData() *any.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("index.Document_OpType", Document_OpType_name, Document_OpType_value)
+	proto.RegisterEnum("index.BulkItem_Action", BulkItem_Action_name, BulkItem_Action_value)
+	proto.RegisterEnum("index.ChangeEvent_Type", ChangeEvent_Type_name, ChangeEvent_Type_value)
+	proto.RegisterEnum("index.MatchQuery_Operator", MatchQuery_Operator_name, MatchQuery_Operator_value)
+	proto.RegisterEnum("index.SortField_Type", SortField_Type_name, SortField_Type_value)
+	proto.RegisterEnum("index.SortField_Mode", SortField_Mode_name, SortField_Mode_value)
+	proto.RegisterEnum("index.SortField_Missing", SortField_Missing_name, SortField_Missing_value)
+	proto.RegisterEnum("index.ReindexStatus_State", ReindexStatus_State_name, ReindexStatus_State_value)
+	proto.RegisterEnum("index.IndexCommand_Type", IndexCommand_Type_name, IndexCommand_Type_value)
+	proto.RegisterType((*Document)(nil), "index.Document")
+	proto.RegisterType((*LegacyDocument)(nil), "index.LegacyDocument")
+	proto.RegisterType((*MultiGetRequest)(nil), "index.MultiGetRequest")
+	proto.RegisterType((*MultiGetResponse)(nil), "index.MultiGetResponse")
+	proto.RegisterType((*UpdateResult)(nil), "index.UpdateResult")
+	proto.RegisterType((*BulkItem)(nil), "index.BulkItem")
+	proto.RegisterType((*BulkRequest)(nil), "index.BulkRequest")
+	proto.RegisterType((*BulkItemResult)(nil), "index.BulkItemResult")
+	proto.RegisterType((*BulkResponse)(nil), "index.BulkResponse")
+	proto.RegisterType((*Stats)(nil), "index.Stats")
+	proto.RegisterType((*ExportRequest)(nil), "index.ExportRequest")
+	proto.RegisterType((*CountRequest)(nil), "index.CountRequest")
+	proto.RegisterType((*CountResponse)(nil), "index.CountResponse")
+	proto.RegisterType((*ExplainRequest)(nil), "index.ExplainRequest")
+	proto.RegisterType((*ExplainResponse)(nil), "index.ExplainResponse")
+	proto.RegisterType((*AnalyzeRequest)(nil), "index.AnalyzeRequest")
+	proto.RegisterType((*Token)(nil), "index.Token")
+	proto.RegisterType((*AnalyzeResponse)(nil), "index.AnalyzeResponse")
+	proto.RegisterType((*SubscribeRequest)(nil), "index.SubscribeRequest")
+	proto.RegisterType((*ChangeEvent)(nil), "index.ChangeEvent")
+	proto.RegisterType((*RejectedChanges)(nil), "index.RejectedChanges")
+	proto.RegisterType((*SimulatePipelineRequest)(nil), "index.SimulatePipelineRequest")
+	proto.RegisterType((*SimulatedDocument)(nil), "index.SimulatedDocument")
+	proto.RegisterType((*SimulatePipelineResponse)(nil), "index.SimulatePipelineResponse")
+	proto.RegisterType((*PercolatorQuery)(nil), "index.PercolatorQuery")
+	proto.RegisterType((*PercolatorQueryList)(nil), "index.PercolatorQueryList")
+	proto.RegisterType((*PercolateRequest)(nil), "index.PercolateRequest")
+	proto.RegisterType((*PercolateResponse)(nil), "index.PercolateResponse")
+	proto.RegisterType((*SubscribePercolationsRequest)(nil), "index.SubscribePercolationsRequest")
+	proto.RegisterType((*Percolation)(nil), "index.Percolation")
+	proto.RegisterType((*SimilarRequest)(nil), "index.SimilarRequest")
+	proto.RegisterType((*FieldStatsRequest)(nil), "index.FieldStatsRequest")
+	proto.RegisterType((*FieldStats)(nil), "index.FieldStats")
+	proto.RegisterType((*FieldStatsResponse)(nil), "index.FieldStatsResponse")
+	proto.RegisterType((*SuggestRequest)(nil), "index.SuggestRequest")
+	proto.RegisterType((*Suggestion)(nil), "index.Suggestion")
+	proto.RegisterType((*SuggestResponse)(nil), "index.SuggestResponse")
+	proto.RegisterType((*SearchRequest)(nil), "index.SearchRequest")
+	proto.RegisterMapType((map[string]*FacetRequest)(nil), "index.SearchRequest.FacetsEntry")
+	proto.RegisterType((*SearchResponse)(nil), "index.SearchResponse")
+	proto.RegisterMapType((map[string]*FacetResult)(nil), "index.SearchResponse.FacetsEntry")
+	proto.RegisterType((*SearchProfile)(nil), "index.SearchProfile")
+	proto.RegisterType((*SearchProfile_Phase)(nil), "index.SearchProfile.Phase")
+	proto.RegisterType((*ScrollRequest)(nil), "index.ScrollRequest")
+	proto.RegisterType((*Query)(nil), "index.Query")
+	proto.RegisterType((*MatchAllQuery)(nil), "index.MatchAllQuery")
+	proto.RegisterType((*MatchNoneQuery)(nil), "index.MatchNoneQuery")
+	proto.RegisterType((*MatchQuery)(nil), "index.MatchQuery")
+	proto.RegisterType((*MatchPhraseQuery)(nil), "index.MatchPhraseQuery")
+	proto.RegisterType((*TermQuery)(nil), "index.TermQuery")
+	proto.RegisterType((*PhraseQuery)(nil), "index.PhraseQuery")
+	proto.RegisterType((*MultiPhraseQuery)(nil), "index.MultiPhraseQuery")
+	proto.RegisterType((*MultiPhraseQuery_Terms)(nil), "index.MultiPhraseQuery.Terms")
+	proto.RegisterType((*PrefixQuery)(nil), "index.PrefixQuery")
+	proto.RegisterType((*WildcardQuery)(nil), "index.WildcardQuery")
+	proto.RegisterType((*RegexpQuery)(nil), "index.RegexpQuery")
+	proto.RegisterType((*FuzzyQuery)(nil), "index.FuzzyQuery")
+	proto.RegisterType((*NumericRangeQuery)(nil), "index.NumericRangeQuery")
+	proto.RegisterType((*DateRangeQuery)(nil), "index.DateRangeQuery")
+	proto.RegisterType((*TermRangeQuery)(nil), "index.TermRangeQuery")
+	proto.RegisterType((*QueryStringQuery)(nil), "index.QueryStringQuery")
+	proto.RegisterType((*BooleanQuery)(nil), "index.BooleanQuery")
+	proto.RegisterType((*ConjunctionQuery)(nil), "index.ConjunctionQuery")
+	proto.RegisterType((*DisjunctionQuery)(nil), "index.DisjunctionQuery")
+	proto.RegisterType((*DocIDQuery)(nil), "index.DocIDQuery")
+	proto.RegisterType((*BoolFieldQuery)(nil), "index.BoolFieldQuery")
+	proto.RegisterType((*GeoPoint)(nil), "index.GeoPoint")
+	proto.RegisterType((*GeoDistanceQuery)(nil), "index.GeoDistanceQuery")
+	proto.RegisterType((*GeoBoundingBoxQuery)(nil), "index.GeoBoundingBoxQuery")
+	proto.RegisterType((*SortField)(nil), "index.SortField")
+	proto.RegisterType((*SortField_Score)(nil), "index.SortField.Score")
+	proto.RegisterType((*SortField_ID)(nil), "index.SortField.ID")
+	proto.RegisterType((*SortField_Field)(nil), "index.SortField.Field")
+	proto.RegisterType((*SortField_GeoDistance)(nil), "index.SortField.GeoDistance")
+	proto.RegisterType((*HighlightRequest)(nil), "index.HighlightRequest")
+	proto.RegisterType((*FacetRequest)(nil), "index.FacetRequest")
+	proto.RegisterType((*FacetRequest_NumericRange)(nil), "index.FacetRequest.NumericRange")
+	proto.RegisterType((*FacetRequest_DateRange)(nil), "index.FacetRequest.DateRange")
+	proto.RegisterType((*SearchStatus)(nil), "index.SearchStatus")
+	proto.RegisterMapType((map[string]string)(nil), "index.SearchStatus.ErrorsEntry")
+	proto.RegisterType((*DocumentMatch)(nil), "index.DocumentMatch")
+	proto.RegisterMapType((map[string]*DocumentMatch_Fragments)(nil), "index.DocumentMatch.FragmentsEntry")
+	proto.RegisterMapType((map[string]*DocumentMatch_TermLocations)(nil), "index.DocumentMatch.LocationsEntry")
+	proto.RegisterType((*DocumentMatch_Location)(nil), "index.DocumentMatch.Location")
+	proto.RegisterType((*DocumentMatch_Locations)(nil), "index.DocumentMatch.Locations")
+	proto.RegisterType((*DocumentMatch_TermLocations)(nil), "index.DocumentMatch.TermLocations")
+	proto.RegisterMapType((map[string]*DocumentMatch_Locations)(nil), "index.DocumentMatch.TermLocations.TermsEntry")
+	proto.RegisterType((*DocumentMatch_Fragments)(nil), "index.DocumentMatch.Fragments")
+	proto.RegisterType((*Explanation)(nil), "index.Explanation")
+	proto.RegisterType((*FacetResult)(nil), "index.FacetResult")
+	proto.RegisterType((*FacetResult_TermFacet)(nil), "index.FacetResult.TermFacet")
+	proto.RegisterType((*FacetResult_NumericRangeFacet)(nil), "index.FacetResult.NumericRangeFacet")
+	proto.RegisterType((*FacetResult_DateRangeFacet)(nil), "index.FacetResult.DateRangeFacet")
+	proto.RegisterType((*IndexMapping)(nil), "index.IndexMapping")
+	proto.RegisterType((*IndexInfo)(nil), "index.IndexInfo")
+	proto.RegisterType((*IndexList)(nil), "index.IndexList")
+	proto.RegisterType((*Alias)(nil), "index.Alias")
+	proto.RegisterType((*AliasList)(nil), "index.AliasList")
+	proto.RegisterType((*SwapAliasRequest)(nil), "index.SwapAliasRequest")
+	proto.RegisterType((*ReindexStatus)(nil), "index.ReindexStatus")
+	proto.RegisterType((*IndexCommand)(nil), "index.IndexCommand")
+}
+
+func init() { proto.RegisterFile("protobuf/index/index.proto", fileDescriptor_7b2daf652facb3ae) }
+
+var fileDescriptor_7b2daf652facb3ae = []byte{
+	// 5226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x4d, 0x73, 0x1b, 0x47,
+	0x76, 0x1c, 0x7c, 0xe3, 0xe1, 0x83, 0x50, 0xeb, 0x0b, 0x86, 0x6c, 0x8b, 0x1e, 0x7f, 0x2c, 0x65,
+	0xcb, 0x90, 0x4d, 0x59, 0xbb, 0xd6, 0xda, 0xeb, 0x5d, 0x88, 0x80, 0x28, 0x7a, 0x49, 0x8a, 0x3b,
+	0x20, 0xe3, 0x8f, 0xb8, 0x0a, 0x19, 0x02, 0x4d, 0x72, 0x56, 0x83, 0x19, 0x68, 0xa6, 0x61, 0x93,
+	0x3e, 0xa4, 0x52, 0x49, 0x2e, 0x49, 0x0e, 0xc9, 0x21, 0x87, 0x54, 0x25, 0x9b, 0xca, 0x21, 0xd9,
+	0x54, 0x0e, 0xf9, 0xb8, 0xe6, 0x90, 0xda, 0x54, 0xe5, 0x9a, 0x43, 0x6a, 0x2f, 0x39, 0xe4, 0x90,
+	0xbf, 0x92, 0xea, 0xcf, 0xe9, 0x19, 0x0c, 0x40, 0x48, 0xab, 0xf2, 0x21, 0x17, 0x70, 0xfa, 0xf5,
+	0x7b, 0xdd, 0xaf, 0x5f, 0xbf, 0x7e, 0xfd, 0x5e, 0xf7, 0x6b, 0x42, 0x6b, 0x12, 0xf8, 0xc4, 0x3f,
+	0x9a, 0x1e, 0xdf, 0x71, 0xbc, 0x11, 0x3e, 0xe3, 0xbf, 0x6d, 0x06, 0x44, 0x79, 0x56, 0x68, 0xbd,
+	0x74, 0xe2, 0xfb, 0x27, 0x2e, 0xbe, 0xa3, 0x30, 0x6d, 0xef, 0x9c, 0x63, 0xb4, 0x5e, 0x4d, 0x56,
+	0x8d, 0xa6, 0x81, 0x4d, 0x1c, 0xdf, 0x13, 0xf5, 0x37, 0x92, 0xf5, 0x78, 0x3c, 0x21, 0x92, 0xf8,
+	0xe5, 0x64, 0x65, 0x48, 0x82, 0xe9, 0x90, 0x88, 0xda, 0x9b, 0xc9, 0x5a, 0xe2, 0x8c, 0x71, 0x48,
+	0xec, 0xf1, 0x64, 0x5e, 0xdf, 0xdf, 0x04, 0xf6, 0x64, 0x82, 0x83, 0x50, 0xd4, 0x37, 0x55, 0x45,
+	0x60, 0x1f, 0x13, 0xf6, 0xc3, 0x6b, 0xcc, 0x7f, 0xce, 0x40, 0xa9, 0xeb, 0x0f, 0xa7, 0x63, 0xec,
+	0x11, 0x54, 0x87, 0x8c, 0x33, 0x6a, 0x1a, 0x6b, 0xc6, 0x7a, 0xd9, 0xca, 0x38, 0x23, 0x74, 0x05,
+	0xf8, 0xb0, 0x9b, 0x59, 0x06, 0xe2, 0x05, 0x74, 0x07, 0x0a, 0xc7, 0x0e, 0x76, 0x47, 0x61, 0x33,
+	0xb7, 0x66, 0xac, 0x57, 0x36, 0xae, 0xb7, 0x79, 0xef, 0x6d, 0xd9, 0x49, 0xbb, 0xcf, 0x98, 0xb7,
+	0x04, 0x1a, 0x7a, 0x19, 0xca, 0x13, 0x1c, 0x0c, 0x7d, 0xd7, 0x26, 0xb8, 0x99, 0x5f, 0x33, 0xd6,
+	0x4b, 0x56, 0x04, 0x40, 0x37, 0xa0, 0x8c, 0xcf, 0x26, 0x4e, 0x80, 0x07, 0x36, 0x69, 0x16, 0xd6,
+	0x8c, 0xf5, 0xac, 0x55, 0xe2, 0x80, 0x0e, 0x41, 0x0d, 0xc8, 0x12, 0xe2, 0x36, 0x8b, 0x0c, 0x4c,
+	0x3f, 0x51, 0x0b, 0x4a, 0x13, 0x67, 0x82, 0x5d, 0xc7, 0xc3, 0xcd, 0x12, 0x63, 0x4b, 0x95, 0xd1,
+	0x1d, 0x28, 0xfa, 0x93, 0x01, 0x39, 0x9f, 0xe0, 0x66, 0x79, 0xcd, 0x58, 0xaf, 0x6f, 0x5c, 0x6b,
+	0xf3, 0x39, 0x94, 0x23, 0x6c, 0x3f, 0x9e, 0x1c, 0x9c, 0x4f, 0xb0, 0x55, 0xf0, 0xd9, 0x5f, 0xf3,
+	0x36, 0x14, 0x38, 0x04, 0x01, 0x14, 0x0e, 0xf7, 0xfb, 0x3d, 0xeb, 0xa0, 0xb1, 0x42, 0xbf, 0x37,
+	0xad, 0x5e, 0xe7, 0xa0, 0xd7, 0x30, 0x38, 0xbc, 0x4b, 0xbf, 0x33, 0x9f, 0xe6, 0x4a, 0x99, 0x46,
+	0xd6, 0x1c, 0x41, 0x7d, 0x07, 0x9f, 0xd8, 0xc3, 0xf3, 0xb9, 0x62, 0xbb, 0xad, 0x04, 0x94, 0x61,
+	0x02, 0xba, 0x32, 0x23, 0xa0, 0x8e, 0x77, 0xae, 0xa4, 0x93, 0x2a, 0x64, 0xf3, 0x4f, 0x0c, 0x58,
+	0xdd, 0x9d, 0xba, 0xc4, 0xd9, 0xc2, 0xc4, 0xc2, 0x4f, 0xa7, 0x38, 0x24, 0x11, 0xa6, 0xa1, 0x4f,
+	0x47, 0x03, 0xb2, 0x0e, 0xeb, 0x2a, 0xbb, 0x5e, 0xb6, 0xe8, 0x27, 0xfa, 0x1e, 0xac, 0x86, 0xfe,
+	0x34, 0x18, 0xe2, 0x81, 0xe3, 0x0d, 0xdd, 0xe9, 0x08, 0x87, 0xcd, 0x2c, 0xab, 0xad, 0x73, 0xf0,
+	0xb6, 0x80, 0x6a, 0x88, 0xf8, 0x4c, 0x20, 0xe6, 0x74, 0xc4, 0x9e, 0x80, 0x9a, 0x47, 0xd0, 0x88,
+	0x98, 0x09, 0x27, 0xbe, 0x17, 0x62, 0xf4, 0x2e, 0x94, 0x47, 0x42, 0x02, 0x61, 0xd3, 0x58, 0xcb,
+	0xae, 0x57, 0x36, 0x56, 0x13, 0xe2, 0xb6, 0x22, 0x0c, 0x74, 0x13, 0x2a, 0x63, 0x27, 0x0c, 0x1d,
+	0xef, 0x64, 0x10, 0xb1, 0x0b, 0x02, 0xb4, 0x3d, 0x0a, 0xcd, 0x43, 0xa8, 0x1e, 0x4e, 0x46, 0x36,
+	0xc1, 0x16, 0x0e, 0xa7, 0x2e, 0x1b, 0xed, 0xd0, 0x9f, 0x7a, 0x84, 0x8d, 0x36, 0x6f, 0xf1, 0x02,
+	0x9d, 0xe2, 0x80, 0xd5, 0xf3, 0x26, 0x2a, 0x1b, 0x57, 0x45, 0x9f, 0x0f, 0xa6, 0xee, 0x93, 0x6d,
+	0x82, 0xc7, 0x9c, 0xda, 0x92, 0x58, 0xe6, 0x9f, 0x1b, 0x50, 0x92, 0x75, 0xa8, 0x0d, 0x05, 0x7b,
+	0x48, 0xd7, 0x64, 0xd3, 0x88, 0xe9, 0x87, 0x44, 0x68, 0x77, 0x58, 0xad, 0x25, 0xb0, 0xd0, 0x3b,
+	0x50, 0x92, 0x23, 0x10, 0x73, 0x39, 0x33, 0x44, 0x85, 0x60, 0xbe, 0x03, 0x05, 0x4e, 0x8e, 0xca,
+	0x90, 0xdf, 0xde, 0xeb, 0xf6, 0x3e, 0xe7, 0xba, 0xd4, 0xed, 0xed, 0xf4, 0x92, 0xba, 0x64, 0x7e,
+	0x00, 0x15, 0xda, 0xa9, 0x9c, 0xda, 0x37, 0x21, 0xef, 0x10, 0x3c, 0x4e, 0x0a, 0x52, 0x0d, 0x8a,
+	0xd7, 0x9a, 0x7f, 0x66, 0x40, 0x3d, 0x3e, 0xd0, 0x67, 0x1e, 0x12, 0x57, 0xd6, 0xcc, 0x05, 0x6b,
+	0x1c, 0x41, 0x6e, 0xe8, 0x8f, 0x30, 0x5b, 0xe1, 0x79, 0x8b, 0x7d, 0x53, 0x4c, 0x1c, 0x04, 0x7e,
+	0xc0, 0x96, 0x70, 0xd9, 0xe2, 0x05, 0xf3, 0xc7, 0x50, 0xe5, 0x03, 0x11, 0x6a, 0xa1, 0x4d, 0x90,
+	0xb1, 0xd4, 0x04, 0xdd, 0x85, 0x7c, 0x9f, 0xd8, 0x24, 0x44, 0x6f, 0x43, 0x3e, 0xa4, 0x1f, 0x4d,
+	0x63, 0xc1, 0xaa, 0xe1, 0x28, 0xe6, 0x36, 0xd4, 0x7a, 0x67, 0x13, 0x3f, 0xb8, 0x60, 0x6d, 0x98,
+	0x90, 0x7f, 0x3a, 0xc5, 0xc1, 0xb9, 0x98, 0xbc, 0xaa, 0x60, 0xe5, 0x67, 0x14, 0x66, 0xf1, 0x2a,
+	0xf3, 0x11, 0x54, 0x37, 0xa9, 0x6a, 0xfd, 0xe6, 0x2d, 0x3d, 0x80, 0x9a, 0x68, 0x49, 0xc8, 0x22,
+	0xa6, 0xc2, 0x39, 0xa9, 0xc2, 0x37, 0xd8, 0xc2, 0x19, 0xf0, 0x9a, 0x0c, 0xab, 0xa1, 0x4a, 0xc4,
+	0x48, 0xcd, 0x2f, 0xa1, 0xde, 0x3b, 0x9b, 0xb8, 0xb6, 0xe3, 0x2d, 0xe6, 0x27, 0x39, 0x8d, 0x8a,
+	0xbf, 0xec, 0x7c, 0xfe, 0x7e, 0x61, 0xc0, 0xaa, 0x6a, 0x3c, 0x62, 0x71, 0x89, 0xd6, 0x9b, 0x50,
+	0x1c, 0xdb, 0x64, 0x78, 0x8a, 0x47, 0xac, 0xfd, 0x92, 0x25, 0x8b, 0x94, 0x3e, 0x1c, 0xfa, 0x01,
+	0xd7, 0x14, 0xc3, 0xe2, 0x05, 0xf4, 0x01, 0x54, 0x30, 0xed, 0xc8, 0x63, 0x1b, 0x20, 0x53, 0x98,
+	0xca, 0x06, 0x12, 0x3c, 0xf5, 0xa2, 0x1a, 0x4b, 0x47, 0x33, 0x5d, 0xa8, 0x77, 0x3c, 0xdb, 0x3d,
+	0xff, 0x16, 0x2f, 0x1e, 0x3b, 0x82, 0x1c, 0xc1, 0x67, 0x44, 0xf0, 0xc7, 0xbe, 0xe9, 0xb6, 0x60,
+	0x73, 0xda, 0x40, 0x68, 0xb2, 0x2a, 0xd3, 0x56, 0x98, 0xad, 0x65, 0x3c, 0x96, 0x2d, 0x5e, 0x30,
+	0x43, 0xc8, 0x1f, 0xf8, 0x4f, 0xb0, 0xc7, 0x9b, 0x0b, 0xc6, 0xa2, 0x0f, 0xf6, 0xcd, 0x76, 0x19,
+	0x3f, 0x74, 0x18, 0xf7, 0x19, 0xb6, 0x06, 0x54, 0x99, 0x0d, 0x99, 0xd8, 0x01, 0x61, 0xfd, 0xe4,
+	0x2d, 0x5e, 0xa0, 0x66, 0x18, 0x7b, 0x23, 0xb1, 0x60, 0xe8, 0x27, 0x6b, 0x97, 0x6e, 0x45, 0x79,
+	0xd1, 0x2e, 0xdd, 0x70, 0xfa, 0xb0, 0xaa, 0x86, 0x28, 0x66, 0x40, 0xe7, 0xdc, 0x48, 0x70, 0xfe,
+	0x06, 0x14, 0x08, 0xe5, 0x51, 0x1a, 0x3b, 0x39, 0xad, 0x8c, 0x71, 0x4b, 0xd4, 0x99, 0xfb, 0xd0,
+	0xe8, 0x4f, 0x8f, 0xc2, 0x61, 0xe0, 0x1c, 0x5d, 0x20, 0xb9, 0xb7, 0x60, 0xf5, 0x38, 0xf0, 0xc7,
+	0x03, 0xea, 0x00, 0x0c, 0x78, 0x3d, 0x57, 0xc0, 0x1a, 0x05, 0x5b, 0xf6, 0x31, 0xd9, 0x66, 0xbb,
+	0xcf, 0xff, 0x18, 0x50, 0xd9, 0x3c, 0xb5, 0xbd, 0x13, 0xdc, 0xfb, 0x9a, 0xee, 0x70, 0xef, 0x88,
+	0xa1, 0x70, 0x13, 0x73, 0x5d, 0x70, 0xa1, 0x61, 0xb4, 0xd9, 0xb6, 0xca, 0x90, 0xd0, 0x2b, 0x00,
+	0x33, 0xed, 0x97, 0x03, 0xd9, 0xf6, 0x1c, 0x83, 0xc3, 0x35, 0x2e, 0xa7, 0x34, 0x2e, 0x72, 0x32,
+	0xf2, 0x4b, 0x39, 0x19, 0xe6, 0x2d, 0xc8, 0xb1, 0x8d, 0x3c, 0x6e, 0x7b, 0x85, 0xbd, 0x35, 0x34,
+	0x3b, 0x9c, 0x31, 0xbf, 0x80, 0x55, 0x0b, 0xff, 0x1c, 0x0f, 0x09, 0x1e, 0xf1, 0x21, 0x84, 0xe8,
+	0x35, 0xa8, 0x46, 0x3c, 0x63, 0x6e, 0xba, 0x72, 0x56, 0x45, 0x71, 0x8d, 0x43, 0xf4, 0x3a, 0xd4,
+	0xec, 0xc9, 0xc4, 0x75, 0xf0, 0x28, 0x36, 0xb2, 0xaa, 0x00, 0x72, 0xc1, 0xfd, 0xa9, 0x01, 0xd7,
+	0xfb, 0xce, 0x78, 0x4a, 0x3d, 0x9b, 0x7d, 0xe1, 0x96, 0xc8, 0x29, 0x41, 0x90, 0xf3, 0xec, 0x31,
+	0x96, 0x7a, 0x46, 0xbf, 0xd1, 0x5d, 0xcd, 0x9b, 0xc9, 0x2c, 0x1e, 0xa8, 0x42, 0xa4, 0xb3, 0x31,
+	0xf2, 0x87, 0x7c, 0x53, 0x5f, 0x40, 0xc0, 0x90, 0xcc, 0x2f, 0xe1, 0x92, 0x64, 0x68, 0xa4, 0x3c,
+	0x96, 0x48, 0xba, 0xc6, 0x72, 0x2e, 0x9c, 0xb2, 0xfd, 0x19, 0xdd, 0xf6, 0x3f, 0x82, 0xe6, 0xec,
+	0x60, 0x85, 0x5a, 0xdf, 0x16, 0x4c, 0xf2, 0x4d, 0xa0, 0x29, 0x54, 0x66, 0x86, 0x15, 0xc1, 0xe5,
+	0x6f, 0xc3, 0xea, 0xbe, 0xf0, 0x08, 0xfd, 0x80, 0x19, 0xad, 0x17, 0x68, 0xf7, 0xb6, 0xe0, 0x72,
+	0xa2, 0xf1, 0x1d, 0x27, 0x24, 0xe8, 0x3d, 0x28, 0xd2, 0x7a, 0x07, 0x4b, 0x26, 0xe5, 0xd6, 0x99,
+	0x40, 0xb6, 0x24, 0x9a, 0xf9, 0x05, 0x34, 0x64, 0xdd, 0x05, 0x0b, 0xed, 0x4e, 0xc2, 0x05, 0xbc,
+	0x50, 0x7d, 0xdf, 0x83, 0x4b, 0x5a, 0xd3, 0x42, 0x86, 0x37, 0xa0, 0xcc, 0x46, 0xc0, 0x3c, 0x26,
+	0x83, 0x79, 0x4c, 0x25, 0x06, 0xa0, 0xfe, 0xd2, 0x07, 0xf0, 0xb2, 0x5a, 0xf5, 0x92, 0xd4, 0xf1,
+	0xbd, 0x70, 0x21, 0x63, 0xe6, 0x3e, 0x54, 0x34, 0xe4, 0x25, 0x85, 0x1c, 0xe3, 0x23, 0x9b, 0xe0,
+	0xe3, 0x6f, 0x33, 0x50, 0xef, 0x3b, 0x63, 0xc7, 0xb5, 0x83, 0x67, 0xdb, 0xb2, 0x6e, 0x42, 0xc5,
+	0x75, 0x9e, 0xe0, 0x81, 0x10, 0x14, 0x6f, 0x17, 0x28, 0xe8, 0x21, 0x83, 0x50, 0x6b, 0x35, 0xb6,
+	0xcf, 0x06, 0xbc, 0x6b, 0x6a, 0x96, 0x43, 0x61, 0x5e, 0x6b, 0x63, 0xfb, 0x8c, 0x4d, 0xcd, 0x01,
+	0x05, 0x22, 0x13, 0x6a, 0x63, 0xc7, 0x63, 0x18, 0x83, 0xe3, 0x00, 0x3f, 0x65, 0x26, 0x23, 0x6f,
+	0x55, 0xc6, 0x8e, 0x47, 0x11, 0x1e, 0x06, 0xf8, 0x29, 0x5a, 0x83, 0x2a, 0xc5, 0xa1, 0x1b, 0x2f,
+	0x43, 0x29, 0x30, 0x14, 0x18, 0x3b, 0x5e, 0xd7, 0x1f, 0x2a, 0x0c, 0xfb, 0x2c, 0xc2, 0x28, 0x0a,
+	0x0c, 0xfb, 0x4c, 0x62, 0x7c, 0x04, 0xf5, 0x10, 0xdb, 0xc1, 0xf0, 0x74, 0x10, 0xf0, 0x81, 0x36,
+	0x4b, 0xc2, 0x53, 0x11, 0xca, 0xcd, 0x2a, 0x85, 0x10, 0xac, 0x5a, 0xa8, 0x17, 0xcd, 0x5b, 0x70,
+	0x89, 0x0d, 0x8b, 0xf9, 0x3a, 0x8b, 0xe7, 0xe8, 0x77, 0x01, 0x22, 0xd4, 0x54, 0xb3, 0xb1, 0xc8,
+	0x85, 0xa0, 0xf6, 0x97, 0x89, 0x82, 0xd7, 0x66, 0x59, 0x6d, 0x99, 0x42, 0x78, 0xf5, 0x1a, 0x54,
+	0xc3, 0xe9, 0x38, 0x1a, 0x67, 0x8e, 0x21, 0x40, 0x38, 0x1d, 0x8b, 0x71, 0x9a, 0x5f, 0x01, 0xd2,
+	0x59, 0x8d, 0x94, 0x31, 0xea, 0xd3, 0x48, 0xf4, 0x79, 0x4b, 0xd3, 0x77, 0xba, 0x94, 0x2e, 0x09,
+	0x91, 0x68, 0xed, 0x48, 0x4d, 0xff, 0x2b, 0x03, 0xea, 0xfd, 0xe9, 0xc9, 0x09, 0x95, 0xd1, 0x42,
+	0x7d, 0x51, 0xdb, 0x76, 0x46, 0xdb, 0xb6, 0xd1, 0x35, 0x28, 0x4c, 0x02, 0x7c, 0xec, 0xc8, 0xfd,
+	0x43, 0x94, 0xa8, 0x98, 0x42, 0xe7, 0x5b, 0xe5, 0xb1, 0xd2, 0x6f, 0xd6, 0xc2, 0xf4, 0xdb, 0x6f,
+	0xcf, 0x45, 0xd0, 0xc9, 0x0b, 0x34, 0x1c, 0xa5, 0x1f, 0x8e, 0x87, 0xc3, 0x50, 0xe8, 0x41, 0x04,
+	0x30, 0x2d, 0x00, 0xc1, 0x1d, 0x5d, 0x1f, 0x69, 0xbe, 0x81, 0xf2, 0xea, 0x32, 0xba, 0x57, 0xd7,
+	0x82, 0xd2, 0xc8, 0x09, 0x89, 0xed, 0x0d, 0xb1, 0x70, 0x0c, 0x54, 0xd9, 0x7c, 0x08, 0xab, 0x6a,
+	0xc4, 0x42, 0x9a, 0x77, 0xa1, 0x12, 0xaa, 0x6e, 0xa4, 0x01, 0x92, 0x52, 0x8b, 0x18, 0xb0, 0x74,
+	0x2c, 0xf3, 0x3f, 0xf2, 0x50, 0x8b, 0x29, 0x59, 0x24, 0xb9, 0x4c, 0xaa, 0xb3, 0x3a, 0xdf, 0x28,
+	0xa2, 0x3b, 0x9a, 0xbc, 0x2a, 0x1b, 0x37, 0x66, 0xec, 0xd3, 0xb6, 0x47, 0xee, 0x6e, 0xfc, 0x96,
+	0xed, 0x4e, 0xb1, 0x10, 0x26, 0x82, 0x1c, 0x75, 0x12, 0xc4, 0xe2, 0x62, 0xdf, 0xe8, 0x1e, 0x94,
+	0x4f, 0x9d, 0x93, 0x53, 0xd7, 0x39, 0x39, 0xe5, 0xb1, 0x7b, 0x45, 0x39, 0x07, 0x8f, 0x24, 0x5c,
+	0xae, 0x87, 0x08, 0x93, 0xce, 0xa1, 0xd0, 0x96, 0x22, 0x5b, 0xf4, 0xa2, 0x84, 0x3e, 0x84, 0xc2,
+	0xb1, 0x3d, 0xc4, 0x24, 0x6c, 0x96, 0x98, 0x3c, 0xd6, 0xd2, 0x16, 0x56, 0xfb, 0x21, 0x43, 0xe9,
+	0x79, 0x24, 0xa0, 0x41, 0x34, 0x2b, 0x50, 0x07, 0x15, 0x73, 0xcf, 0x96, 0x45, 0xfe, 0x25, 0x4b,
+	0x16, 0xd1, 0x1b, 0x90, 0x0b, 0xfd, 0x80, 0x34, 0x81, 0xb5, 0xd8, 0x90, 0x2d, 0xfa, 0x01, 0x61,
+	0xba, 0x69, 0xb1, 0x5a, 0xf4, 0x0e, 0x5c, 0x12, 0xb1, 0xf2, 0xc0, 0xf5, 0x87, 0xdc, 0x90, 0x36,
+	0x2b, 0xac, 0xa5, 0x86, 0xa8, 0xd8, 0x91, 0x70, 0xf4, 0x26, 0xd4, 0x25, 0x32, 0x8f, 0x93, 0x9b,
+	0x55, 0x86, 0x59, 0x13, 0xd0, 0x3e, 0x03, 0xa6, 0x85, 0xe1, 0xb5, 0x65, 0xc3, 0xf0, 0x7a, 0x5a,
+	0x18, 0x4e, 0xbd, 0x14, 0x61, 0x80, 0xec, 0x63, 0x82, 0x83, 0xe6, 0x2a, 0xc3, 0xaa, 0x70, 0x58,
+	0x87, 0x82, 0xd0, 0xfb, 0x50, 0x08, 0x87, 0x81, 0xef, 0xba, 0xcd, 0x06, 0x9b, 0x8e, 0x97, 0x66,
+	0x26, 0xb6, 0x2b, 0x8e, 0xa5, 0x2c, 0x81, 0x48, 0x65, 0x37, 0x09, 0xfc, 0x63, 0xc7, 0xc5, 0xcd,
+	0x4b, 0x5c, 0x76, 0xa2, 0xd8, 0xda, 0x83, 0x8a, 0x26, 0x6c, 0xea, 0xe2, 0x3e, 0xc1, 0xe7, 0x62,
+	0x2d, 0xd0, 0x4f, 0x74, 0x0b, 0xf2, 0x5f, 0x53, 0x15, 0x11, 0xbb, 0xdc, 0x65, 0xb9, 0xea, 0x29,
+	0x91, 0x9c, 0x77, 0x8e, 0xf1, 0xc3, 0xcc, 0x87, 0xc6, 0xa7, 0xb9, 0x92, 0xd1, 0xc8, 0x98, 0xff,
+	0x9b, 0x85, 0xba, 0x9c, 0x51, 0xb1, 0x1a, 0xde, 0x81, 0x02, 0x8d, 0xeb, 0xa6, 0x61, 0xa2, 0x21,
+	0x8e, 0xd6, 0x67, 0x55, 0x96, 0x40, 0x41, 0x6d, 0x1a, 0x61, 0x72, 0xfb, 0x9b, 0x5d, 0x60, 0x7f,
+	0x25, 0x12, 0x5a, 0x87, 0xdc, 0xa9, 0x43, 0xf8, 0xd1, 0x46, 0x84, 0x2c, 0x1d, 0x90, 0x5d, 0x1a,
+	0xc8, 0x58, 0x0c, 0x83, 0x59, 0x4e, 0x9f, 0xd8, 0xee, 0x80, 0xe1, 0xe7, 0x85, 0xe5, 0xa4, 0x90,
+	0x47, 0xb4, 0xfa, 0x06, 0x94, 0xe9, 0x0e, 0xc1, 0xe3, 0x9d, 0x02, 0x8b, 0x77, 0x4a, 0x63, 0xfb,
+	0xac, 0x4f, 0xcb, 0xe8, 0x5d, 0xc8, 0x11, 0xdf, 0x7f, 0xd2, 0x2c, 0x5e, 0x24, 0x76, 0x86, 0x86,
+	0xee, 0x27, 0x54, 0xfd, 0xb5, 0xc4, 0x18, 0xb8, 0x60, 0x52, 0x75, 0xfd, 0x06, 0x94, 0xf9, 0xcc,
+	0x0d, 0x9c, 0x11, 0xd3, 0xf6, 0xb2, 0x55, 0xe2, 0x80, 0xed, 0x11, 0x15, 0x8e, 0x9c, 0x4c, 0x48,
+	0x11, 0xce, 0x3e, 0xaf, 0x8b, 0xa6, 0x78, 0xf7, 0xa2, 0x29, 0x5e, 0x8f, 0x4f, 0x31, 0x8a, 0x4f,
+	0x31, 0x0b, 0xe5, 0x67, 0x66, 0xf8, 0xbf, 0x0d, 0xa8, 0xc5, 0xfa, 0x43, 0xd7, 0xa1, 0xe8, 0xf9,
+	0x23, 0x3c, 0x50, 0xe7, 0x64, 0x05, 0x5a, 0xdc, 0x1e, 0xa1, 0x0d, 0x28, 0x4c, 0x4e, 0xed, 0x10,
+	0xcb, 0x8d, 0xa3, 0x95, 0xc6, 0x6e, 0x7b, 0x9f, 0xa2, 0x58, 0x02, 0x53, 0x89, 0x3a, 0xbb, 0x94,
+	0xa8, 0x5b, 0x9f, 0x42, 0x9e, 0xd1, 0xa7, 0xee, 0xa4, 0xb2, 0xad, 0xcc, 0x52, 0x6d, 0x99, 0x03,
+	0xa8, 0xf5, 0x99, 0xa8, 0xa5, 0x01, 0x8e, 0x4d, 0x86, 0x91, 0x98, 0x8c, 0x68, 0x31, 0x66, 0x96,
+	0x5c, 0x8c, 0xe6, 0xbf, 0x96, 0x21, 0xcf, 0xfd, 0xdf, 0xbb, 0x54, 0xdb, 0x08, 0x5d, 0xeb, 0xae,
+	0xab, 0x8e, 0x44, 0xb8, 0x70, 0x98, 0xce, 0x76, 0x5c, 0x97, 0x21, 0x3e, 0x5a, 0xa1, 0x5a, 0xc8,
+	0x01, 0xe8, 0xfb, 0x00, 0x9c, 0xc8, 0xf3, 0x55, 0x44, 0x71, 0x55, 0xa7, 0xda, 0xf3, 0x3d, 0x2c,
+	0xc9, 0xca, 0x63, 0x09, 0xa1, 0x0b, 0x99, 0x15, 0x84, 0x4c, 0x2f, 0xe9, 0x24, 0x12, 0x9d, 0x63,
+	0xa0, 0x8f, 0xa1, 0xca, 0xbb, 0x98, 0x9c, 0x06, 0x76, 0x88, 0xd5, 0x21, 0xb0, 0x46, 0xb1, 0xcf,
+	0x6a, 0x24, 0x5d, 0x65, 0x1c, 0xc1, 0xd0, 0x5b, 0x62, 0x43, 0xe5, 0x51, 0x9d, 0x34, 0xc7, 0xd4,
+	0x4d, 0x93, 0xe8, 0xac, 0x9e, 0x9e, 0xa1, 0x8a, 0xf6, 0x0b, 0x31, 0xbd, 0x8b, 0x37, 0x2d, 0x70,
+	0x18, 0x4f, 0xf4, 0x7c, 0x52, 0xf2, 0x54, 0x8c, 0xf3, 0x44, 0xab, 0x92, 0x3c, 0x45, 0x30, 0xd6,
+	0x17, 0x77, 0x29, 0x4a, 0xf1, 0xbe, 0x18, 0x30, 0xea, 0x8b, 0x15, 0xd1, 0x06, 0x94, 0xbe, 0x71,
+	0xdc, 0xd1, 0xd0, 0x0e, 0xf8, 0xea, 0x8b, 0xa6, 0xe5, 0x33, 0x01, 0x56, 0xd3, 0x22, 0xf1, 0x68,
+	0x0f, 0x01, 0x3e, 0xc1, 0x67, 0x93, 0x26, 0xc4, 0x7a, 0xb0, 0x18, 0x50, 0xf5, 0xc0, 0x71, 0xe8,
+	0x64, 0x70, 0xb7, 0xa5, 0x12, 0x9b, 0x8c, 0x87, 0x14, 0xa6, 0x26, 0x83, 0x61, 0xa0, 0x1f, 0x43,
+	0xcd, 0x9b, 0x8e, 0x71, 0xe0, 0x0c, 0x07, 0x01, 0x8d, 0x64, 0xd9, 0x4e, 0x14, 0x85, 0x5b, 0x7b,
+	0xbc, 0xce, 0xa2, 0x55, 0x92, 0xb2, 0xea, 0x69, 0x40, 0xaa, 0x30, 0x23, 0x9b, 0x60, 0x41, 0x5d,
+	0x8b, 0x29, 0x4c, 0x97, 0xc6, 0x22, 0x3a, 0x69, 0x79, 0x24, 0x21, 0x94, 0x8e, 0x39, 0x99, 0x9c,
+	0xae, 0x1e, 0xa3, 0xa3, 0xb3, 0x19, 0xa7, 0x23, 0x12, 0x42, 0x67, 0x8a, 0xfb, 0xf3, 0x21, 0x09,
+	0x1c, 0xef, 0xa4, 0xb9, 0x1a, 0x9b, 0x29, 0x46, 0xd0, 0x67, 0x35, 0x6a, 0xa6, 0x9e, 0x46, 0x30,
+	0x7a, 0xb8, 0x78, 0xe4, 0xfb, 0x2e, 0xb6, 0xbd, 0x66, 0x23, 0xb6, 0x51, 0x3c, 0xe0, 0x50, 0x49,
+	0x24, 0xb1, 0xd0, 0x47, 0x50, 0x19, 0xfa, 0xde, 0xcf, 0xa7, 0x1e, 0x3f, 0x22, 0xbd, 0x14, 0xeb,
+	0x6d, 0x33, 0xaa, 0x51, 0xbd, 0x69, 0xd8, 0x94, 0x78, 0xe4, 0x84, 0x8a, 0x18, 0xc5, 0x88, 0xbb,
+	0x4e, 0x38, 0x43, 0xac, 0x61, 0xa3, 0xb7, 0xa1, 0x40, 0xdd, 0x65, 0x67, 0xd4, 0xbc, 0x1c, 0x9b,
+	0xc5, 0xae, 0x3f, 0xdc, 0xee, 0xaa, 0x59, 0x1c, 0xf9, 0xc3, 0xed, 0x11, 0x15, 0x26, 0x65, 0x98,
+	0x47, 0x42, 0xcd, 0x2b, 0x31, 0x61, 0xd2, 0x91, 0x31, 0x4f, 0x45, 0x09, 0xf3, 0x48, 0x42, 0xa8,
+	0x30, 0x4f, 0xb0, 0x3f, 0x50, 0x7e, 0xe7, 0xd5, 0x18, 0x87, 0x5b, 0xd8, 0xef, 0x8a, 0x1a, 0xc5,
+	0xe1, 0x49, 0x04, 0x43, 0x0f, 0xa1, 0x41, 0xa9, 0x8f, 0xfc, 0xa9, 0x37, 0xa2, 0xc7, 0xf2, 0x47,
+	0xfe, 0x59, 0xf3, 0xda, 0x9a, 0xa1, 0x19, 0xe1, 0x2d, 0xec, 0x3f, 0x10, 0xb5, 0x0f, 0x7c, 0xb5,
+	0x10, 0xea, 0x27, 0x31, 0xf0, 0x83, 0xa2, 0xf0, 0x36, 0xcd, 0x4d, 0xa8, 0xc5, 0x2c, 0x13, 0xda,
+	0x80, 0xfc, 0x91, 0xef, 0x87, 0x44, 0x98, 0xaf, 0x97, 0x67, 0xcd, 0x9f, 0x3f, 0x3d, 0x72, 0x31,
+	0xf7, 0x32, 0x39, 0xaa, 0xd9, 0x85, 0x7a, 0xdc, 0x50, 0x3d, 0x57, 0x2b, 0x7f, 0x93, 0x01, 0x88,
+	0x8c, 0x17, 0x75, 0x93, 0xb9, 0x79, 0x13, 0x01, 0x06, 0x2b, 0xcc, 0x09, 0x30, 0x16, 0x9d, 0x24,
+	0x2a, 0x56, 0x72, 0x4b, 0xb3, 0x42, 0xcf, 0x8d, 0xb8, 0xe5, 0x18, 0xb8, 0xd8, 0x3b, 0x21, 0xa7,
+	0xc2, 0x81, 0xae, 0x72, 0xe0, 0x0e, 0x83, 0x2d, 0x8e, 0x49, 0xd0, 0xf7, 0xa1, 0xe4, 0x4f, 0x70,
+	0x60, 0x13, 0x3f, 0x60, 0xa6, 0xad, 0xae, 0x66, 0x28, 0x1a, 0x63, 0xfb, 0xb1, 0xc0, 0xb0, 0x14,
+	0xae, 0x79, 0x03, 0x4a, 0x12, 0x8a, 0x0a, 0x90, 0x79, 0x6c, 0x35, 0x56, 0x50, 0x11, 0xb2, 0x9d,
+	0xbd, 0x6e, 0xc3, 0x30, 0xff, 0xd2, 0x80, 0x46, 0xd2, 0x5a, 0x53, 0x0f, 0x33, 0x66, 0xdc, 0xb9,
+	0xbc, 0x62, 0x16, 0xfc, 0x3b, 0x91, 0x9a, 0xe9, 0x40, 0x59, 0x6d, 0x0a, 0xf3, 0xa2, 0xb0, 0x14,
+	0x36, 0x54, 0x57, 0xd9, 0xe5, 0xbb, 0x1a, 0x43, 0x45, 0x17, 0xc1, 0x15, 0xc8, 0xf3, 0xb3, 0x06,
+	0x7e, 0xe0, 0xc2, 0x0b, 0x2f, 0xb0, 0xbb, 0x7f, 0x31, 0xc4, 0x65, 0x9a, 0xde, 0xe9, 0x5d, 0xbd,
+	0xd3, 0xca, 0xc6, 0x2b, 0x73, 0x76, 0x2e, 0x66, 0x5a, 0xc3, 0x17, 0xce, 0x53, 0xeb, 0x15, 0xc8,
+	0x1f, 0xc8, 0x26, 0x67, 0x07, 0x6f, 0xfa, 0x50, 0xd1, 0xf6, 0x42, 0x2d, 0x04, 0x37, 0x62, 0x21,
+	0xf8, 0x8b, 0x93, 0xd1, 0x14, 0x6a, 0xb1, 0xcd, 0x94, 0xaa, 0x97, 0xda, 0x74, 0x85, 0x97, 0x25,
+	0xcb, 0x2f, 0xb0, 0x5b, 0x1f, 0x2a, 0xda, 0x8e, 0x4c, 0xc7, 0x29, 0x76, 0x6d, 0x31, 0x4e, 0x5e,
+	0x7a, 0x81, 0x1d, 0xfe, 0xa3, 0x01, 0x10, 0x6d, 0xeb, 0xa9, 0x7a, 0x3e, 0x63, 0x3e, 0x32, 0x17,
+	0x99, 0x8f, 0x6c, 0xd2, 0x7c, 0xa4, 0xde, 0x7f, 0x44, 0xfc, 0xe6, 0x97, 0xe7, 0xf7, 0x57, 0x19,
+	0xb8, 0x34, 0xe3, 0x53, 0xa0, 0x36, 0x64, 0xc7, 0x8e, 0xb7, 0x94, 0x79, 0xa6, 0x88, 0x0c, 0xdf,
+	0x3e, 0x6b, 0x66, 0x96, 0xc2, 0xb7, 0xcf, 0xa8, 0x93, 0xc3, 0x22, 0xe8, 0xd0, 0xf9, 0x1a, 0x0f,
+	0x68, 0x4f, 0x59, 0xb1, 0x4b, 0x25, 0x29, 0xe9, 0x5e, 0xc9, 0xe9, 0xaa, 0x8a, 0x60, 0xd7, 0xf1,
+	0x12, 0x0d, 0xd8, 0x67, 0xcd, 0xdc, 0xb3, 0x34, 0x60, 0x6b, 0x9a, 0x9d, 0x4f, 0x95, 0x60, 0x61,
+	0x79, 0x09, 0xfe, 0x7b, 0x06, 0xea, 0x71, 0xbf, 0x0a, 0xbd, 0x27, 0xef, 0x93, 0x8c, 0x39, 0x5c,
+	0x1d, 0xc8, 0x6c, 0x0f, 0x79, 0xd7, 0x74, 0x9b, 0xdf, 0x35, 0x65, 0x2e, 0xc4, 0xa7, 0x68, 0x68,
+	0x13, 0x56, 0xa3, 0xd1, 0x47, 0x37, 0x57, 0x8b, 0xc7, 0x5f, 0x57, 0x24, 0x7d, 0xd6, 0x65, 0x4c,
+	0x84, 0xf2, 0xa2, 0x6b, 0x59, 0x11, 0xf6, 0xbc, 0xd1, 0x0b, 0x14, 0xe1, 0xef, 0x65, 0xa0, 0x1e,
+	0x77, 0x31, 0x69, 0xd8, 0x2a, 0x35, 0xb0, 0xcc, 0x75, 0xac, 0x11, 0xe9, 0x58, 0xf9, 0xff, 0x9f,
+	0x16, 0x7d, 0x05, 0x8d, 0xa4, 0xab, 0x8c, 0xae, 0x08, 0x37, 0x4c, 0xfa, 0x38, 0x4f, 0xe3, 0xce,
+	0x53, 0x66, 0xf9, 0xd6, 0x7f, 0x6d, 0x40, 0x55, 0x77, 0xa8, 0xd1, 0x1a, 0xe4, 0xc6, 0xd3, 0x90,
+	0x88, 0xcd, 0x29, 0x7e, 0x9c, 0xc8, 0x6a, 0xe8, 0x45, 0x65, 0x78, 0xea, 0x4f, 0x99, 0x4d, 0x9c,
+	0xc5, 0x11, 0x75, 0xe8, 0x7b, 0x50, 0xa2, 0xd8, 0x03, 0xcf, 0x27, 0xcd, 0x6c, 0x0a, 0x5e, 0x91,
+	0xd6, 0xee, 0xf9, 0xec, 0x08, 0x9b, 0x9e, 0xd6, 0x8b, 0x26, 0xf9, 0xd5, 0x72, 0x79, 0xec, 0x78,
+	0x7d, 0xde, 0xce, 0xf3, 0x98, 0xae, 0x00, 0x1a, 0x49, 0x7f, 0x1f, 0xbd, 0x0d, 0x65, 0xe9, 0xef,
+	0x87, 0xa9, 0x83, 0x8b, 0xaa, 0x9f, 0x4b, 0x90, 0x7f, 0x68, 0x40, 0x23, 0x19, 0x27, 0xd0, 0x4e,
+	0x65, 0x9c, 0x30, 0xa7, 0x53, 0x55, 0x2d, 0xf5, 0x3a, 0xc3, 0x04, 0x40, 0x3f, 0x9f, 0x6b, 0x97,
+	0xb1, 0x00, 0xa2, 0xa8, 0x43, 0xe6, 0x0b, 0x19, 0x51, 0xbe, 0xd0, 0xf3, 0x0c, 0x6d, 0x02, 0xf5,
+	0x78, 0x64, 0x42, 0xf5, 0x8f, 0x1f, 0x14, 0x19, 0xfc, 0xb0, 0x9d, 0x15, 0x5e, 0xe0, 0x5e, 0xd9,
+	0x86, 0xd2, 0x16, 0xf6, 0xf7, 0x7d, 0xc7, 0x63, 0x97, 0xed, 0xae, 0xc8, 0x78, 0x31, 0x2c, 0xfa,
+	0xc9, 0x20, 0x36, 0x91, 0x92, 0x72, 0x6d, 0x62, 0xfe, 0x9d, 0x01, 0x8d, 0x64, 0x08, 0x44, 0x13,
+	0x7a, 0xe4, 0xf9, 0xae, 0x30, 0xb7, 0xab, 0x51, 0xac, 0xc3, 0xda, 0xb6, 0x14, 0x42, 0xec, 0x48,
+	0x9f, 0xb3, 0xaf, 0xca, 0xd1, 0xb8, 0xb2, 0xa9, 0xe3, 0x7a, 0x06, 0x4f, 0xf7, 0x57, 0x06, 0x5c,
+	0x4e, 0x09, 0xb4, 0xd0, 0xdb, 0x50, 0x22, 0xfe, 0x64, 0xe0, 0xe2, 0x63, 0x32, 0x8f, 0xd5, 0x22,
+	0xf1, 0x27, 0x3b, 0xf8, 0x98, 0xa0, 0x0d, 0xa8, 0x1e, 0xf9, 0x84, 0xd0, 0x9b, 0x7d, 0x76, 0x14,
+	0x9f, 0x49, 0xc7, 0xaf, 0x70, 0x24, 0x8b, 0xe2, 0xbc, 0xc0, 0x11, 0xfc, 0x51, 0x1e, 0xca, 0xea,
+	0x40, 0x1d, 0xb5, 0x65, 0x46, 0x08, 0x67, 0xfa, 0x5a, 0xf2, 0xc4, 0xbd, 0xcd, 0xce, 0x4b, 0x69,
+	0xf0, 0xcb, 0xd0, 0xd0, 0x9b, 0xea, 0x5a, 0x50, 0x3b, 0xf7, 0x55, 0xc8, 0xdb, 0xdd, 0x47, 0x2b,
+	0xec, 0xb6, 0xb0, 0xad, 0xb3, 0x9b, 0xd6, 0x2c, 0xfb, 0xa5, 0xcd, 0xf2, 0x81, 0x74, 0x12, 0xb1,
+	0xb1, 0x1c, 0x4f, 0x92, 0x4c, 0x53, 0x91, 0x64, 0x80, 0x8c, 0x20, 0x37, 0xc2, 0xe1, 0x50, 0xdc,
+	0x1e, 0xb1, 0xef, 0x56, 0x11, 0xf2, 0x8c, 0xff, 0x56, 0x0e, 0x32, 0xdb, 0xdd, 0xd6, 0xdf, 0x1b,
+	0x90, 0xe7, 0xc3, 0x56, 0xe2, 0x34, 0x74, 0x71, 0xde, 0x12, 0x89, 0x13, 0x19, 0x16, 0xb5, 0x5d,
+	0x9d, 0xe9, 0x5d, 0x4b, 0x9b, 0xb8, 0x05, 0xb9, 0xb1, 0x3f, 0xe2, 0x97, 0x47, 0x69, 0xa8, 0xbb,
+	0xfe, 0x08, 0x5b, 0x0c, 0x05, 0x6d, 0x40, 0x51, 0x24, 0xce, 0xb1, 0x61, 0xd5, 0x37, 0x9a, 0xb3,
+	0xd8, 0xbc, 0xde, 0x92, 0x88, 0xad, 0x11, 0x54, 0xb4, 0xa1, 0xce, 0x61, 0x57, 0x5f, 0x1e, 0x99,
+	0x8b, 0x96, 0x07, 0x82, 0xdc, 0xd4, 0x73, 0x88, 0xd0, 0x1f, 0xf6, 0x6d, 0x6e, 0x88, 0x2c, 0x8c,
+	0x12, 0xe4, 0x3a, 0x87, 0x07, 0x8f, 0x79, 0x12, 0x46, 0xff, 0xc0, 0xda, 0xde, 0xdb, 0xe2, 0x49,
+	0x18, 0x7b, 0x87, 0xbb, 0x0f, 0x7a, 0x56, 0x23, 0x43, 0x31, 0x58, 0x6a, 0x46, 0xd6, 0x7c, 0x13,
+	0x72, 0x74, 0x6c, 0xa8, 0x02, 0xc5, 0x6e, 0xef, 0x61, 0xe7, 0x70, 0xe7, 0x80, 0x87, 0xa9, 0xbb,
+	0xdb, 0x7b, 0x0d, 0x83, 0x7d, 0x74, 0x3e, 0x6f, 0x64, 0xcc, 0x57, 0xa1, 0x28, 0x06, 0x45, 0x69,
+	0x77, 0x3a, 0x7d, 0x8a, 0x56, 0x86, 0xfc, 0xc3, 0x6d, 0xab, 0x7f, 0xd0, 0x30, 0x1e, 0xe4, 0x20,
+	0x73, 0x74, 0x6e, 0xfe, 0x04, 0x1a, 0xc9, 0x9b, 0x27, 0x9e, 0xb0, 0x73, 0xee, 0xca, 0x68, 0x96,
+	0x17, 0xb4, 0x4b, 0xa8, 0x8c, 0x7e, 0x09, 0x65, 0xfe, 0x67, 0x16, 0xaa, 0xfa, 0x05, 0xc6, 0x1c,
+	0x51, 0xc9, 0xfb, 0xc6, 0x8c, 0x76, 0xdf, 0xb8, 0x05, 0xf5, 0xd8, 0x69, 0x9c, 0x4c, 0xd1, 0x58,
+	0x4b, 0xb9, 0x17, 0x89, 0x9d, 0xcd, 0x59, 0x35, 0xfd, 0x50, 0x2e, 0x44, 0x9f, 0x40, 0x25, 0x3a,
+	0x95, 0x93, 0x37, 0x17, 0xaf, 0xa4, 0xb5, 0xa2, 0x7c, 0x49, 0x0b, 0xd4, 0xe1, 0x5c, 0xd8, 0xfa,
+	0x7d, 0x03, 0xaa, 0x7a, 0xfb, 0xa9, 0x47, 0xdf, 0xed, 0x68, 0x73, 0x79, 0x16, 0xb7, 0x3d, 0xbb,
+	0xa4, 0xdb, 0xde, 0xfa, 0x03, 0x03, 0xca, 0x8a, 0xbd, 0x54, 0x0e, 0x36, 0xa4, 0xe7, 0x3b, 0x8f,
+	0x07, 0xee, 0xdf, 0x08, 0x63, 0xc3, 0x50, 0x29, 0x17, 0xd4, 0xfd, 0xcc, 0x2e, 0x41, 0x41, 0x11,
+	0xcd, 0xff, 0x32, 0xa0, 0xaa, 0x5f, 0x23, 0xb1, 0x10, 0xd7, 0x27, 0xb6, 0x2b, 0xf3, 0x4a, 0x59,
+	0x81, 0x69, 0x83, 0xed, 0xb8, 0x78, 0x24, 0x26, 0x54, 0x94, 0xd0, 0xab, 0x00, 0xe1, 0x74, 0x38,
+	0xc4, 0x61, 0x78, 0x3c, 0x75, 0x45, 0x68, 0xa5, 0x41, 0xd0, 0x0f, 0xa0, 0xc0, 0x72, 0x61, 0xe4,
+	0x24, 0xdd, 0x4c, 0xb9, 0xb9, 0x6a, 0xf7, 0x18, 0x86, 0xb8, 0xc5, 0xe1, 0xe8, 0xad, 0xfb, 0x50,
+	0xd1, 0xc0, 0x29, 0x17, 0x2f, 0x57, 0xf4, 0x8b, 0x97, 0xb2, 0x76, 0xc9, 0x62, 0xfe, 0xba, 0x08,
+	0xb5, 0xd8, 0xf5, 0xd5, 0x92, 0x09, 0x17, 0x2a, 0x57, 0x2f, 0xbb, 0x20, 0x57, 0x2f, 0xb7, 0x54,
+	0xae, 0x1e, 0xea, 0x40, 0x39, 0xba, 0x28, 0xcd, 0xb3, 0xa1, 0xbf, 0x9e, 0x76, 0xb3, 0xd6, 0x56,
+	0xd7, 0xa6, 0x7c, 0xf8, 0x11, 0x15, 0x6d, 0xe2, 0x38, 0xb0, 0x4f, 0x78, 0x02, 0x71, 0x61, 0x41,
+	0x13, 0x0f, 0x25, 0x96, 0x68, 0x42, 0x51, 0x21, 0x24, 0x2e, 0x77, 0xf9, 0x35, 0x32, 0xfb, 0xd6,
+	0x52, 0x6f, 0x4a, 0xcb, 0xe5, 0x36, 0xdd, 0x81, 0x82, 0xb8, 0xc6, 0x2d, 0x5f, 0x40, 0xc0, 0xd1,
+	0x5a, 0x63, 0x28, 0xc9, 0x51, 0xd1, 0x79, 0x9b, 0xf8, 0xa1, 0xc8, 0x87, 0xa0, 0x9f, 0x51, 0x7a,
+	0xa0, 0x48, 0x0f, 0x88, 0xa5, 0x07, 0xf2, 0x6c, 0x0c, 0xfa, 0x49, 0x6f, 0x7d, 0xed, 0x20, 0xb0,
+	0xcf, 0x07, 0x32, 0xb1, 0x90, 0xab, 0x50, 0xce, 0xaa, 0x33, 0xf0, 0xbe, 0x84, 0xb6, 0x1e, 0x41,
+	0x39, 0xba, 0x7b, 0xfe, 0x48, 0x97, 0x7b, 0xfc, 0xb0, 0x28, 0x5d, 0xee, 0x9a, 0xc4, 0x5b, 0xff,
+	0x64, 0x40, 0x8d, 0x46, 0x4e, 0x51, 0x73, 0x9b, 0xf1, 0x73, 0xa7, 0x77, 0x53, 0x9b, 0x8a, 0x91,
+	0xb0, 0x92, 0x98, 0x09, 0x4e, 0xdb, 0xfa, 0x1c, 0x20, 0x02, 0xa6, 0x68, 0xf2, 0x07, 0xf1, 0x2b,
+	0xc4, 0x57, 0x17, 0xeb, 0x89, 0xa6, 0xe9, 0xad, 0x5b, 0x50, 0x56, 0x93, 0xcf, 0x0e, 0x39, 0x64,
+	0x41, 0xb8, 0xaf, 0x11, 0xa0, 0xf5, 0x3b, 0x50, 0x8f, 0xab, 0x5a, 0x0a, 0x23, 0x1f, 0xc6, 0x19,
+	0x31, 0x2f, 0x1e, 0xad, 0xce, 0xcc, 0x57, 0x50, 0x8f, 0x6b, 0xe2, 0xf3, 0x0e, 0x55, 0xb5, 0xa2,
+	0x2f, 0xea, 0x31, 0x54, 0xb4, 0xc5, 0x16, 0xf7, 0xa6, 0x0d, 0x81, 0xc8, 0xf2, 0x70, 0x71, 0x18,
+	0xda, 0x27, 0xd2, 0x2a, 0xc8, 0x22, 0x6a, 0x43, 0x69, 0x78, 0xea, 0xb8, 0xa3, 0x00, 0x7b, 0x62,
+	0xd3, 0x49, 0x5b, 0xc2, 0x0a, 0xc7, 0xfc, 0x65, 0x1e, 0x2a, 0xda, 0x1d, 0xee, 0x9c, 0x4d, 0x4e,
+	0xd9, 0xca, 0x8c, 0x6e, 0x2b, 0x9b, 0x91, 0xfb, 0xc1, 0x0d, 0xa2, 0x2c, 0x52, 0x7c, 0x9f, 0x9c,
+	0xe2, 0x40, 0x64, 0xe1, 0xf0, 0x02, 0x35, 0xf3, 0x5c, 0xc9, 0xb8, 0x9d, 0x78, 0x79, 0xf6, 0x0a,
+	0x99, 0x09, 0x9d, 0x97, 0x39, 0x2a, 0xfa, 0xe9, 0xcc, 0x56, 0xca, 0x2d, 0xc4, 0x1b, 0x29, 0xc4,
+	0xfa, 0x4e, 0xc7, 0xe1, 0x89, 0xed, 0xf4, 0x41, 0x7c, 0x3b, 0x2d, 0xc6, 0x6e, 0xdc, 0xf5, 0x96,
+	0xd4, 0x76, 0xc5, 0x81, 0xfa, 0x96, 0x7a, 0x8f, 0x1f, 0x48, 0xb3, 0x8a, 0x8b, 0xd3, 0x82, 0xe4,
+	0x7b, 0x85, 0xd6, 0x2f, 0x8c, 0xf8, 0x89, 0x99, 0xa2, 0xff, 0xae, 0xb7, 0xe3, 0x88, 0xbf, 0x9c,
+	0xce, 0xdf, 0x5f, 0x1b, 0xda, 0x79, 0xd4, 0x7c, 0xe6, 0xbe, 0x83, 0x9d, 0x3a, 0x9d, 0x41, 0x73,
+	0x00, 0x55, 0x96, 0x5a, 0xbb, 0x6b, 0x4f, 0x26, 0x54, 0xc5, 0xee, 0xd3, 0x53, 0x98, 0x11, 0x3e,
+	0x1b, 0x8c, 0x39, 0x60, 0xe1, 0x6b, 0x81, 0xaa, 0xa3, 0x93, 0xa6, 0x26, 0x4b, 0x99, 0xff, 0x60,
+	0x40, 0x99, 0xf5, 0xb0, 0xed, 0x1d, 0xfb, 0xa9, 0x83, 0x9f, 0xe9, 0x32, 0xb3, 0x74, 0x97, 0xb7,
+	0x01, 0x71, 0xd2, 0x90, 0xf8, 0x81, 0x7d, 0x82, 0xf9, 0xe3, 0x24, 0xee, 0x31, 0x37, 0x58, 0x4d,
+	0x9f, 0x57, 0x30, 0xaf, 0xf9, 0x26, 0x54, 0x46, 0xf8, 0xd8, 0x9e, 0xba, 0x64, 0x40, 0x88, 0xcb,
+	0xe4, 0x90, 0xb5, 0x40, 0x80, 0x0e, 0x88, 0x6b, 0xfe, 0x40, 0xb0, 0xca, 0xf2, 0x57, 0xdf, 0x86,
+	0xa2, 0x9e, 0xae, 0x1c, 0xdd, 0xa6, 0xab, 0xd1, 0x58, 0x12, 0xc1, 0xbc, 0x07, 0xf9, 0x8e, 0xeb,
+	0xd8, 0xe9, 0xd9, 0x84, 0xcd, 0xa8, 0x21, 0xee, 0x0a, 0x2b, 0xb2, 0xbb, 0x50, 0x66, 0x64, 0xac,
+	0xbf, 0xb7, 0xa0, 0x68, 0xd3, 0x02, 0x4e, 0x1e, 0x5b, 0x30, 0x14, 0x4b, 0x56, 0x9a, 0xa7, 0xd0,
+	0xe8, 0x7f, 0x63, 0x4f, 0x38, 0x74, 0x41, 0xee, 0xf3, 0x6b, 0x50, 0x65, 0xc9, 0xe8, 0xf1, 0xbe,
+	0x2b, 0x14, 0x26, 0x73, 0xae, 0x59, 0x42, 0x8e, 0x42, 0xe0, 0x19, 0xa2, 0x65, 0xe2, 0x8b, 0x6a,
+	0xf3, 0x2f, 0xb2, 0x50, 0xb3, 0xb0, 0x10, 0x23, 0x73, 0xee, 0xf8, 0x59, 0x2a, 0x91, 0x99, 0xea,
+	0x2d, 0x75, 0xcf, 0xae, 0x21, 0xb5, 0xe9, 0x1f, 0xae, 0xa5, 0x84, 0x65, 0x69, 0xf1, 0x9c, 0x9f,
+	0xe8, 0x31, 0x13, 0xdf, 0xb8, 0xeb, 0x0c, 0x2c, 0x2d, 0x77, 0xc8, 0x53, 0xc4, 0x68, 0xbf, 0x23,
+	0x0d, 0x95, 0xef, 0xe7, 0x0d, 0x51, 0x11, 0x21, 0xb7, 0xe1, 0xf2, 0xd0, 0x9e, 0x9e, 0x9c, 0x92,
+	0xc1, 0x74, 0xa2, 0xa1, 0xf3, 0x5c, 0xcb, 0x4b, 0xbc, 0xea, 0x70, 0x12, 0xe1, 0xdf, 0x07, 0x60,
+	0x8b, 0x66, 0x40, 0x9c, 0x31, 0x6e, 0xe6, 0xe7, 0x1c, 0x2c, 0x46, 0x07, 0xbb, 0x65, 0x86, 0x4d,
+	0xcb, 0xe8, 0x1e, 0x94, 0xb0, 0x37, 0xe2, 0x84, 0x85, 0x0b, 0x09, 0x8b, 0xd8, 0x1b, 0x31, 0x32,
+	0x95, 0xd1, 0x5d, 0xd4, 0x33, 0xba, 0xb7, 0xf8, 0x63, 0x1c, 0x16, 0xc0, 0x6d, 0x77, 0x77, 0x7a,
+	0x8d, 0x15, 0x1a, 0x96, 0x59, 0x87, 0x7b, 0x7b, 0x3c, 0x82, 0xab, 0x41, 0x79, 0xf3, 0xf1, 0xee,
+	0x3e, 0x4d, 0xa4, 0xef, 0x36, 0x32, 0x34, 0xa0, 0x7b, 0xd8, 0xd9, 0xde, 0xe9, 0x75, 0x1b, 0x59,
+	0x54, 0x85, 0xd2, 0x66, 0x67, 0x6f, 0xb3, 0x47, 0x4b, 0x39, 0xf3, 0x97, 0x59, 0xb1, 0x6e, 0x37,
+	0xfd, 0xf1, 0xd8, 0xf6, 0x68, 0x0a, 0x84, 0xfe, 0x84, 0xa0, 0xa9, 0xab, 0xaa, 0x40, 0xd1, 0x83,
+	0xe1, 0x75, 0xc8, 0x8d, 0x6c, 0x62, 0x2f, 0x5c, 0x69, 0x0c, 0xc3, 0xfc, 0xb7, 0x8c, 0x08, 0x39,
+	0x2f, 0xc3, 0xea, 0xe1, 0xde, 0x4f, 0xf7, 0x1e, 0x7f, 0xb6, 0x37, 0xd8, 0x7c, 0xbc, 0xbb, 0x4b,
+	0x2f, 0x39, 0x57, 0x50, 0x03, 0xaa, 0xfd, 0xde, 0xc1, 0x60, 0xb7, 0x77, 0xd0, 0xe9, 0x76, 0x0e,
+	0x3a, 0x0d, 0x83, 0xa2, 0xf1, 0x87, 0x00, 0x11, 0x30, 0x83, 0x10, 0xd4, 0xd9, 0xa3, 0x81, 0x41,
+	0xf7, 0xf1, 0xe6, 0xe1, 0x6e, 0x6f, 0xef, 0xa0, 0x91, 0xd5, 0x10, 0x15, 0x30, 0x87, 0xae, 0xc2,
+	0xa5, 0xfd, 0xc3, 0x83, 0x01, 0x47, 0xde, 0xed, 0xec, 0xef, 0x53, 0xb1, 0xe4, 0x69, 0x37, 0xfc,
+	0xc5, 0x20, 0xaf, 0x69, 0x14, 0x28, 0x44, 0x50, 0x73, 0x48, 0x91, 0x8a, 0x8e, 0x92, 0x76, 0x76,
+	0xb6, 0x3b, 0xfd, 0x46, 0x49, 0x43, 0xe0, 0x90, 0x32, 0xaa, 0x03, 0xf4, 0x3f, 0xeb, 0xec, 0x8b,
+	0x32, 0xb0, 0x01, 0xb1, 0xe7, 0x0b, 0x11, 0x03, 0x15, 0xd4, 0x84, 0x2b, 0xb4, 0x95, 0xfd, 0x9e,
+	0xb5, 0xf9, 0x78, 0xa7, 0x73, 0xf0, 0xd8, 0x1a, 0xfc, 0xec, 0xb0, 0x67, 0x7d, 0xd1, 0xa8, 0xa2,
+	0x1b, 0x70, 0x5d, 0x34, 0x38, 0x53, 0x59, 0xa3, 0x64, 0x54, 0x0e, 0x56, 0xef, 0xd3, 0xde, 0xe6,
+	0x41, 0xaf, 0x3b, 0xd8, 0x7c, 0xd4, 0xd9, 0xdb, 0xea, 0xf5, 0x1b, 0xf5, 0x8d, 0x3f, 0xbe, 0x0c,
+	0x79, 0x36, 0x0b, 0x74, 0x86, 0x3e, 0xf5, 0x1d, 0x0f, 0x41, 0x9b, 0xbd, 0x0f, 0xdd, 0xf3, 0x47,
+	0xb8, 0x75, 0x6d, 0x46, 0xf2, 0x3d, 0xfa, 0x6a, 0xd5, 0x5c, 0x41, 0xef, 0x42, 0x7e, 0x07, 0xdb,
+	0x5f, 0xe3, 0x25, 0xd1, 0xef, 0x40, 0x71, 0x0b, 0x13, 0x8a, 0x84, 0xe6, 0x20, 0xb5, 0xb4, 0x86,
+	0xcc, 0x15, 0x74, 0x0f, 0x60, 0x0b, 0x93, 0x4d, 0x77, 0x1a, 0x12, 0x1c, 0xcc, 0xa5, 0xa9, 0x71,
+	0x1a, 0x81, 0x66, 0xae, 0xa0, 0x8f, 0xa1, 0xd4, 0xf7, 0xec, 0x49, 0x78, 0xea, 0x93, 0xb9, 0x44,
+	0xf3, 0xb9, 0xbc, 0x05, 0xd9, 0x2d, 0x4c, 0x50, 0xf2, 0x91, 0x5f, 0x2b, 0x09, 0x30, 0x57, 0xd0,
+	0x8f, 0xa0, 0x24, 0x9f, 0x44, 0xa2, 0x6b, 0xfa, 0x75, 0x6d, 0xf4, 0x60, 0xb3, 0x75, 0x7d, 0x06,
+	0xce, 0xd3, 0xfa, 0xcc, 0x15, 0xf4, 0xbe, 0x94, 0xfa, 0x4c, 0x5f, 0xf2, 0x0c, 0x4c, 0x7f, 0x0c,
+	0x69, 0xae, 0xac, 0x1b, 0x34, 0x55, 0xae, 0x8b, 0x5d, 0x4c, 0xf0, 0x33, 0xd0, 0xbc, 0x0f, 0x39,
+	0xfa, 0xee, 0x0e, 0x21, 0xed, 0x11, 0x9e, 0xe4, 0xee, 0x72, 0x0c, 0xa6, 0x38, 0xbb, 0x0b, 0x05,
+	0xfe, 0xb4, 0x0e, 0x5d, 0x89, 0x3c, 0xc8, 0xe8, 0xa5, 0x5d, 0x8a, 0x2c, 0xde, 0x33, 0x68, 0x18,
+	0xcc, 0x23, 0x5e, 0x94, 0x9a, 0x8c, 0xd9, 0xba, 0x9a, 0x9a, 0xde, 0x68, 0xae, 0x50, 0x87, 0x99,
+	0x67, 0x90, 0x5f, 0x56, 0x49, 0x39, 0xd1, 0x5b, 0xbc, 0xd6, 0x95, 0x38, 0x50, 0x51, 0xfd, 0x10,
+	0x8a, 0xe2, 0x21, 0x1b, 0xba, 0xaa, 0xbb, 0xb9, 0xea, 0xd5, 0x5c, 0xeb, 0x5a, 0x12, 0xac, 0xd3,
+	0x8a, 0x27, 0x58, 0x8a, 0x36, 0xfe, 0xea, 0xac, 0x75, 0x2d, 0x09, 0xd6, 0x69, 0x45, 0x6e, 0xb6,
+	0xa2, 0x8d, 0xa7, 0xb2, 0xb7, 0xae, 0x25, 0xc1, 0x8a, 0x76, 0x33, 0x96, 0xd5, 0xdf, 0x9c, 0x4d,
+	0x90, 0x17, 0x2d, 0xbc, 0x94, 0x52, 0xa3, 0x1a, 0xb9, 0x0f, 0x45, 0xf1, 0xd6, 0x22, 0x62, 0x20,
+	0xf6, 0xf6, 0x62, 0xbe, 0xa4, 0x1f, 0x02, 0xda, 0x9f, 0x92, 0xe4, 0x2b, 0x9b, 0x39, 0x6f, 0x5e,
+	0x16, 0xac, 0x91, 0x6d, 0xb8, 0xca, 0xd5, 0xf0, 0x37, 0x6f, 0x6a, 0x17, 0xae, 0x52, 0xcf, 0x22,
+	0x4e, 0xe0, 0xe0, 0x70, 0x6e, 0x53, 0xad, 0x74, 0x38, 0x6d, 0xc4, 0x5c, 0x41, 0x3f, 0x81, 0xb2,
+	0xac, 0xc0, 0xe8, 0x7a, 0x02, 0x55, 0xcd, 0x6e, 0x73, 0xb6, 0x42, 0xc9, 0xe8, 0x00, 0xae, 0xa6,
+	0xbe, 0xa9, 0x41, 0xaf, 0xab, 0x69, 0x9d, 0xff, 0xe2, 0xa6, 0x85, 0x12, 0x2d, 0xd3, 0x37, 0x8d,
+	0x74, 0x71, 0x7c, 0x0c, 0x65, 0x45, 0xa7, 0xf8, 0x4a, 0xbe, 0xd8, 0x53, 0xd4, 0xda, 0xab, 0x3a,
+	0x46, 0x7d, 0x08, 0x8d, 0xe4, 0x23, 0x2b, 0xf4, 0x6a, 0xe2, 0x39, 0x55, 0xe2, 0xa9, 0x59, 0xeb,
+	0xe6, 0xdc, 0x7a, 0x35, 0x54, 0xba, 0x62, 0x79, 0xfe, 0xb7, 0x5a, 0xb1, 0x7a, 0x62, 0xeb, 0x7c,
+	0x3d, 0xfa, 0x11, 0x54, 0x36, 0x5d, 0x6c, 0x07, 0x0b, 0xa9, 0xe7, 0xcf, 0xf9, 0x27, 0xd1, 0x71,
+	0x5c, 0x80, 0xed, 0xf1, 0x1c, 0x7b, 0x91, 0x9a, 0xa5, 0xcd, 0xc4, 0x71, 0x9b, 0x5e, 0x03, 0x11,
+	0xbe, 0x88, 0x66, 0x1c, 0xde, 0x96, 0x74, 0x49, 0x59, 0x3d, 0x5b, 0xb0, 0xab, 0x5b, 0x98, 0xc4,
+	0x02, 0x88, 0x59, 0xa2, 0xcb, 0x3a, 0x44, 0xa0, 0x31, 0x75, 0x5a, 0xdd, 0x9f, 0xc6, 0x69, 0xd3,
+	0x30, 0x17, 0x8c, 0xf5, 0x63, 0x7a, 0x03, 0x45, 0xe2, 0x1e, 0xea, 0x6c, 0xf7, 0x57, 0xd2, 0x9c,
+	0x54, 0xb6, 0xd6, 0x2b, 0x9b, 0x01, 0xb6, 0x09, 0xde, 0xe6, 0xef, 0xfc, 0x67, 0x08, 0xe7, 0x77,
+	0x7c, 0x1f, 0x2a, 0x7c, 0x8d, 0x3e, 0x3b, 0xe9, 0x7b, 0x4c, 0xbe, 0xf3, 0xe8, 0x66, 0x20, 0xbc,
+	0x33, 0xba, 0x00, 0xa5, 0xcf, 0x3e, 0x6f, 0xd7, 0x8d, 0x91, 0x8a, 0x15, 0xbb, 0x01, 0xa5, 0xfd,
+	0x29, 0xe1, 0x91, 0x49, 0x2c, 0x9a, 0x58, 0xc0, 0xe0, 0x3d, 0x39, 0xb6, 0x67, 0x23, 0xfb, 0x04,
+	0xca, 0x2a, 0x2a, 0x89, 0x16, 0x61, 0x22, 0x4e, 0x59, 0x40, 0xbf, 0xce, 0xe4, 0x92, 0xd6, 0x67,
+	0xac, 0x14, 0xc9, 0xa3, 0xc3, 0xc3, 0xa1, 0x0b, 0xe5, 0xa1, 0x02, 0x2c, 0x73, 0xe5, 0xc1, 0xfa,
+	0x97, 0x6f, 0x9d, 0x38, 0xe4, 0x74, 0x7a, 0xd4, 0x1e, 0xfa, 0xe3, 0x3b, 0x63, 0x3f, 0x9c, 0x3e,
+	0xb1, 0xef, 0x1c, 0xb9, 0x76, 0x48, 0xee, 0xc4, 0xff, 0x37, 0xc9, 0x51, 0x81, 0x95, 0xef, 0xfe,
+	0xdf, 0x00, 0xf1, 0x63, 0xa6, 0x8c, 0xb4, 0x44, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IndexClient is the client API for Index service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IndexClient interface {
+	Join(ctx context.Context, in *raft.Node, opts ...grpc.CallOption) (*empty.Empty, error)
+	Leave(ctx context.Context, in *raft.Node, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetNode(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*raft.Node, error)
+	GetCluster(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*raft.Cluster, error)
+	Snapshot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	Get(ctx context.Context, in *Document, opts ...grpc.CallOption) (*Document, error)
+	MultiGet(ctx context.Context, in *MultiGetRequest, opts ...grpc.CallOption) (*MultiGetResponse, error)
+	Index(ctx context.Context, opts ...grpc.CallOption) (Index_IndexClient, error)
+	Delete(ctx context.Context, opts ...grpc.CallOption) (Index_DeleteClient, error)
+	Bulk(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Index_ExportClient, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error)
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	FieldStats(ctx context.Context, in *FieldStatsRequest, opts ...grpc.CallOption) (*FieldStatsResponse, error)
+	Similar(ctx context.Context, in *SimilarRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	PutPercolatorQuery(ctx context.Context, in *PercolatorQuery, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeletePercolatorQuery(ctx context.Context, in *PercolatorQuery, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListPercolatorQueries(ctx context.Context, in *PercolatorQuery, opts ...grpc.CallOption) (*PercolatorQueryList, error)
+	Percolate(ctx context.Context, in *PercolateRequest, opts ...grpc.CallOption) (*PercolateResponse, error)
+	SubscribePercolations(ctx context.Context, in *SubscribePercolationsRequest, opts ...grpc.CallOption) (Index_SubscribePercolationsClient, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Index_SubscribeClient, error)
+	SimulatePipeline(ctx context.Context, in *SimulatePipelineRequest, opts ...grpc.CallOption) (*SimulatePipelineResponse, error)
+	Scroll(ctx context.Context, in *ScrollRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	ClearScroll(ctx context.Context, in *ScrollRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Index_SearchStreamClient, error)
+	GetStats(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*Stats, error)
+	GetIndexMapping(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*IndexMapping, error)
+	PutIndexMapping(ctx context.Context, in *IndexMapping, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetReindexStatus(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*ReindexStatus, error)
+	CreateIndex(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteIndex(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetIndex(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*IndexInfo, error)
+	ListIndexes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*IndexList, error)
+	PutAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*empty.Empty, error)
+	SwapAlias(ctx context.Context, in *SwapAliasRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*Alias, error)
+	ListAliases(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AliasList, error)
+}
+
+type indexClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIndexClient(cc *grpc.ClientConn) IndexClient {
+	return &indexClient{cc}
+}
+
+func (c *indexClient) Join(ctx context.Context, in *raft.Node, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/Join", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Leave(ctx context.Context, in *raft.Node, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/Leave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) GetNode(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*raft.Node, error) {
+	out := new(raft.Node)
+	err := c.cc.Invoke(ctx, "/index.Index/GetNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) GetCluster(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*raft.Cluster, error) {
+	out := new(raft.Cluster)
+	err := c.cc.Invoke(ctx, "/index.Index/GetCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Snapshot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/Snapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Get(ctx context.Context, in *Document, opts ...grpc.CallOption) (*Document, error) {
+	out := new(Document)
+	err := c.cc.Invoke(ctx, "/index.Index/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) MultiGet(ctx context.Context, in *MultiGetRequest, opts ...grpc.CallOption) (*MultiGetResponse, error) {
+	out := new(MultiGetResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/MultiGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Index(ctx context.Context, opts ...grpc.CallOption) (Index_IndexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Index_serviceDesc.Streams[0], "/index.Index/Index", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexIndexClient{stream}
+	return x, nil
+}
+
+type Index_IndexClient interface {
+	Send(*Document) error
+	CloseAndRecv() (*UpdateResult, error)
+	grpc.ClientStream
+}
+
+type indexIndexClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexIndexClient) Send(m *Document) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexIndexClient) CloseAndRecv() (*UpdateResult, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UpdateResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexClient) Delete(ctx context.Context, opts ...grpc.CallOption) (Index_DeleteClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Index_serviceDesc.Streams[1], "/index.Index/Delete", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexDeleteClient{stream}
+	return x, nil
+}
+
+type Index_DeleteClient interface {
+	Send(*Document) error
+	CloseAndRecv() (*UpdateResult, error)
+	grpc.ClientStream
+}
+
+type indexDeleteClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexDeleteClient) Send(m *Document) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexDeleteClient) CloseAndRecv() (*UpdateResult, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UpdateResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexClient) Bulk(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	out := new(BulkResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Bulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Index_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Index_serviceDesc.Streams[2], "/index.Index/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Index_ExportClient interface {
+	Recv() (*Document, error)
+	grpc.ClientStream
+}
+
+type indexExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexExportClient) Recv() (*Document, error) {
+	m := new(Document)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error) {
+	out := new(ExplainResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Explain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Analyze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Suggest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) FieldStats(ctx context.Context, in *FieldStatsRequest, opts ...grpc.CallOption) (*FieldStatsResponse, error) {
+	out := new(FieldStatsResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/FieldStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Similar(ctx context.Context, in *SimilarRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Similar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) PutPercolatorQuery(ctx context.Context, in *PercolatorQuery, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/PutPercolatorQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) DeletePercolatorQuery(ctx context.Context, in *PercolatorQuery, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/DeletePercolatorQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) ListPercolatorQueries(ctx context.Context, in *PercolatorQuery, opts ...grpc.CallOption) (*PercolatorQueryList, error) {
+	out := new(PercolatorQueryList)
+	err := c.cc.Invoke(ctx, "/index.Index/ListPercolatorQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Percolate(ctx context.Context, in *PercolateRequest, opts ...grpc.CallOption) (*PercolateResponse, error) {
+	out := new(PercolateResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Percolate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) SubscribePercolations(ctx context.Context, in *SubscribePercolationsRequest, opts ...grpc.CallOption) (Index_SubscribePercolationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Index_serviceDesc.Streams[3], "/index.Index/SubscribePercolations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexSubscribePercolationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Index_SubscribePercolationsClient interface {
+	Recv() (*Percolation, error)
+	grpc.ClientStream
+}
+
+type indexSubscribePercolationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexSubscribePercolationsClient) Recv() (*Percolation, error) {
+	m := new(Percolation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Index_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Index_serviceDesc.Streams[4], "/index.Index/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Index_SubscribeClient interface {
+	Recv() (*ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type indexSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexSubscribeClient) Recv() (*ChangeEvent, error) {
+	m := new(ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexClient) SimulatePipeline(ctx context.Context, in *SimulatePipelineRequest, opts ...grpc.CallOption) (*SimulatePipelineResponse, error) {
+	out := new(SimulatePipelineResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/SimulatePipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) Scroll(ctx context.Context, in *ScrollRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Scroll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) ClearScroll(ctx context.Context, in *ScrollRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/ClearScroll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Index_SearchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Index_serviceDesc.Streams[5], "/index.Index/SearchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexSearchStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Index_SearchStreamClient interface {
+	Recv() (*DocumentMatch, error)
+	grpc.ClientStream
+}
+
+type indexSearchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexSearchStreamClient) Recv() (*DocumentMatch, error) {
+	m := new(DocumentMatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexClient) GetStats(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*Stats, error) {
+	out := new(Stats)
+	err := c.cc.Invoke(ctx, "/index.Index/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) GetIndexMapping(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*IndexMapping, error) {
+	out := new(IndexMapping)
+	err := c.cc.Invoke(ctx, "/index.Index/GetIndexMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) PutIndexMapping(ctx context.Context, in *IndexMapping, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/PutIndexMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) GetReindexStatus(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*ReindexStatus, error) {
+	out := new(ReindexStatus)
+	err := c.cc.Invoke(ctx, "/index.Index/GetReindexStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) CreateIndex(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) DeleteIndex(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/DeleteIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) GetIndex(ctx context.Context, in *IndexInfo, opts ...grpc.CallOption) (*IndexInfo, error) {
+	out := new(IndexInfo)
+	err := c.cc.Invoke(ctx, "/index.Index/GetIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) ListIndexes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*IndexList, error) {
+	out := new(IndexList)
+	err := c.cc.Invoke(ctx, "/index.Index/ListIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) PutAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/PutAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) DeleteAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/DeleteAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) SwapAlias(ctx context.Context, in *SwapAliasRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/index.Index/SwapAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) GetAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*Alias, error) {
+	out := new(Alias)
+	err := c.cc.Invoke(ctx, "/index.Index/GetAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) ListAliases(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AliasList, error) {
+	out := new(AliasList)
+	err := c.cc.Invoke(ctx, "/index.Index/ListAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IndexServer is the server API for Index service.
+type IndexServer interface {
+	Join(context.Context, *raft.Node) (*empty.Empty, error)
+	Leave(context.Context, *raft.Node) (*empty.Empty, error)
+	GetNode(context.Context, *empty.Empty) (*raft.Node, error)
+	GetCluster(context.Context, *empty.Empty) (*raft.Cluster, error)
+	Snapshot(context.Context, *empty.Empty) (*empty.Empty, error)
+	Get(context.Context, *Document) (*Document, error)
+	MultiGet(context.Context, *MultiGetRequest) (*MultiGetResponse, error)
+	Index(Index_IndexServer) error
+	Delete(Index_DeleteServer) error
+	Bulk(context.Context, *BulkRequest) (*BulkResponse, error)
+	Export(*ExportRequest, Index_ExportServer) error
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	Count(context.Context, *CountRequest) (*CountResponse, error)
+	Explain(context.Context, *ExplainRequest) (*ExplainResponse, error)
+	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
+	FieldStats(context.Context, *FieldStatsRequest) (*FieldStatsResponse, error)
+	Similar(context.Context, *SimilarRequest) (*SearchResponse, error)
+	PutPercolatorQuery(context.Context, *PercolatorQuery) (*empty.Empty, error)
+	DeletePercolatorQuery(context.Context, *PercolatorQuery) (*empty.Empty, error)
+	ListPercolatorQueries(context.Context, *PercolatorQuery) (*PercolatorQueryList, error)
+	Percolate(context.Context, *PercolateRequest) (*PercolateResponse, error)
+	SubscribePercolations(*SubscribePercolationsRequest, Index_SubscribePercolationsServer) error
+	Subscribe(*SubscribeRequest, Index_SubscribeServer) error
+	SimulatePipeline(context.Context, *SimulatePipelineRequest) (*SimulatePipelineResponse, error)
+	Scroll(context.Context, *ScrollRequest) (*SearchResponse, error)
+	ClearScroll(context.Context, *ScrollRequest) (*empty.Empty, error)
+	SearchStream(*SearchRequest, Index_SearchStreamServer) error
+	GetStats(context.Context, *IndexInfo) (*Stats, error)
+	GetIndexMapping(context.Context, *IndexInfo) (*IndexMapping, error)
+	PutIndexMapping(context.Context, *IndexMapping) (*empty.Empty, error)
+	GetReindexStatus(context.Context, *IndexInfo) (*ReindexStatus, error)
+	CreateIndex(context.Context, *IndexInfo) (*empty.Empty, error)
+	DeleteIndex(context.Context, *IndexInfo) (*empty.Empty, error)
+	GetIndex(context.Context, *IndexInfo) (*IndexInfo, error)
+	ListIndexes(context.Context, *empty.Empty) (*IndexList, error)
+	PutAlias(context.Context, *Alias) (*empty.Empty, error)
+	DeleteAlias(context.Context, *Alias) (*empty.Empty, error)
+	SwapAlias(context.Context, *SwapAliasRequest) (*empty.Empty, error)
+	GetAlias(context.Context, *Alias) (*Alias, error)
+	ListAliases(context.Context, *empty.Empty) (*AliasList, error)
+}
+
+func RegisterIndexServer(s *grpc.Server, srv IndexServer) {
+	s.RegisterService(&_Index_serviceDesc, srv)
+}
+
+func _Index_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(raft.Node)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Join(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Join",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Join(ctx, req.(*raft.Node))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Leave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(raft.Node)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Leave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Leave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Leave(ctx, req.(*raft.Node))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).GetNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/GetNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).GetNode(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).GetCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/GetCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).GetCluster(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Snapshot(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Document)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Get(ctx, req.(*Document))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_MultiGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).MultiGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/MultiGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).MultiGet(ctx, req.(*MultiGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Index_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexServer).Index(&indexIndexServer{stream})
+}
+
+type Index_IndexServer interface {
+	SendAndClose(*UpdateResult) error
+	Recv() (*Document, error)
+	grpc.ServerStream
+}
+
+type indexIndexServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexIndexServer) SendAndClose(m *UpdateResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexIndexServer) Recv() (*Document, error) {
+	m := new(Document)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Index_Delete_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexServer).Delete(&indexDeleteServer{stream})
+}
+
+type Index_DeleteServer interface {
+	SendAndClose(*UpdateResult) error
+	Recv() (*Document, error)
+	grpc.ServerStream
+}
+
+type indexDeleteServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexDeleteServer) SendAndClose(m *UpdateResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexDeleteServer) Recv() (*Document, error) {
+	m := new(Document)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Index_Bulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Bulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Bulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Bulk(ctx, req.(*BulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexServer).Export(m, &indexExportServer{stream})
+}
+
+type Index_ExportServer interface {
+	Send(*Document) error
+	grpc.ServerStream
+}
+
+type indexExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexExportServer) Send(m *Document) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Index_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Count(ctx, req.(*CountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Explain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Explain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Explain(ctx, req.(*ExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Analyze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Suggest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_FieldStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FieldStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).FieldStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/FieldStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).FieldStats(ctx, req.(*FieldStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Similar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimilarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Similar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Similar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Similar(ctx, req.(*SimilarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_PutPercolatorQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PercolatorQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).PutPercolatorQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/PutPercolatorQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).PutPercolatorQuery(ctx, req.(*PercolatorQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_DeletePercolatorQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PercolatorQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).DeletePercolatorQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/DeletePercolatorQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).DeletePercolatorQuery(ctx, req.(*PercolatorQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_ListPercolatorQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PercolatorQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).ListPercolatorQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/ListPercolatorQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).ListPercolatorQueries(ctx, req.(*PercolatorQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Percolate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PercolateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Percolate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Percolate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Percolate(ctx, req.(*PercolateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_SubscribePercolations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePercolationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexServer).SubscribePercolations(m, &indexSubscribePercolationsServer{stream})
+}
+
+type Index_SubscribePercolationsServer interface {
+	Send(*Percolation) error
+	grpc.ServerStream
+}
+
+type indexSubscribePercolationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexSubscribePercolationsServer) Send(m *Percolation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Index_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexServer).Subscribe(m, &indexSubscribeServer{stream})
+}
+
+type Index_SubscribeServer interface {
+	Send(*ChangeEvent) error
+	grpc.ServerStream
+}
+
+type indexSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexSubscribeServer) Send(m *ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Index_SimulatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).SimulatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/SimulatePipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).SimulatePipeline(ctx, req.(*SimulatePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_Scroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Scroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Scroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Scroll(ctx, req.(*ScrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *indexIndexClient) CloseAndRecv() (*UpdateResult, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
+func _Index_ClearScroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrollRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	m := new(UpdateResult)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(IndexServer).ClearScroll(ctx, in)
 	}
-	return m, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/ClearScroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).ClearScroll(ctx, req.(*ScrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *indexClient) Delete(ctx context.Context, opts ...grpc.CallOption) (Index_DeleteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Index_serviceDesc.Streams[1], "/index.Index/Delete", opts...)
-	if err != nil {
-		return nil, err
+func _Index_SearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	x := &indexDeleteClient{stream}
-	return x, nil
+	return srv.(IndexServer).SearchStream(m, &indexSearchStreamServer{stream})
 }
 
-type Index_DeleteClient interface {
-	Send(*Document) error
-	CloseAndRecv() (*UpdateResult, error)
-	grpc.ClientStream
+type Index_SearchStreamServer interface {
+	Send(*DocumentMatch) error
+	grpc.ServerStream
 }
 
-type indexDeleteClient struct {
-	grpc.ClientStream
+type indexSearchStreamServer struct {
+	grpc.ServerStream
 }
 
-func (x *indexDeleteClient) Send(m *Document) error {
-	return x.ClientStream.SendMsg(m)
+func (x *indexSearchStreamServer) Send(m *DocumentMatch) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *indexDeleteClient) CloseAndRecv() (*UpdateResult, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
+func _Index_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	m := new(UpdateResult)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(IndexServer).GetStats(ctx, in)
 	}
-	return m, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).GetStats(ctx, req.(*IndexInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *indexClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
-	out := new(SearchResponse)
-	err := c.cc.Invoke(ctx, "/index.Index/Search", in, out, opts...)
-	if err != nil {
+func _Index_GetIndexMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IndexServer).GetIndexMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/GetIndexMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).GetIndexMapping(ctx, req.(*IndexInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *indexClient) GetStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Stats, error) {
-	out := new(Stats)
-	err := c.cc.Invoke(ctx, "/index.Index/GetStats", in, out, opts...)
-	if err != nil {
+func _Index_PutIndexMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexMapping)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// IndexServer is the server API for Index service.
-type IndexServer interface {
-	Join(context.Context, *raft.Node) (*empty.Empty, error)
-	Leave(context.Context, *raft.Node) (*empty.Empty, error)
-	GetNode(context.Context, *empty.Empty) (*raft.Node, error)
-	GetCluster(context.Context, *empty.Empty) (*raft.Cluster, error)
-	Snapshot(context.Context, *empty.Empty) (*empty.Empty, error)
-	Get(context.Context, *Document) (*Document, error)
-	Index(Index_IndexServer) error
-	Delete(Index_DeleteServer) error
-	Search(context.Context, *SearchRequest) (*SearchResponse, error)
-	GetStats(context.Context, *empty.Empty) (*Stats, error)
-}
-
-func RegisterIndexServer(s *grpc.Server, srv IndexServer) {
-	s.RegisterService(&_Index_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(IndexServer).PutIndexMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/PutIndexMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).PutIndexMapping(ctx, req.(*IndexMapping))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Index_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(raft.Node)
+func _Index_GetReindexStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexServer).Join(ctx, in)
+		return srv.(IndexServer).GetReindexStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/index.Index/Join",
+		FullMethod: "/index.Index/GetReindexStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexServer).Join(ctx, req.(*raft.Node))
+		return srv.(IndexServer).GetReindexStatus(ctx, req.(*IndexInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Index_Leave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(raft.Node)
+func _Index_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexServer).Leave(ctx, in)
+		return srv.(IndexServer).CreateIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/index.Index/Leave",
+		FullMethod: "/index.Index/CreateIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexServer).Leave(ctx, req.(*raft.Node))
+		return srv.(IndexServer).CreateIndex(ctx, req.(*IndexInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Index_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Index_DeleteIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexServer).GetNode(ctx, in)
+		return srv.(IndexServer).DeleteIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/index.Index/GetNode",
+		FullMethod: "/index.Index/DeleteIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexServer).GetNode(ctx, req.(*empty.Empty))
+		return srv.(IndexServer).DeleteIndex(ctx, req.(*IndexInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Index_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Index_GetIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexServer).GetCluster(ctx, in)
+		return srv.(IndexServer).GetIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/index.Index/GetCluster",
+		FullMethod: "/index.Index/GetIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexServer).GetCluster(ctx, req.(*empty.Empty))
+		return srv.(IndexServer).GetIndex(ctx, req.(*IndexInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Index_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Index_ListIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexServer).Snapshot(ctx, in)
+		return srv.(IndexServer).ListIndexes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/index.Index/Snapshot",
+		FullMethod: "/index.Index/ListIndexes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexServer).Snapshot(ctx, req.(*empty.Empty))
+		return srv.(IndexServer).ListIndexes(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Index_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Document)
+func _Index_PutAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Alias)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexServer).Get(ctx, in)
+		return srv.(IndexServer).PutAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/index.Index/Get",
+		FullMethod: "/index.Index/PutAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexServer).Get(ctx, req.(*Document))
+		return srv.(IndexServer).PutAlias(ctx, req.(*Alias))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Index_Index_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(IndexServer).Index(&indexIndexServer{stream})
-}
-
-type Index_IndexServer interface {
-	SendAndClose(*UpdateResult) error
-	Recv() (*Document, error)
-	grpc.ServerStream
-}
-
-type indexIndexServer struct {
-	grpc.ServerStream
-}
-
-func (x *indexIndexServer) SendAndClose(m *UpdateResult) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *indexIndexServer) Recv() (*Document, error) {
-	m := new(Document)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _Index_DeleteAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Alias)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-func _Index_Delete_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(IndexServer).Delete(&indexDeleteServer{stream})
-}
-
-type Index_DeleteServer interface {
-	SendAndClose(*UpdateResult) error
-	Recv() (*Document, error)
-	grpc.ServerStream
-}
-
-type indexDeleteServer struct {
-	grpc.ServerStream
-}
-
-func (x *indexDeleteServer) SendAndClose(m *UpdateResult) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(IndexServer).DeleteAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/DeleteAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).DeleteAlias(ctx, req.(*Alias))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *indexDeleteServer) Recv() (*Document, error) {
-	m := new(Document)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _Index_SwapAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapAliasRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(IndexServer).SwapAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/SwapAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).SwapAlias(ctx, req.(*SwapAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Index_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
+func _Index_GetAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Alias)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexServer).Search(ctx, in)
+		return srv.(IndexServer).GetAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/index.Index/Search",
+		FullMethod: "/index.Index/GetAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexServer).Search(ctx, req.(*SearchRequest))
+		return srv.(IndexServer).GetAlias(ctx, req.(*Alias))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Index_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Index_ListAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexServer).GetStats(ctx, in)
+		return srv.(IndexServer).ListAliases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/index.Index/GetStats",
+		FullMethod: "/index.Index/ListAliases",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexServer).GetStats(ctx, req.(*empty.Empty))
+		return srv.(IndexServer).ListAliases(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -775,14 +7987,122 @@ var _Index_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _Index_Get_Handler,
 		},
+		{
+			MethodName: "MultiGet",
+			Handler:    _Index_MultiGet_Handler,
+		},
+		{
+			MethodName: "Bulk",
+			Handler:    _Index_Bulk_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _Index_Search_Handler,
 		},
+		{
+			MethodName: "Count",
+			Handler:    _Index_Count_Handler,
+		},
+		{
+			MethodName: "Explain",
+			Handler:    _Index_Explain_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _Index_Analyze_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _Index_Suggest_Handler,
+		},
+		{
+			MethodName: "FieldStats",
+			Handler:    _Index_FieldStats_Handler,
+		},
+		{
+			MethodName: "Similar",
+			Handler:    _Index_Similar_Handler,
+		},
+		{
+			MethodName: "PutPercolatorQuery",
+			Handler:    _Index_PutPercolatorQuery_Handler,
+		},
+		{
+			MethodName: "DeletePercolatorQuery",
+			Handler:    _Index_DeletePercolatorQuery_Handler,
+		},
+		{
+			MethodName: "ListPercolatorQueries",
+			Handler:    _Index_ListPercolatorQueries_Handler,
+		},
+		{
+			MethodName: "Percolate",
+			Handler:    _Index_Percolate_Handler,
+		},
+		{
+			MethodName: "SimulatePipeline",
+			Handler:    _Index_SimulatePipeline_Handler,
+		},
+		{
+			MethodName: "Scroll",
+			Handler:    _Index_Scroll_Handler,
+		},
+		{
+			MethodName: "ClearScroll",
+			Handler:    _Index_ClearScroll_Handler,
+		},
 		{
 			MethodName: "GetStats",
 			Handler:    _Index_GetStats_Handler,
 		},
+		{
+			MethodName: "GetIndexMapping",
+			Handler:    _Index_GetIndexMapping_Handler,
+		},
+		{
+			MethodName: "PutIndexMapping",
+			Handler:    _Index_PutIndexMapping_Handler,
+		},
+		{
+			MethodName: "GetReindexStatus",
+			Handler:    _Index_GetReindexStatus_Handler,
+		},
+		{
+			MethodName: "CreateIndex",
+			Handler:    _Index_CreateIndex_Handler,
+		},
+		{
+			MethodName: "DeleteIndex",
+			Handler:    _Index_DeleteIndex_Handler,
+		},
+		{
+			MethodName: "GetIndex",
+			Handler:    _Index_GetIndex_Handler,
+		},
+		{
+			MethodName: "ListIndexes",
+			Handler:    _Index_ListIndexes_Handler,
+		},
+		{
+			MethodName: "PutAlias",
+			Handler:    _Index_PutAlias_Handler,
+		},
+		{
+			MethodName: "DeleteAlias",
+			Handler:    _Index_DeleteAlias_Handler,
+		},
+		{
+			MethodName: "SwapAlias",
+			Handler:    _Index_SwapAlias_Handler,
+		},
+		{
+			MethodName: "GetAlias",
+			Handler:    _Index_GetAlias_Handler,
+		},
+		{
+			MethodName: "ListAliases",
+			Handler:    _Index_ListAliases_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -795,6 +8115,26 @@ var _Index_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Index_Delete_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Export",
+			Handler:       _Index_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribePercolations",
+			Handler:       _Index_SubscribePercolations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Index_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchStream",
+			Handler:       _Index_SearchStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "protobuf/index/index.proto",
 }
@@ -0,0 +1,1070 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/mosuka/blast/protobuf/index"
+)
+
+// FromBleveSearchRequest converts a bleve search request to the typed protobuf representation.
+func FromBleveSearchRequest(searchRequest *bleve.SearchRequest) (*index.SearchRequest, error) {
+	if searchRequest == nil {
+		return nil, nil
+	}
+
+	q, err := FromBleveQuery(searchRequest.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &index.SearchRequest{
+		Query:            q,
+		Size:             &wrappers.Int32Value{Value: int32(searchRequest.Size)},
+		From:             int32(searchRequest.From),
+		Fields:           searchRequest.Fields,
+		Explain:          searchRequest.Explain,
+		IncludeLocations: searchRequest.IncludeLocations,
+	}
+
+	if searchRequest.Highlight != nil {
+		req.Highlight = &index.HighlightRequest{
+			Fields: searchRequest.Highlight.Fields,
+		}
+		if searchRequest.Highlight.Style != nil {
+			req.Highlight.Style = *searchRequest.Highlight.Style
+		}
+	}
+
+	if len(searchRequest.Facets) > 0 {
+		req.Facets = make(map[string]*index.FacetRequest, len(searchRequest.Facets))
+		for name, facetRequest := range searchRequest.Facets {
+			facet, err := fromBleveFacetRequest(facetRequest)
+			if err != nil {
+				return nil, err
+			}
+			req.Facets[name] = facet
+		}
+	}
+
+	for _, s := range searchRequest.Sort {
+		sort, err := fromBleveSort(s)
+		if err != nil {
+			return nil, err
+		}
+		req.Sort = append(req.Sort, sort)
+	}
+
+	return req, nil
+}
+
+// ToBleveSearchRequest converts a typed protobuf search request to a bleve search request.
+func ToBleveSearchRequest(req *index.SearchRequest) (*bleve.SearchRequest, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	if req.Query == nil {
+		return nil, errors.New("query is required")
+	}
+
+	q, err := ToBleveQuery(req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	size := 10
+	if req.Size != nil {
+		size = int(req.Size.Value)
+	}
+
+	searchRequest := bleve.NewSearchRequestOptions(q, size, int(req.From), req.Explain)
+	searchRequest.Fields = req.Fields
+	searchRequest.IncludeLocations = req.IncludeLocations
+
+	if req.Highlight != nil {
+		searchRequest.Highlight = &bleve.HighlightRequest{
+			Fields: req.Highlight.Fields,
+		}
+		if req.Highlight.Style != "" {
+			style := req.Highlight.Style
+			searchRequest.Highlight.Style = &style
+		}
+	}
+
+	if len(req.Facets) > 0 {
+		searchRequest.Facets = make(bleve.FacetsRequest, len(req.Facets))
+		for name, facet := range req.Facets {
+			searchRequest.Facets[name] = toBleveFacetRequest(facet)
+		}
+	}
+
+	if len(req.Sort) > 0 {
+		searchRequest.Sort = make(search.SortOrder, 0, len(req.Sort))
+		for _, sort := range req.Sort {
+			s, err := toBleveSort(sort)
+			if err != nil {
+				return nil, err
+			}
+			searchRequest.Sort = append(searchRequest.Sort, s)
+		}
+	}
+
+	return searchRequest, nil
+}
+
+// FromBleveSearchResult converts a bleve search result to the typed protobuf representation.
+func FromBleveSearchResult(searchResult *bleve.SearchResult) (*index.SearchResponse, error) {
+	if searchResult == nil {
+		return nil, nil
+	}
+
+	resp := &index.SearchResponse{
+		TotalHits: searchResult.Total,
+		MaxScore:  searchResult.MaxScore,
+		Took:      ptypes.DurationProto(searchResult.Took),
+	}
+
+	if searchResult.Status != nil {
+		resp.Status = &index.SearchStatus{
+			Total:      int32(searchResult.Status.Total),
+			Failed:     int32(searchResult.Status.Failed),
+			Successful: int32(searchResult.Status.Successful),
+		}
+		if len(searchResult.Status.Errors) > 0 {
+			resp.Status.Errors = make(map[string]string, len(searchResult.Status.Errors))
+			for name, err := range searchResult.Status.Errors {
+				resp.Status.Errors[name] = err.Error()
+			}
+		}
+	}
+
+	if searchResult.Request != nil {
+		req, err := FromBleveSearchRequest(searchResult.Request)
+		if err != nil {
+			return nil, err
+		}
+		resp.Request = req
+	}
+
+	for _, hit := range searchResult.Hits {
+		documentMatch, err := FromBleveDocumentMatch(hit)
+		if err != nil {
+			return nil, err
+		}
+		resp.Hits = append(resp.Hits, documentMatch)
+	}
+
+	if len(searchResult.Facets) > 0 {
+		resp.Facets = make(map[string]*index.FacetResult, len(searchResult.Facets))
+		for name, facetResult := range searchResult.Facets {
+			resp.Facets[name] = fromBleveFacetResult(facetResult)
+		}
+	}
+
+	return resp, nil
+}
+
+// ToBleveSearchResult converts a typed protobuf search response to a bleve search result.
+func ToBleveSearchResult(resp *index.SearchResponse) (*bleve.SearchResult, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	searchResult := &bleve.SearchResult{
+		Total:    resp.TotalHits,
+		MaxScore: resp.MaxScore,
+		Hits:     make(search.DocumentMatchCollection, 0, len(resp.Hits)),
+	}
+
+	if resp.Took != nil {
+		took, err := ptypes.Duration(resp.Took)
+		if err != nil {
+			return nil, err
+		}
+		searchResult.Took = took
+	}
+
+	if resp.Status != nil {
+		searchResult.Status = &bleve.SearchStatus{
+			Total:      int(resp.Status.Total),
+			Failed:     int(resp.Status.Failed),
+			Successful: int(resp.Status.Successful),
+		}
+		if len(resp.Status.Errors) > 0 {
+			searchResult.Status.Errors = make(bleve.IndexErrMap, len(resp.Status.Errors))
+			for name, msg := range resp.Status.Errors {
+				searchResult.Status.Errors[name] = errors.New(msg)
+			}
+		}
+	}
+
+	if resp.Request != nil {
+		searchRequest, err := ToBleveSearchRequest(resp.Request)
+		if err != nil {
+			return nil, err
+		}
+		searchResult.Request = searchRequest
+	}
+
+	for _, documentMatch := range resp.Hits {
+		hit, err := ToBleveDocumentMatch(documentMatch)
+		if err != nil {
+			return nil, err
+		}
+		searchResult.Hits = append(searchResult.Hits, hit)
+	}
+
+	if len(resp.Facets) > 0 {
+		searchResult.Facets = make(search.FacetResults, len(resp.Facets))
+		for name, facetResult := range resp.Facets {
+			searchResult.Facets[name] = toBleveFacetResult(facetResult)
+		}
+	}
+
+	return searchResult, nil
+}
+
+// FromBleveQuery converts a bleve query to the typed protobuf representation.
+func FromBleveQuery(q query.Query) (*index.Query, error) {
+	if q == nil {
+		return nil, nil
+	}
+
+	switch q := q.(type) {
+	case *query.MatchAllQuery:
+		return &index.Query{Query: &index.Query_MatchAll{MatchAll: &index.MatchAllQuery{
+			Boost: fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.MatchNoneQuery:
+		return &index.Query{Query: &index.Query_MatchNone{MatchNone: &index.MatchNoneQuery{
+			Boost: fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.MatchQuery:
+		return &index.Query{Query: &index.Query_Match{Match: &index.MatchQuery{
+			Match:        q.Match,
+			Field:        q.FieldVal,
+			Analyzer:     q.Analyzer,
+			Boost:        fromBleveBoost(q.BoostVal),
+			PrefixLength: int32(q.Prefix),
+			Fuzziness:    int32(q.Fuzziness),
+			Operator:     index.MatchQuery_Operator(q.Operator),
+		}}}, nil
+	case *query.MatchPhraseQuery:
+		return &index.Query{Query: &index.Query_MatchPhrase{MatchPhrase: &index.MatchPhraseQuery{
+			MatchPhrase: q.MatchPhrase,
+			Field:       q.FieldVal,
+			Analyzer:    q.Analyzer,
+			Boost:       fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.TermQuery:
+		return &index.Query{Query: &index.Query_Term{Term: &index.TermQuery{
+			Term:  q.Term,
+			Field: q.FieldVal,
+			Boost: fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.PhraseQuery:
+		return &index.Query{Query: &index.Query_Phrase{Phrase: &index.PhraseQuery{
+			Terms: q.Terms,
+			Field: q.Field,
+			Boost: fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.MultiPhraseQuery:
+		multiPhrase := &index.MultiPhraseQuery{
+			Field: q.Field,
+			Boost: fromBleveBoost(q.BoostVal),
+		}
+		for _, terms := range q.Terms {
+			multiPhrase.Terms = append(multiPhrase.Terms, &index.MultiPhraseQuery_Terms{Terms: terms})
+		}
+		return &index.Query{Query: &index.Query_MultiPhrase{MultiPhrase: multiPhrase}}, nil
+	case *query.PrefixQuery:
+		return &index.Query{Query: &index.Query_Prefix{Prefix: &index.PrefixQuery{
+			Prefix: q.Prefix,
+			Field:  q.FieldVal,
+			Boost:  fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.WildcardQuery:
+		return &index.Query{Query: &index.Query_Wildcard{Wildcard: &index.WildcardQuery{
+			Wildcard: q.Wildcard,
+			Field:    q.FieldVal,
+			Boost:    fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.RegexpQuery:
+		return &index.Query{Query: &index.Query_Regexp{Regexp: &index.RegexpQuery{
+			Regexp: q.Regexp,
+			Field:  q.FieldVal,
+			Boost:  fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.FuzzyQuery:
+		return &index.Query{Query: &index.Query_Fuzzy{Fuzzy: &index.FuzzyQuery{
+			Term:         q.Term,
+			PrefixLength: int32(q.Prefix),
+			Fuzziness:    int32(q.Fuzziness),
+			Field:        q.FieldVal,
+			Boost:        fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.NumericRangeQuery:
+		return &index.Query{Query: &index.Query_NumericRange{NumericRange: &index.NumericRangeQuery{
+			Min:          fromFloat64(q.Min),
+			Max:          fromFloat64(q.Max),
+			InclusiveMin: fromBool(q.InclusiveMin),
+			InclusiveMax: fromBool(q.InclusiveMax),
+			Field:        q.FieldVal,
+			Boost:        fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.DateRangeQuery:
+		dateRange := &index.DateRangeQuery{
+			InclusiveStart: fromBool(q.InclusiveStart),
+			InclusiveEnd:   fromBool(q.InclusiveEnd),
+			Field:          q.FieldVal,
+			Boost:          fromBleveBoost(q.BoostVal),
+		}
+		if !q.Start.IsZero() {
+			start, err := ptypes.TimestampProto(q.Start.Time)
+			if err != nil {
+				return nil, err
+			}
+			dateRange.Start = start
+		}
+		if !q.End.IsZero() {
+			end, err := ptypes.TimestampProto(q.End.Time)
+			if err != nil {
+				return nil, err
+			}
+			dateRange.End = end
+		}
+		return &index.Query{Query: &index.Query_DateRange{DateRange: dateRange}}, nil
+	case *query.TermRangeQuery:
+		return &index.Query{Query: &index.Query_TermRange{TermRange: &index.TermRangeQuery{
+			Min:          q.Min,
+			Max:          q.Max,
+			InclusiveMin: fromBool(q.InclusiveMin),
+			InclusiveMax: fromBool(q.InclusiveMax),
+			Field:        q.FieldVal,
+			Boost:        fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.QueryStringQuery:
+		return &index.Query{Query: &index.Query_QueryString{QueryString: &index.QueryStringQuery{
+			Query: q.Query,
+			Boost: fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.BooleanQuery:
+		boolean := &index.BooleanQuery{
+			Boost: fromBleveBoost(q.BoostVal),
+		}
+		var err error
+		switch must := q.Must.(type) {
+		case nil:
+		case *query.ConjunctionQuery:
+			boolean.Must, err = fromBleveQueries(must.Conjuncts)
+		default:
+			boolean.Must, err = fromBleveQueries([]query.Query{must})
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch should := q.Should.(type) {
+		case nil:
+		case *query.DisjunctionQuery:
+			boolean.Should, err = fromBleveQueries(should.Disjuncts)
+			boolean.MinShould = should.Min
+		default:
+			boolean.Should, err = fromBleveQueries([]query.Query{should})
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch mustNot := q.MustNot.(type) {
+		case nil:
+		case *query.DisjunctionQuery:
+			boolean.MustNot, err = fromBleveQueries(mustNot.Disjuncts)
+		default:
+			boolean.MustNot, err = fromBleveQueries([]query.Query{mustNot})
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &index.Query{Query: &index.Query_Boolean{Boolean: boolean}}, nil
+	case *query.ConjunctionQuery:
+		conjuncts, err := fromBleveQueries(q.Conjuncts)
+		if err != nil {
+			return nil, err
+		}
+		return &index.Query{Query: &index.Query_Conjunction{Conjunction: &index.ConjunctionQuery{
+			Conjuncts: conjuncts,
+			Boost:     fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.DisjunctionQuery:
+		disjuncts, err := fromBleveQueries(q.Disjuncts)
+		if err != nil {
+			return nil, err
+		}
+		return &index.Query{Query: &index.Query_Disjunction{Disjunction: &index.DisjunctionQuery{
+			Disjuncts: disjuncts,
+			Min:       q.Min,
+			Boost:     fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.DocIDQuery:
+		return &index.Query{Query: &index.Query_DocId{DocId: &index.DocIDQuery{
+			Ids:   q.IDs,
+			Boost: fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.BoolFieldQuery:
+		return &index.Query{Query: &index.Query_BoolField{BoolField: &index.BoolFieldQuery{
+			Value: q.Bool,
+			Field: q.FieldVal,
+			Boost: fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.GeoDistanceQuery:
+		return &index.Query{Query: &index.Query_GeoDistance{GeoDistance: &index.GeoDistanceQuery{
+			Location: fromBleveGeoPoint(q.Location),
+			Distance: q.Distance,
+			Field:    q.FieldVal,
+			Boost:    fromBleveBoost(q.BoostVal),
+		}}}, nil
+	case *query.GeoBoundingBoxQuery:
+		return &index.Query{Query: &index.Query_GeoBoundingBox{GeoBoundingBox: &index.GeoBoundingBoxQuery{
+			TopLeft:     fromBleveGeoPoint(q.TopLeft),
+			BottomRight: fromBleveGeoPoint(q.BottomRight),
+			Field:       q.FieldVal,
+			Boost:       fromBleveBoost(q.BoostVal),
+		}}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported query type: %T", q)
+	}
+}
+
+// ToBleveQuery converts a typed protobuf query to a bleve query.
+func ToBleveQuery(q *index.Query) (query.Query, error) {
+	if q == nil {
+		return nil, errors.New("query is required")
+	}
+
+	switch q := q.Query.(type) {
+	case *index.Query_MatchAll:
+		return &query.MatchAllQuery{
+			BoostVal: toBleveBoost(q.MatchAll.Boost),
+		}, nil
+	case *index.Query_MatchNone:
+		return &query.MatchNoneQuery{
+			BoostVal: toBleveBoost(q.MatchNone.Boost),
+		}, nil
+	case *index.Query_Match:
+		return &query.MatchQuery{
+			Match:     q.Match.Match,
+			FieldVal:  q.Match.Field,
+			Analyzer:  q.Match.Analyzer,
+			BoostVal:  toBleveBoost(q.Match.Boost),
+			Prefix:    int(q.Match.PrefixLength),
+			Fuzziness: int(q.Match.Fuzziness),
+			Operator:  query.MatchQueryOperator(q.Match.Operator),
+		}, nil
+	case *index.Query_MatchPhrase:
+		return &query.MatchPhraseQuery{
+			MatchPhrase: q.MatchPhrase.MatchPhrase,
+			FieldVal:    q.MatchPhrase.Field,
+			Analyzer:    q.MatchPhrase.Analyzer,
+			BoostVal:    toBleveBoost(q.MatchPhrase.Boost),
+		}, nil
+	case *index.Query_Term:
+		return &query.TermQuery{
+			Term:     q.Term.Term,
+			FieldVal: q.Term.Field,
+			BoostVal: toBleveBoost(q.Term.Boost),
+		}, nil
+	case *index.Query_Phrase:
+		return &query.PhraseQuery{
+			Terms:    q.Phrase.Terms,
+			Field:    q.Phrase.Field,
+			BoostVal: toBleveBoost(q.Phrase.Boost),
+		}, nil
+	case *index.Query_MultiPhrase:
+		multiPhrase := &query.MultiPhraseQuery{
+			Field:    q.MultiPhrase.Field,
+			BoostVal: toBleveBoost(q.MultiPhrase.Boost),
+		}
+		for _, terms := range q.MultiPhrase.Terms {
+			multiPhrase.Terms = append(multiPhrase.Terms, terms.Terms)
+		}
+		return multiPhrase, nil
+	case *index.Query_Prefix:
+		return &query.PrefixQuery{
+			Prefix:   q.Prefix.Prefix,
+			FieldVal: q.Prefix.Field,
+			BoostVal: toBleveBoost(q.Prefix.Boost),
+		}, nil
+	case *index.Query_Wildcard:
+		return &query.WildcardQuery{
+			Wildcard: q.Wildcard.Wildcard,
+			FieldVal: q.Wildcard.Field,
+			BoostVal: toBleveBoost(q.Wildcard.Boost),
+		}, nil
+	case *index.Query_Regexp:
+		return &query.RegexpQuery{
+			Regexp:   q.Regexp.Regexp,
+			FieldVal: q.Regexp.Field,
+			BoostVal: toBleveBoost(q.Regexp.Boost),
+		}, nil
+	case *index.Query_Fuzzy:
+		return &query.FuzzyQuery{
+			Term:      q.Fuzzy.Term,
+			Prefix:    int(q.Fuzzy.PrefixLength),
+			Fuzziness: int(q.Fuzzy.Fuzziness),
+			FieldVal:  q.Fuzzy.Field,
+			BoostVal:  toBleveBoost(q.Fuzzy.Boost),
+		}, nil
+	case *index.Query_NumericRange:
+		return &query.NumericRangeQuery{
+			Min:          toFloat64(q.NumericRange.Min),
+			Max:          toFloat64(q.NumericRange.Max),
+			InclusiveMin: toBool(q.NumericRange.InclusiveMin),
+			InclusiveMax: toBool(q.NumericRange.InclusiveMax),
+			FieldVal:     q.NumericRange.Field,
+			BoostVal:     toBleveBoost(q.NumericRange.Boost),
+		}, nil
+	case *index.Query_DateRange:
+		dateRange := &query.DateRangeQuery{
+			InclusiveStart: toBool(q.DateRange.InclusiveStart),
+			InclusiveEnd:   toBool(q.DateRange.InclusiveEnd),
+			FieldVal:       q.DateRange.Field,
+			BoostVal:       toBleveBoost(q.DateRange.Boost),
+		}
+		if q.DateRange.Start != nil {
+			start, err := ptypes.Timestamp(q.DateRange.Start)
+			if err != nil {
+				return nil, err
+			}
+			dateRange.Start = query.BleveQueryTime{Time: start}
+		}
+		if q.DateRange.End != nil {
+			end, err := ptypes.Timestamp(q.DateRange.End)
+			if err != nil {
+				return nil, err
+			}
+			dateRange.End = query.BleveQueryTime{Time: end}
+		}
+		return dateRange, nil
+	case *index.Query_TermRange:
+		return &query.TermRangeQuery{
+			Min:          q.TermRange.Min,
+			Max:          q.TermRange.Max,
+			InclusiveMin: toBool(q.TermRange.InclusiveMin),
+			InclusiveMax: toBool(q.TermRange.InclusiveMax),
+			FieldVal:     q.TermRange.Field,
+			BoostVal:     toBleveBoost(q.TermRange.Boost),
+		}, nil
+	case *index.Query_QueryString:
+		return &query.QueryStringQuery{
+			Query:    q.QueryString.Query,
+			BoostVal: toBleveBoost(q.QueryString.Boost),
+		}, nil
+	case *index.Query_Boolean:
+		must, err := toBleveQueries(q.Boolean.Must)
+		if err != nil {
+			return nil, err
+		}
+		should, err := toBleveQueries(q.Boolean.Should)
+		if err != nil {
+			return nil, err
+		}
+		mustNot, err := toBleveQueries(q.Boolean.MustNot)
+		if err != nil {
+			return nil, err
+		}
+		boolean := query.NewBooleanQuery(must, should, mustNot)
+		if len(should) > 0 {
+			boolean.SetMinShould(q.Boolean.MinShould)
+		}
+		boolean.BoostVal = toBleveBoost(q.Boolean.Boost)
+		return boolean, nil
+	case *index.Query_Conjunction:
+		conjuncts, err := toBleveQueries(q.Conjunction.Conjuncts)
+		if err != nil {
+			return nil, err
+		}
+		return &query.ConjunctionQuery{
+			Conjuncts: conjuncts,
+			BoostVal:  toBleveBoost(q.Conjunction.Boost),
+		}, nil
+	case *index.Query_Disjunction:
+		disjuncts, err := toBleveQueries(q.Disjunction.Disjuncts)
+		if err != nil {
+			return nil, err
+		}
+		return &query.DisjunctionQuery{
+			Disjuncts: disjuncts,
+			Min:       q.Disjunction.Min,
+			BoostVal:  toBleveBoost(q.Disjunction.Boost),
+		}, nil
+	case *index.Query_DocId:
+		return &query.DocIDQuery{
+			IDs:      q.DocId.Ids,
+			BoostVal: toBleveBoost(q.DocId.Boost),
+		}, nil
+	case *index.Query_BoolField:
+		return &query.BoolFieldQuery{
+			Bool:     q.BoolField.Value,
+			FieldVal: q.BoolField.Field,
+			BoostVal: toBleveBoost(q.BoolField.Boost),
+		}, nil
+	case *index.Query_GeoDistance:
+		return &query.GeoDistanceQuery{
+			Location: toBleveGeoPoint(q.GeoDistance.Location),
+			Distance: q.GeoDistance.Distance,
+			FieldVal: q.GeoDistance.Field,
+			BoostVal: toBleveBoost(q.GeoDistance.Boost),
+		}, nil
+	case *index.Query_GeoBoundingBox:
+		return &query.GeoBoundingBoxQuery{
+			TopLeft:     toBleveGeoPoint(q.GeoBoundingBox.TopLeft),
+			BottomRight: toBleveGeoPoint(q.GeoBoundingBox.BottomRight),
+			FieldVal:    q.GeoBoundingBox.Field,
+			BoostVal:    toBleveBoost(q.GeoBoundingBox.Boost),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported query type: %T", q)
+	}
+}
+
+func fromBleveQueries(queries []query.Query) ([]*index.Query, error) {
+	rv := make([]*index.Query, 0, len(queries))
+	for _, q := range queries {
+		converted, err := FromBleveQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, converted)
+	}
+	return rv, nil
+}
+
+func toBleveQueries(queries []*index.Query) ([]query.Query, error) {
+	rv := make([]query.Query, 0, len(queries))
+	for _, q := range queries {
+		converted, err := ToBleveQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, converted)
+	}
+	return rv, nil
+}
+
+func fromBleveSort(s search.SearchSort) (*index.SortField, error) {
+	switch s := s.(type) {
+	case *search.SortScore:
+		return &index.SortField{
+			By:   &index.SortField_Score_{Score: &index.SortField_Score{}},
+			Desc: s.Desc,
+		}, nil
+	case *search.SortDocID:
+		return &index.SortField{
+			By:   &index.SortField_Id{Id: &index.SortField_ID{}},
+			Desc: s.Desc,
+		}, nil
+	case *search.SortField:
+		return &index.SortField{
+			By: &index.SortField_Field_{Field: &index.SortField_Field{
+				Field:   s.Field,
+				Type:    index.SortField_Type(s.Type),
+				Mode:    index.SortField_Mode(s.Mode),
+				Missing: index.SortField_Missing(s.Missing),
+			}},
+			Desc: s.Desc,
+		}, nil
+	case *search.SortGeoDistance:
+		return &index.SortField{
+			By: &index.SortField_GeoDistance_{GeoDistance: &index.SortField_GeoDistance{
+				Field:    s.Field,
+				Location: &index.GeoPoint{Lon: s.Lon, Lat: s.Lat},
+				Unit:     s.Unit,
+			}},
+			Desc: s.Desc,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported sort type: %T", s)
+	}
+}
+
+func toBleveSort(s *index.SortField) (search.SearchSort, error) {
+	switch by := s.By.(type) {
+	case *index.SortField_Score_:
+		return &search.SortScore{Desc: s.Desc}, nil
+	case *index.SortField_Id:
+		return &search.SortDocID{Desc: s.Desc}, nil
+	case *index.SortField_Field_:
+		return &search.SortField{
+			Field:   by.Field.Field,
+			Desc:    s.Desc,
+			Type:    search.SortFieldType(by.Field.Type),
+			Mode:    search.SortFieldMode(by.Field.Mode),
+			Missing: search.SortFieldMissing(by.Field.Missing),
+		}, nil
+	case *index.SortField_GeoDistance_:
+		location := by.GeoDistance.Location
+		if location == nil {
+			location = &index.GeoPoint{}
+		}
+		return search.NewSortGeoDistance(by.GeoDistance.Field, by.GeoDistance.Unit, location.Lon, location.Lat, s.Desc)
+	default:
+		return nil, fmt.Errorf("unsupported sort type: %T", by)
+	}
+}
+
+func fromBleveFacetRequest(facetRequest *bleve.FacetRequest) (*index.FacetRequest, error) {
+	facet := &index.FacetRequest{
+		Field: facetRequest.Field,
+		Size:  int32(facetRequest.Size),
+	}
+
+	for _, numericRange := range facetRequest.NumericRanges {
+		facet.NumericRanges = append(facet.NumericRanges, &index.FacetRequest_NumericRange{
+			Name: numericRange.Name,
+			Min:  fromFloat64(numericRange.Min),
+			Max:  fromFloat64(numericRange.Max),
+		})
+	}
+
+	for _, dateTimeRange := range facetRequest.DateTimeRanges {
+		// date time ranges keep their bounds unexported when given as strings,
+		// so they are read back through their JSON representation.
+		value, err := dateTimeRange.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		var dateRange struct {
+			Name  string  `json:"name"`
+			Start *string `json:"start"`
+			End   *string `json:"end"`
+		}
+		err = json.Unmarshal(value, &dateRange)
+		if err != nil {
+			return nil, err
+		}
+		facet.DateRanges = append(facet.DateRanges, &index.FacetRequest_DateRange{
+			Name:  dateRange.Name,
+			Start: fromDateTimeString(dateRange.Start),
+			End:   fromDateTimeString(dateRange.End),
+		})
+	}
+
+	return facet, nil
+}
+
+func toBleveFacetRequest(facet *index.FacetRequest) *bleve.FacetRequest {
+	facetRequest := bleve.NewFacetRequest(facet.Field, int(facet.Size))
+
+	for _, numericRange := range facet.NumericRanges {
+		facetRequest.AddNumericRange(numericRange.Name, toFloat64(numericRange.Min), toFloat64(numericRange.Max))
+	}
+
+	for _, dateRange := range facet.DateRanges {
+		facetRequest.AddDateTimeRangeString(dateRange.Name, toString(dateRange.Start), toString(dateRange.End))
+	}
+
+	return facetRequest
+}
+
+func fromBleveFacetResult(facetResult *search.FacetResult) *index.FacetResult {
+	facet := &index.FacetResult{
+		Field:   facetResult.Field,
+		Total:   int32(facetResult.Total),
+		Missing: int32(facetResult.Missing),
+		Other:   int32(facetResult.Other),
+	}
+
+	for _, term := range facetResult.Terms {
+		facet.Terms = append(facet.Terms, &index.FacetResult_TermFacet{
+			Term:  term.Term,
+			Count: int32(term.Count),
+		})
+	}
+
+	for _, numericRange := range facetResult.NumericRanges {
+		facet.NumericRanges = append(facet.NumericRanges, &index.FacetResult_NumericRangeFacet{
+			Name:  numericRange.Name,
+			Min:   fromFloat64(numericRange.Min),
+			Max:   fromFloat64(numericRange.Max),
+			Count: int32(numericRange.Count),
+		})
+	}
+
+	for _, dateRange := range facetResult.DateRanges {
+		facet.DateRanges = append(facet.DateRanges, &index.FacetResult_DateRangeFacet{
+			Name:  dateRange.Name,
+			Start: fromString(dateRange.Start),
+			End:   fromString(dateRange.End),
+			Count: int32(dateRange.Count),
+		})
+	}
+
+	return facet
+}
+
+func toBleveFacetResult(facet *index.FacetResult) *search.FacetResult {
+	facetResult := &search.FacetResult{
+		Field:   facet.Field,
+		Total:   int(facet.Total),
+		Missing: int(facet.Missing),
+		Other:   int(facet.Other),
+	}
+
+	for _, term := range facet.Terms {
+		facetResult.Terms = append(facetResult.Terms, &search.TermFacet{
+			Term:  term.Term,
+			Count: int(term.Count),
+		})
+	}
+
+	for _, numericRange := range facet.NumericRanges {
+		facetResult.NumericRanges = append(facetResult.NumericRanges, &search.NumericRangeFacet{
+			Name:  numericRange.Name,
+			Min:   toFloat64(numericRange.Min),
+			Max:   toFloat64(numericRange.Max),
+			Count: int(numericRange.Count),
+		})
+	}
+
+	for _, dateRange := range facet.DateRanges {
+		facetResult.DateRanges = append(facetResult.DateRanges, &search.DateRangeFacet{
+			Name:  dateRange.Name,
+			Start: toString(dateRange.Start),
+			End:   toString(dateRange.End),
+			Count: int(dateRange.Count),
+		})
+	}
+
+	return facetResult
+}
+
+// FromBleveDocumentMatch converts a bleve search hit to the typed protobuf representation.
+func FromBleveDocumentMatch(hit *search.DocumentMatch) (*index.DocumentMatch, error) {
+	documentMatch := &index.DocumentMatch{
+		Index:       hit.Index,
+		Id:          hit.ID,
+		Score:       hit.Score,
+		Explanation: FromBleveExplanation(hit.Expl),
+		Sort:        hit.Sort,
+	}
+
+	if len(hit.Locations) > 0 {
+		documentMatch.Locations = make(map[string]*index.DocumentMatch_TermLocations, len(hit.Locations))
+		for field, termLocations := range hit.Locations {
+			terms := make(map[string]*index.DocumentMatch_Locations, len(termLocations))
+			for term, locations := range termLocations {
+				l := &index.DocumentMatch_Locations{}
+				for _, location := range locations {
+					l.Locations = append(l.Locations, &index.DocumentMatch_Location{
+						Pos:            location.Pos,
+						Start:          location.Start,
+						End:            location.End,
+						ArrayPositions: location.ArrayPositions,
+					})
+				}
+				terms[term] = l
+			}
+			documentMatch.Locations[field] = &index.DocumentMatch_TermLocations{Terms: terms}
+		}
+	}
+
+	if len(hit.Fragments) > 0 {
+		documentMatch.Fragments = make(map[string]*index.DocumentMatch_Fragments, len(hit.Fragments))
+		for field, fragments := range hit.Fragments {
+			documentMatch.Fragments[field] = &index.DocumentMatch_Fragments{Fragments: fragments}
+		}
+	}
+
+	if len(hit.Fields) > 0 {
+		fields, err := ToStruct(hit.Fields)
+		if err != nil {
+			return nil, err
+		}
+		documentMatch.Fields = fields
+	}
+
+	return documentMatch, nil
+}
+
+// ToBleveDocumentMatch converts a typed protobuf search hit back to a bleve search hit.
+func ToBleveDocumentMatch(documentMatch *index.DocumentMatch) (*search.DocumentMatch, error) {
+	hit := &search.DocumentMatch{
+		Index: documentMatch.Index,
+		ID:    documentMatch.Id,
+		Score: documentMatch.Score,
+		Expl:  ToBleveExplanation(documentMatch.Explanation),
+		Sort:  documentMatch.Sort,
+	}
+
+	if len(documentMatch.Locations) > 0 {
+		hit.Locations = make(search.FieldTermLocationMap, len(documentMatch.Locations))
+		for field, termLocations := range documentMatch.Locations {
+			terms := make(search.TermLocationMap, len(termLocations.Terms))
+			for term, locations := range termLocations.Terms {
+				for _, location := range locations.Locations {
+					terms.AddLocation(term, &search.Location{
+						Pos:            location.Pos,
+						Start:          location.Start,
+						End:            location.End,
+						ArrayPositions: location.ArrayPositions,
+					})
+				}
+			}
+			hit.Locations[field] = terms
+		}
+	}
+
+	if len(documentMatch.Fragments) > 0 {
+		hit.Fragments = make(search.FieldFragmentMap, len(documentMatch.Fragments))
+		for field, fragments := range documentMatch.Fragments {
+			hit.Fragments[field] = fragments.Fragments
+		}
+	}
+
+	if documentMatch.Fields != nil {
+		fields, err := FromStruct(documentMatch.Fields)
+		if err != nil {
+			return nil, err
+		}
+		hit.Fields = fields
+	}
+
+	return hit, nil
+}
+
+// FromBleveExplanation converts a bleve score explanation to the typed protobuf representation.
+func FromBleveExplanation(expl *search.Explanation) *index.Explanation {
+	if expl == nil {
+		return nil
+	}
+
+	explanation := &index.Explanation{
+		Value:   expl.Value,
+		Message: expl.Message,
+	}
+	for _, child := range expl.Children {
+		explanation.Children = append(explanation.Children, FromBleveExplanation(child))
+	}
+
+	return explanation
+}
+
+// ToBleveExplanation converts a typed protobuf score explanation back to a bleve score explanation.
+func ToBleveExplanation(explanation *index.Explanation) *search.Explanation {
+	if explanation == nil {
+		return nil
+	}
+
+	expl := &search.Explanation{
+		Value:   explanation.Value,
+		Message: explanation.Message,
+	}
+	for _, child := range explanation.Children {
+		expl.Children = append(expl.Children, ToBleveExplanation(child))
+	}
+
+	return expl
+}
+
+func fromBleveGeoPoint(point []float64) *index.GeoPoint {
+	if len(point) < 2 {
+		return nil
+	}
+	return &index.GeoPoint{Lon: point[0], Lat: point[1]}
+}
+
+func toBleveGeoPoint(point *index.GeoPoint) []float64 {
+	if point == nil {
+		return nil
+	}
+	return []float64{point.Lon, point.Lat}
+}
+
+func fromBleveBoost(boost *query.Boost) *wrappers.DoubleValue {
+	if boost == nil {
+		return nil
+	}
+	return &wrappers.DoubleValue{Value: float64(*boost)}
+}
+
+func toBleveBoost(boost *wrappers.DoubleValue) *query.Boost {
+	if boost == nil {
+		return nil
+	}
+	b := query.Boost(boost.Value)
+	return &b
+}
+
+func fromFloat64(value *float64) *wrappers.DoubleValue {
+	if value == nil {
+		return nil
+	}
+	return &wrappers.DoubleValue{Value: *value}
+}
+
+func toFloat64(value *wrappers.DoubleValue) *float64 {
+	if value == nil {
+		return nil
+	}
+	v := value.Value
+	return &v
+}
+
+func fromBool(value *bool) *wrappers.BoolValue {
+	if value == nil {
+		return nil
+	}
+	return &wrappers.BoolValue{Value: *value}
+}
+
+func toBool(value *wrappers.BoolValue) *bool {
+	if value == nil {
+		return nil
+	}
+	v := value.Value
+	return &v
+}
+
+func fromString(value *string) *wrappers.StringValue {
+	if value == nil {
+		return nil
+	}
+	return &wrappers.StringValue{Value: *value}
+}
+
+func toString(value *wrappers.StringValue) *string {
+	if value == nil {
+		return nil
+	}
+	v := value.Value
+	return &v
+}
+
+// fromDateTimeString drops the zero time that unset date time range bounds are serialized as.
+func fromDateTimeString(value *string) *wrappers.StringValue {
+	if value == nil || *value == (time.Time{}).Format(time.RFC3339Nano) {
+		return nil
+	}
+	return fromString(value)
+}
@@ -0,0 +1,130 @@
+package protobuf
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search"
+	"github.com/mosuka/blast/protobuf/index"
+)
+
+func TestSearchRequestConversion(t *testing.T) {
+	requests := []string{
+		`{"query":{"query":"blast"},"size":20,"from":10}`,
+		`{"query":{"match":"blast","field":"title","fuzziness":1,"operator":"and","boost":2.0}}`,
+		`{"query":{"must":{"conjuncts":[{"term":"blast","field":"title"},{"min":1,"max":10,"inclusive_max":false,"field":"count"}]},"should":{"disjuncts":[{"prefix":"bl","field":"title"},{"wildcard":"bl*t","field":"title"}],"min":1},"must_not":{"disjuncts":[{"match_phrase":"full text","field":"text"}]}}}`,
+		`{"query":{"start":"2019-01-01T00:00:00Z","end":"2019-12-31T00:00:00Z","field":"timestamp"},"sort":["-_score","_id",{"by":"field","field":"title","desc":true,"type":"string","mode":"max","missing":"first"}]}`,
+		`{"query":{"ids":["1","2"]},"fields":["*"],"highlight":{"style":"html","fields":["title"]},"explain":true,"includeLocations":true}`,
+		`{"query":{"location":[-122.107799,37.399285],"distance":"100mi","field":"geo"},"facets":{"count":{"size":5,"field":"count","numeric_ranges":[{"name":"low","max":10},{"name":"high","min":10}]},"timestamp":{"size":5,"field":"timestamp","date_ranges":[{"name":"old","end":"2019-01-01T00:00:00Z"}]}}}`,
+	}
+
+	for _, r := range requests {
+		expected := bleve.NewSearchRequest(nil)
+		err := json.Unmarshal([]byte(r), expected)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		req, err := FromBleveSearchRequest(expected)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		actual, err := ToBleveSearchRequest(req)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		expectedJSON, _ := json.Marshal(expected)
+		actualJSON, _ := json.Marshal(actual)
+		if string(expectedJSON) != string(actualJSON) {
+			t.Errorf("expected content to see %s, saw %s", expectedJSON, actualJSON)
+		}
+	}
+}
+
+func TestToBleveSearchRequestWithoutQuery(t *testing.T) {
+	_, err := ToBleveSearchRequest(&index.SearchRequest{})
+	if err == nil {
+		t.Errorf("expected error, saw nil")
+	}
+}
+
+func TestSearchResultConversion(t *testing.T) {
+	min := 10.0
+	start := "2019-01-01T00:00:00Z"
+	expected := &bleve.SearchResult{
+		Status: &bleve.SearchStatus{
+			Total:      1,
+			Failed:     0,
+			Successful: 1,
+		},
+		Request: bleve.NewSearchRequest(bleve.NewQueryStringQuery("blast")),
+		Hits: search.DocumentMatchCollection{
+			{
+				ID:    "1",
+				Score: 1.5,
+				Expl: &search.Explanation{
+					Value:    1.5,
+					Message:  "weight",
+					Children: []*search.Explanation{{Value: 1.5, Message: "tf"}},
+				},
+				Locations: search.FieldTermLocationMap{
+					"title": search.TermLocationMap{
+						"blast": search.Locations{{Pos: 1, Start: 0, End: 5, ArrayPositions: search.ArrayPositions{0}}},
+					},
+				},
+				Fragments: search.FieldFragmentMap{"title": []string{"<mark>blast</mark>"}},
+				Sort:      []string{"_score"},
+				Fields:    map[string]interface{}{"title": "blast", "count": 3.0, "tags": []interface{}{"a", "b"}},
+			},
+		},
+		Total:    1,
+		MaxScore: 1.5,
+		Took:     123 * time.Millisecond,
+		Facets: search.FacetResults{
+			"count": &search.FacetResult{
+				Field: "count",
+				Total: 1,
+				NumericRanges: search.NumericRangeFacets{
+					{Name: "high", Min: &min, Count: 1},
+				},
+			},
+			"timestamp": &search.FacetResult{
+				Field: "timestamp",
+				Total: 1,
+				DateRanges: search.DateRangeFacets{
+					{Name: "new", Start: &start, Count: 1},
+				},
+			},
+			"tags": &search.FacetResult{
+				Field: "tags",
+				Total: 2,
+				Terms: search.TermFacets{{Term: "a", Count: 1}, {Term: "b", Count: 1}},
+			},
+		},
+	}
+
+	resp, err := FromBleveSearchResult(expected)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	actual, err := ToBleveSearchResult(resp)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if !reflect.DeepEqual(expected.Hits, actual.Hits) {
+		t.Errorf("expected content to see %v, saw %v", expected.Hits, actual.Hits)
+	}
+
+	expectedJSON, _ := json.Marshal(expected)
+	actualJSON, _ := json.Marshal(actual)
+	if string(expectedJSON) != string(actualJSON) {
+		t.Errorf("expected content to see %s, saw %s", expectedJSON, actualJSON)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2019 Minoru Osuka
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/golang/protobuf/jsonpb"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+// ToStruct converts a JSON compatible map to a protobuf Struct.
+func ToStruct(fields map[string]interface{}) (*structpb.Struct, error) {
+	value, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &structpb.Struct{}
+	err = jsonpb.Unmarshal(bytes.NewReader(value), s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// FromStruct converts a protobuf Struct to a map.
+func FromStruct(s *structpb.Struct) (map[string]interface{}, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	marshaler := &jsonpb.Marshaler{}
+	value, err := marshaler.MarshalToString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	err = json.Unmarshal([]byte(value), &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -19,6 +19,7 @@ import (
 	"reflect"
 
 	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/mosuka/blast/protobuf/index"
 	"github.com/mosuka/blast/protobuf/management"
@@ -30,11 +31,16 @@ func init() {
 	registry.RegisterType("map[string]interface {}", reflect.TypeOf((map[string]interface{})(nil)))
 
 	registry.RegisterType("management.KeyValuePair", reflect.TypeOf(management.KeyValuePair{}))
-	registry.RegisterType("index.Document", reflect.TypeOf(index.Document{}))
+	// documents are carried as standard Any messages, the legacy encoding is kept to read existing raft logs
+	registry.RegisterType("index.Document", reflect.TypeOf(index.LegacyDocument{}))
+	registry.RegisterType("index.IndexInfo", reflect.TypeOf(index.IndexInfo{}))
+	registry.RegisterType("index.Alias", reflect.TypeOf(index.Alias{}))
+	registry.RegisterType("index.SwapAliasRequest", reflect.TypeOf(index.SwapAliasRequest{}))
 	registry.RegisterType("raft.Node", reflect.TypeOf(raft.Node{}))
 
 	registry.RegisterType("bleve.SearchRequest", reflect.TypeOf(bleve.SearchRequest{}))
 	registry.RegisterType("bleve.SearchResult", reflect.TypeOf(bleve.SearchResult{}))
+	registry.RegisterType("mapping.IndexMappingImpl", reflect.TypeOf(mapping.IndexMappingImpl{}))
 }
 
 func MarshalAny(message *any.Any) (interface{}, error) {
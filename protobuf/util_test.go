@@ -33,7 +33,7 @@ func TestMarshalAny(t *testing.T) {
 		t.Errorf("expected content to see %v, saw %v", expectedValue, actualValue)
 	}
 
-	// test index.Document
+	// test index.LegacyDocument
 	fieldsMap := map[string]interface{}{"f1": "aaa", "f2": 222, "f3": "ccc"}
 	fieldsAny := &any.Any{}
 	err = UnmarshalAny(fieldsMap, fieldsAny)
@@ -41,7 +41,7 @@ func TestMarshalAny(t *testing.T) {
 		t.Errorf("%v", err)
 	}
 
-	doc := &index.Document{
+	doc := &index.LegacyDocument{
 		Id:     "1",
 		Fields: fieldsAny,
 	}
@@ -160,7 +160,7 @@ func TestUnmarshalAny(t *testing.T) {
 		t.Errorf("expected content to see %v, saw %v", 3, dataMap["c"])
 	}
 
-	// index.Document
+	// index.LegacyDocument
 	dataAny = &any.Any{
 		TypeUrl: "index.Document",
 		Value:   []byte(`{"id":"1","fields":{"type_url":"map[string]interface {}","value":"eyJmMSI6ImFhYSIsImYyIjoyMjIsImYzIjoiY2NjIn0="}}`),
@@ -170,7 +170,7 @@ func TestUnmarshalAny(t *testing.T) {
 	if err != nil {
 		t.Errorf("%v", err)
 	}
-	dataDoc := data.(*index.Document)
+	dataDoc := data.(*index.LegacyDocument)
 
 	if dataDoc.Id != "1" {
 		t.Errorf("expected content to see %v, saw %v", "1", dataDoc.Id)
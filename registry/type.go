@@ -36,6 +36,13 @@ func TypeByName(name string) reflect.Type {
 }
 
 func TypeNameByInstance(instance interface{}) string {
+	// prefer the name the type was registered with
+	for name, typ := range Types {
+		if reflect.TypeOf(instance) == reflect.PtrTo(typ) {
+			return name
+		}
+	}
+
 	switch ins := instance.(type) {
 	case map[string]interface{}:
 		return reflect.TypeOf(ins).String()